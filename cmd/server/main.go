@@ -14,13 +14,19 @@ import (
 	"bytecourses/internal/bootstrap"
 	infraauth "bytecourses/internal/infrastructure/auth"
 	infrahttp "bytecourses/internal/infrastructure/http"
+	"bytecourses/internal/pkg/password"
 	"bytecourses/web"
 )
 
 func main() {
 	storage := flag.String("storage", "memory", "storage backend: memory|sql")
+	sessionStore := flag.String("session-store", "memory", "session store backend: memory|sql (requires sql storage)")
 	bcryptCost := flag.Int("bcrypt-cost", bcrypt.DefaultCost, "bcrypt cost factor")
-	emailService := flag.String("email-service", "none", "email service provider: resend|none")
+	passwordMinLength := flag.Int("password-min-length", password.DefaultMinLength, "minimum length of new passwords")
+	reviewQuorum := flag.Int("review-quorum", 1, "number of reviewer votes needed to decide a proposal")
+	reviewReminderAfter := flag.Duration("review-reminder-after", 72*time.Hour, "remind reviewers who have not voted after this long (0 disables)")
+	trustProxy := flag.Bool("trust-proxy", false, "read client addresses from X-Forwarded-For set by a reverse proxy")
+	emailService := flag.String("email-service", "none", "email service provider: resend|log|none")
 	seedUsers := flag.String("seed-users", "", "path to JSON file containing users to seed")
 	seedProposals := flag.String("seed-proposals", "", "path to JSON file containing proposals to seed")
 	seedCourses := flag.String("seed-courses", "", "path to JSON file containing courses to seed")
@@ -44,10 +50,23 @@ func main() {
 		os.Exit(1)
 	}
 
+	var sessionStoreType bootstrap.SessionStoreType
+	switch *sessionStore {
+	case "memory":
+		sessionStoreType = bootstrap.SessionStoreMemory
+	case "sql", "postgres":
+		sessionStoreType = bootstrap.SessionStorePostgres
+	default:
+		logger.Error("unknown session store", "session-store", *sessionStore)
+		os.Exit(1)
+	}
+
 	var emailServiceType bootstrap.EmailService
 	switch *emailService {
 	case "resend":
 		emailServiceType = bootstrap.EmailServiceResend
+	case "log":
+		emailServiceType = bootstrap.EmailServiceLog
 	case "none":
 		emailServiceType = bootstrap.EmailServiceNone
 	default:
@@ -57,6 +76,7 @@ func main() {
 
 	cfg := bootstrap.Config{
 		Storage:       storageType,
+		SessionStore:  sessionStoreType,
 		EmailService:  emailServiceType,
 		BCryptCost:    *bcryptCost,
 		SeedUsers:     *seedUsers,
@@ -64,6 +84,17 @@ func main() {
 		SeedCourses:   *seedCourses,
 		SeedContent:   *seedContent,
 		BaseURL:       os.Getenv("BASE_URL"),
+		TrustProxy:    *trustProxy,
+
+		PasswordMinLength: *passwordMinLength,
+
+		ReviewQuorum:        *reviewQuorum,
+		ReviewReminderAfter: *reviewReminderAfter,
+
+		OIDCIssuerURL:    os.Getenv("OIDC_ISSUER_URL"),
+		OIDCClientID:     os.Getenv("OIDC_CLIENT_ID"),
+		OIDCClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+		OIDCProviderName: os.Getenv("OIDC_PROVIDER_NAME"),
 	}
 
 	ctx := context.Background()
@@ -93,7 +124,7 @@ func main() {
 		}
 	}()
 
-	logger.Info("server started", "port", port, "storage", storageType)
+	logger.Info("server started", "port", port, "storage", storageType, "session_store", sessionStoreType)
 
 	<-done
 	logger.Info("shutting down server...")
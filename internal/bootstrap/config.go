@@ -1,5 +1,7 @@
 package bootstrap
 
+import "time"
+
 type StorageType string
 
 const (
@@ -7,15 +9,24 @@ const (
 	StoragePostgres StorageType = "postgres"
 )
 
+type SessionStoreType string
+
+const (
+	SessionStoreMemory   SessionStoreType = "memory"
+	SessionStorePostgres SessionStoreType = "postgres"
+)
+
 type EmailService string
 
 const (
 	EmailServiceResend EmailService = "resend"
+	EmailServiceLog    EmailService = "log"
 	EmailServiceNone   EmailService = "none"
 )
 
 type Config struct {
 	Storage       StorageType
+	SessionStore  SessionStoreType
 	EmailService  EmailService
 	BCryptCost    int
 	SeedUsers     string
@@ -23,4 +34,24 @@ type Config struct {
 	SeedCourses   string
 	SeedContent   string
 	BaseURL       string
+
+	// TrustProxy reads client addresses from the X-Forwarded-For hop added
+	// by a reverse proxy. Leave it off when clients can reach the app
+	// directly, since they could then set the header themselves.
+	TrustProxy bool
+
+	// PasswordMinLength overrides the password policy's default when set.
+	PasswordMinLength int
+
+	// ReviewQuorum is how many assigned reviewers must vote before a
+	// proposal is decided. Assigned reviewers who have not voted within
+	// ReviewReminderAfter are reminded; zero disables reminders.
+	ReviewQuorum        int
+	ReviewReminderAfter time.Duration
+
+	// Single sign-on is enabled when OIDCIssuerURL is set.
+	OIDCIssuerURL    string
+	OIDCClientID     string
+	OIDCClientSecret string
+	OIDCProviderName string
 }
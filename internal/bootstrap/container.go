@@ -15,37 +15,71 @@ import (
 	"bytecourses/internal/domain"
 	infraauth "bytecourses/internal/infrastructure/auth"
 	"bytecourses/internal/infrastructure/email"
+	"bytecourses/internal/infrastructure/oidc"
 	"bytecourses/internal/infrastructure/persistence"
 	"bytecourses/internal/infrastructure/persistence/memory"
 	"bytecourses/internal/infrastructure/persistence/postgres"
 	"bytecourses/internal/infrastructure/storage"
 	"bytecourses/internal/pkg/events"
+	"bytecourses/internal/pkg/password"
+	"bytecourses/internal/pkg/policy"
 	"bytecourses/internal/services"
 )
 
+const (
+	sessionTTL        = 24 * time.Hour
+	pendingSessionTTL = 5 * time.Minute
+
+	reviewReminderInterval = time.Hour
+)
+
 type Container struct {
-	EventBus     events.EventBus
-	SessionStore infraauth.SessionStore
-	EmailSender  email.Sender
-	BaseURL      string
-	DB           persistence.DB
+	EventBus            events.EventBus
+	SessionStore        infraauth.SessionStore
+	PendingSessionStore infraauth.SessionStore
+	EmailSender         email.Sender
+	OIDCProvider        *oidc.Provider
+	PasswordPolicy      *password.Policy
+	Authorizer          *policy.Authorizer
+	BaseURL             string
+	TrustProxy          bool
+	DB                  persistence.DB
 
 	UserRepo          persistence.UserRepository
 	ProposalRepo      persistence.ProposalRepository
+	ReviewerRepo      persistence.ProposalAssignmentRepository
 	CourseRepo        persistence.CourseRepository
+	CourseMemberRepo  persistence.CourseMemberRepository
 	ModuleRepo        persistence.ModuleRepository
 	ReadingRepo       persistence.ReadingRepository
 	FileRepo          persistence.FileRepository
+	QuizRepo          persistence.QuizRepository
+	QuizAttemptRepo   persistence.QuizAttemptRepository
+	AssignmentRepo    persistence.AssignmentRepository
+	SubmissionRepo    persistence.SubmissionRepository
 	PasswordResetRepo persistence.PasswordResetRepository
+	VerificationRepo  persistence.EmailVerificationRepository
+	EmailChangeRepo   persistence.EmailChangeRepository
+	RecoveryCodeRepo  persistence.RecoveryCodeRepository
+	RolePolicyRepo    persistence.RolePolicyRepository
+	LoginThrottleRepo persistence.LoginThrottleRepository
+	APITokenRepo      persistence.APITokenRepository
+	UserIdentityRepo  persistence.UserIdentityRepository
 	EnrollmentRepo    persistence.EnrollmentRepository
+	ProgressRepo      persistence.ProgressRepository
+	CertificateRepo   persistence.CertificateRepository
+	SearchRepo        persistence.SearchRepository
 	FileStorage       storage.FileStorage
 
-	AuthService       *services.AuthService
-	ProposalService   *services.ProposalService
-	CourseService     *services.CourseService
-	ModuleService     *services.ModuleService
-	ContentService    *services.ContentService
-	EnrollmentService *services.EnrollmentService
+	AuthService        *services.AuthService
+	ProposalService    *services.ProposalService
+	CourseService      *services.CourseService
+	ModuleService      *services.ModuleService
+	ContentService     *services.ContentService
+	EnrollmentService  *services.EnrollmentService
+	SubmissionService  *services.SubmissionService
+	CertificateService *services.CertificateService
+	SearchService      *services.SearchService
 
 	onClose func() error
 }
@@ -58,15 +92,18 @@ func NewContainer(ctx context.Context, cfg Config) (*Container, error) {
 	}))
 
 	c.EventBus = events.NewInMemoryEventBus(logger)
-	c.SessionStore = infraauth.NewInMemorySessionStore(24 * time.Hour)
 
-	if err := c.setupEmailSender(cfg); err != nil {
+	if err := c.setupEmailSender(cfg, logger); err != nil {
 		return nil, err
 	}
 	if err := c.setupPersistence(ctx, cfg); err != nil {
 		return nil, err
 	}
+	if err := c.setupSessionStore(cfg); err != nil {
+		return nil, err
+	}
 
+	c.TrustProxy = cfg.TrustProxy
 	c.BaseURL = strings.TrimSpace(cfg.BaseURL)
 	if strings.HasSuffix(c.BaseURL, "/") && !strings.HasSuffix(c.BaseURL, "//") {
 		c.BaseURL = strings.TrimSuffix(c.BaseURL, "/")
@@ -77,10 +114,21 @@ func NewContainer(ctx context.Context, cfg Config) (*Container, error) {
 			slog.Warn("BASE_URL invalid or missing scheme/host", "base_url", c.BaseURL)
 		}
 	}
+	if err := c.setupOIDC(cfg); err != nil {
+		return nil, err
+	}
+
+	c.PasswordPolicy = password.DefaultPolicy()
+	if cfg.PasswordMinLength > 0 {
+		c.PasswordPolicy.MinLength = cfg.PasswordMinLength
+	}
 
 	seedAdmin(ctx, c.UserRepo)
-	c.wireServices()
+	c.wireServices(cfg)
 	c.setupEventSubscribers()
+	if cfg.ReviewReminderAfter > 0 {
+		c.startReviewReminders(cfg.ReviewReminderAfter)
+	}
 
 	if cfg.SeedUsers != "" {
 		if err := c.seedUsers(ctx, cfg.SeedUsers); err != nil {
@@ -106,7 +154,7 @@ func NewContainer(ctx context.Context, cfg Config) (*Container, error) {
 	return &c, nil
 }
 
-func (c *Container) setupEmailSender(cfg Config) error {
+func (c *Container) setupEmailSender(cfg Config, logger *slog.Logger) error {
 	switch cfg.EmailService {
 	case EmailServiceResend:
 		apiKey := os.Getenv("RESEND_API_KEY")
@@ -119,6 +167,9 @@ func (c *Container) setupEmailSender(cfg Config) error {
 		}
 		c.EmailSender = email.NewResendSender(apiKey, fromEmail)
 
+	case EmailServiceLog:
+		c.EmailSender = email.NewLogSender(logger)
+
 	case EmailServiceNone:
 		c.EmailSender = email.NewNullSender()
 
@@ -129,6 +180,34 @@ func (c *Container) setupEmailSender(cfg Config) error {
 	return nil
 }
 
+func (c *Container) setupOIDC(cfg Config) error {
+	issuerURL := strings.TrimSpace(cfg.OIDCIssuerURL)
+	if issuerURL == "" {
+		return nil
+	}
+	if cfg.OIDCClientID == "" {
+		return errors.New("OIDC_CLIENT_ID required when OIDC_ISSUER_URL is set")
+	}
+	if c.BaseURL == "" {
+		return errors.New("BASE_URL required when OIDC_ISSUER_URL is set")
+	}
+
+	name := cfg.OIDCProviderName
+	if name == "" {
+		name = "single sign-on"
+	}
+
+	c.OIDCProvider = oidc.NewProvider(oidc.Config{
+		Name:         name,
+		IssuerURL:    issuerURL,
+		ClientID:     cfg.OIDCClientID,
+		ClientSecret: cfg.OIDCClientSecret,
+		RedirectURL:  c.BaseURL + "/api/login/oidc/callback",
+	}, nil)
+
+	return nil
+}
+
 func (c *Container) setupPersistence(ctx context.Context, cfg Config) error {
 	uploadDir := os.Getenv("UPLOAD_DIR")
 	if uploadDir == "" {
@@ -143,13 +222,32 @@ func (c *Container) setupPersistence(ctx context.Context, cfg Config) error {
 	switch cfg.Storage {
 	case StorageMemory:
 		c.UserRepo = memory.NewUserRepository()
-		c.ProposalRepo = memory.NewProposalRepository()
-		c.CourseRepo = memory.NewCourseRepository()
+		c.ProposalRepo = memory.NewProposalRepository(c.UserRepo)
+		c.ReviewerRepo = memory.NewProposalAssignmentRepository()
+		c.EnrollmentRepo = memory.NewEnrollmentRepository()
+		c.CourseRepo = memory.NewCourseRepository(c.EnrollmentRepo)
+		c.CourseMemberRepo = memory.NewCourseMemberRepository()
 		c.ModuleRepo = memory.NewModuleRepository()
 		c.ReadingRepo = memory.NewReadingRepository()
 		c.FileRepo = memory.NewFileRepository()
+		c.QuizRepo = memory.NewQuizRepository()
+		c.QuizAttemptRepo = memory.NewQuizAttemptRepository()
+		c.AssignmentRepo = memory.NewAssignmentRepository()
+		c.SubmissionRepo = memory.NewSubmissionRepository()
 		c.PasswordResetRepo = memory.NewPasswordResetRepository()
-		c.EnrollmentRepo = memory.NewEnrollmentRepository()
+		c.VerificationRepo = memory.NewEmailVerificationRepository()
+		c.EmailChangeRepo = memory.NewEmailChangeRepository()
+		c.RecoveryCodeRepo = memory.NewRecoveryCodeRepository()
+		c.RolePolicyRepo = memory.NewRolePolicyRepository()
+		c.LoginThrottleRepo = memory.NewLoginThrottleRepository()
+		c.APITokenRepo = memory.NewAPITokenRepository()
+		c.UserIdentityRepo = memory.NewUserIdentityRepository()
+		c.ProgressRepo = memory.NewProgressRepository()
+		c.CertificateRepo = memory.NewCertificateRepository()
+
+		searchRepo := memory.NewSearchRepository(c.CourseRepo, c.ModuleRepo, c.ReadingRepo)
+		searchRepo.Subscribe(c.EventBus)
+		c.SearchRepo = searchRepo
 
 	case StoragePostgres:
 		dbURL := os.Getenv("DATABASE_URL")
@@ -165,12 +263,28 @@ func (c *Container) setupPersistence(ctx context.Context, cfg Config) error {
 		c.DB = db
 		c.UserRepo = postgres.NewUserRepository(db)
 		c.ProposalRepo = postgres.NewProposalRepository(db)
+		c.ReviewerRepo = postgres.NewProposalAssignmentRepository(db)
 		c.CourseRepo = postgres.NewCourseRepository(db)
+		c.CourseMemberRepo = postgres.NewCourseMemberRepository(db)
 		c.ModuleRepo = postgres.NewModuleRepository(db)
 		c.ReadingRepo = postgres.NewReadingRepository(db)
 		c.FileRepo = postgres.NewFileRepository(db)
+		c.QuizRepo = postgres.NewQuizRepository(db)
+		c.QuizAttemptRepo = postgres.NewQuizAttemptRepository(db)
+		c.AssignmentRepo = postgres.NewAssignmentRepository(db)
+		c.SubmissionRepo = postgres.NewSubmissionRepository(db)
 		c.PasswordResetRepo = postgres.NewPasswordResetRepository(db)
+		c.VerificationRepo = postgres.NewEmailVerificationRepository(db)
+		c.EmailChangeRepo = postgres.NewEmailChangeRepository(db)
+		c.RecoveryCodeRepo = postgres.NewRecoveryCodeRepository(db)
+		c.RolePolicyRepo = postgres.NewRolePolicyRepository(db)
+		c.LoginThrottleRepo = postgres.NewLoginThrottleRepository(db)
+		c.APITokenRepo = postgres.NewAPITokenRepository(db)
+		c.UserIdentityRepo = postgres.NewUserIdentityRepository(db)
 		c.EnrollmentRepo = postgres.NewEnrollmentRepository(db)
+		c.ProgressRepo = postgres.NewProgressRepository(db)
+		c.CertificateRepo = postgres.NewCertificateRepository(db)
+		c.SearchRepo = postgres.NewSearchRepository(db)
 		c.onClose = db.Close
 
 	default:
@@ -180,61 +294,232 @@ func (c *Container) setupPersistence(ctx context.Context, cfg Config) error {
 	return nil
 }
 
-func (c *Container) wireServices() {
+func (c *Container) setupSessionStore(cfg Config) error {
+	switch cfg.SessionStore {
+	case SessionStoreMemory, "":
+		c.SessionStore = infraauth.NewInMemorySessionStore(sessionTTL)
+		c.PendingSessionStore = infraauth.NewInMemorySessionStore(pendingSessionTTL)
+
+	case SessionStorePostgres:
+		db, ok := c.DB.(*postgres.DB)
+		if !ok {
+			return errors.New("postgres session store requires postgres storage")
+		}
+
+		store := postgres.NewSessionStore(db, sessionTTL)
+		pendingStore := postgres.NewPendingSessionStore(db, pendingSessionTTL)
+		c.SessionStore = store
+		c.PendingSessionStore = pendingStore
+
+		closeDB := c.onClose
+		c.onClose = func() error {
+			store.Close()
+			pendingStore.Close()
+			return closeDB()
+		}
+
+	default:
+		return errors.New("unknown session store")
+	}
+
+	return nil
+}
+
+func (c *Container) wireServices(cfg Config) {
+	c.Authorizer = policy.NewAuthorizer(c.CourseMemberRepo)
+
 	c.AuthService = services.NewAuthService(
 		c.UserRepo,
 		c.PasswordResetRepo,
+		c.VerificationRepo,
+		c.EmailChangeRepo,
+		c.RecoveryCodeRepo,
+		c.RolePolicyRepo,
+		c.LoginThrottleRepo,
+		c.APITokenRepo,
+		c.UserIdentityRepo,
 		c.SessionStore,
+		c.PendingSessionStore,
+		c.OIDCProvider,
+		c.PasswordPolicy,
 		c.EventBus,
 	)
 
 	c.ProposalService = services.NewProposalService(
 		c.ProposalRepo,
+		c.ReviewerRepo,
 		c.UserRepo,
+		domain.ReviewQuorum{Votes: cfg.ReviewQuorum},
 		c.EventBus,
+		c.FileStorage,
 	)
 
 	c.CourseService = services.NewCourseService(
 		c.CourseRepo,
 		c.ProposalRepo,
+		c.ModuleRepo,
+		c.ReadingRepo,
+		c.FileRepo,
+		c.CourseMemberRepo,
+		c.UserRepo,
+		c.Authorizer,
 		c.EventBus,
+		c.FileStorage,
 	)
 
 	c.ModuleService = services.NewModuleService(
 		c.ModuleRepo,
 		c.CourseRepo,
+		c.EnrollmentRepo,
+		c.ProgressRepo,
+		c.ReadingRepo,
+		c.FileRepo,
+		c.Authorizer,
 		c.EventBus,
 	)
 
 	c.ContentService = services.NewContentService(
 		c.ReadingRepo,
 		c.FileRepo,
+		c.QuizRepo,
+		c.QuizAttemptRepo,
+		c.AssignmentRepo,
 		c.ModuleRepo,
 		c.CourseRepo,
+		c.EnrollmentRepo,
+		c.ProgressRepo,
+		c.Authorizer,
 		c.EventBus,
 		c.FileStorage,
 	)
 
 	c.EnrollmentService = services.NewEnrollmentService(
 		c.EnrollmentRepo,
+		c.ProgressRepo,
 		c.CourseRepo,
+		c.ModuleRepo,
+		c.ReadingRepo,
+		c.FileRepo,
 		c.UserRepo,
 		c.EventBus,
 	)
+
+	c.SubmissionService = services.NewSubmissionService(
+		c.SubmissionRepo,
+		c.AssignmentRepo,
+		c.ModuleRepo,
+		c.CourseRepo,
+		c.EnrollmentRepo,
+		c.ProgressRepo,
+		c.ReadingRepo,
+		c.FileRepo,
+		c.Authorizer,
+		c.EventBus,
+		c.FileStorage,
+	)
+
+	c.CertificateService = services.NewCertificateService(
+		c.CertificateRepo,
+		c.EnrollmentRepo,
+		c.CourseRepo,
+		c.UserRepo,
+		c.EventBus,
+	)
+
+	c.SearchService = services.NewSearchService(
+		c.SearchRepo,
+	)
 }
 
 func (c *Container) setupEventSubscribers() {
-	c.EventBus.Subscribe("user.registered", func(ctx context.Context, e domain.Event) error {
-		event := e.(*domain.UserRegisteredEvent)
+	c.EventBus.Subscribe("user.email_verification_requested", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.EmailVerificationRequestedEvent)
+		return c.EmailSender.SendVerificationEmail(ctx, event.Email, event.Name, event.VerifyURL, event.Token)
+	})
+
+	c.EventBus.Subscribe("user.email_verified", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.EmailVerifiedEvent)
 		getStartedURL := c.BaseURL + "/"
 		return c.EmailSender.SendWelcomeEmail(ctx, event.Email, event.Name, getStartedURL)
 	})
 
+	c.EventBus.Subscribe("user.locked_out", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.UserLockedOutEvent)
+		slog.Warn("account locked after repeated failed logins",
+			"user_id", event.UserID,
+			"locked_until", event.LockedUntil,
+		)
+		return nil
+	})
+
+	c.EventBus.Subscribe("user.role_changed", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.UserRoleChangedEvent)
+		slog.Info("admin changed user role",
+			"user_id", event.UserID,
+			"old_role", event.OldRole,
+			"new_role", event.NewRole,
+			"admin_id", event.ChangedBy,
+		)
+		return nil
+	})
+
+	c.EventBus.Subscribe("user.suspended", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.UserSuspendedEvent)
+		slog.Info("admin suspended user",
+			"user_id", event.UserID,
+			"admin_id", event.SuspendedBy,
+		)
+		return nil
+	})
+
+	c.EventBus.Subscribe("user.reactivated", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.UserReactivatedEvent)
+		slog.Info("admin reactivated user",
+			"user_id", event.UserID,
+			"admin_id", event.ReactivatedBy,
+		)
+		return nil
+	})
+
+	c.EventBus.Subscribe("user.password_reset_forced", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.UserPasswordResetForcedEvent)
+		slog.Info("admin forced password reset",
+			"user_id", event.UserID,
+			"admin_id", event.ForcedBy,
+		)
+		return nil
+	})
+
+	c.EventBus.Subscribe("user.unlocked", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.UserUnlockedEvent)
+		slog.Info("admin unlocked user",
+			"user_id", event.UserID,
+			"admin_id", event.UnlockedBy,
+		)
+		return nil
+	})
+
 	c.EventBus.Subscribe("user.password_reset_requested", func(ctx context.Context, e domain.Event) error {
 		event := e.(*domain.PasswordResetRequestedEvent)
 		return c.EmailSender.SendPasswordResetEmail(ctx, event.Email, event.ResetURL, event.Token)
 	})
 
+	c.EventBus.Subscribe("user.email_change_requested", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.EmailChangeRequestedEvent)
+		if err := c.EmailSender.SendEmailChangeConfirmationEmail(ctx, event.NewEmail, event.Name, event.ConfirmURL, event.Token); err != nil {
+			return err
+		}
+		return c.EmailSender.SendEmailChangeNoticeEmail(ctx, event.OldEmail, event.Name, event.NewEmail)
+	})
+
+	c.EventBus.Subscribe("user.password_reset_completed", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.PasswordResetCompletedEvent)
+		if err := c.PendingSessionStore.DeleteByUserID(event.UserID); err != nil {
+			return err
+		}
+		return c.SessionStore.DeleteByUserID(event.UserID)
+	})
+
 	c.EventBus.Subscribe("proposal.submitted", func(ctx context.Context, e domain.Event) error {
 		event := e.(*domain.ProposalSubmittedEvent)
 		author, ok := c.UserRepo.GetByID(ctx, event.AuthorID)
@@ -275,6 +560,26 @@ func (c *Container) setupEventSubscribers() {
 		return c.EmailSender.SendProposalChangesRequestedEmail(ctx, author.Email, author.Name, event.Title, event.ReviewNotes, proposalURL)
 	})
 
+	c.EventBus.Subscribe("proposal.reviewer_assigned", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.ProposalReviewerAssignedEvent)
+		reviewer, ok := c.UserRepo.GetByID(ctx, event.ReviewerID)
+		if !ok {
+			return nil
+		}
+		reviewURL := c.BaseURL + "/proposals/" + strconv.FormatInt(event.ProposalID, 10)
+		return c.EmailSender.SendProposalReviewAssignedEmail(ctx, reviewer.Email, reviewer.Name, event.Title, reviewURL)
+	})
+
+	c.EventBus.Subscribe("proposal.review_reminder", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.ProposalReviewReminderEvent)
+		reviewer, ok := c.UserRepo.GetByID(ctx, event.ReviewerID)
+		if !ok {
+			return nil
+		}
+		reviewURL := c.BaseURL + "/proposals/" + strconv.FormatInt(event.ProposalID, 10)
+		return c.EmailSender.SendProposalReviewReminderEmail(ctx, reviewer.Email, reviewer.Name, event.Title, reviewURL)
+	})
+
 	c.EventBus.Subscribe("enrollment.created", func(ctx context.Context, e domain.Event) error {
 		event := e.(*domain.EnrollmentCreatedEvent)
 		user, ok := c.UserRepo.GetByID(ctx, event.UserID)
@@ -288,6 +593,101 @@ func (c *Container) setupEventSubscribers() {
 		courseURL := c.BaseURL + "/courses/" + strconv.FormatInt(event.CourseID, 10)
 		return c.EmailSender.SendEnrollmentConfirmationEmail(ctx, user.Email, user.Name, course.Title, courseURL)
 	})
+
+	c.EventBus.Subscribe("submission.created", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.SubmissionCreatedEvent)
+		instructor, ok := c.UserRepo.GetByID(ctx, event.InstructorID)
+		if !ok {
+			return nil
+		}
+		learner, ok := c.UserRepo.GetByID(ctx, event.UserID)
+		if !ok {
+			return nil
+		}
+		assignment, ok := c.AssignmentRepo.GetByID(ctx, event.AssignmentID)
+		if !ok {
+			return nil
+		}
+		submissionURL := c.BaseURL + "/courses/" + strconv.FormatInt(event.CourseID, 10) + "/modules/" + strconv.FormatInt(event.ModuleID, 10) + "/content/" + strconv.FormatInt(event.AssignmentID, 10)
+		return c.EmailSender.SendSubmissionReceivedEmail(ctx, instructor.Email, instructor.Name, learner.Name, assignment.Title, submissionURL)
+	})
+
+	c.EventBus.Subscribe("submission.graded", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.SubmissionGradedEvent)
+		learner, ok := c.UserRepo.GetByID(ctx, event.UserID)
+		if !ok {
+			return nil
+		}
+		assignment, ok := c.AssignmentRepo.GetByID(ctx, event.AssignmentID)
+		if !ok {
+			return nil
+		}
+		submissionURL := c.BaseURL + "/courses/" + strconv.FormatInt(event.CourseID, 10) + "/modules/" + strconv.FormatInt(event.ModuleID, 10) + "/content/" + strconv.FormatInt(event.AssignmentID, 10)
+		return c.EmailSender.SendSubmissionGradedEmail(ctx, learner.Email, learner.Name, assignment.Title, event.Grade, event.MaxPoints, event.Feedback, submissionURL)
+	})
+
+	c.EventBus.Subscribe("progress.content_completed", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.ContentCompletedEvent)
+		progress, err := c.EnrollmentService.GetCourseProgress(ctx, &services.GetCourseProgressQuery{
+			CourseID: event.CourseID,
+			UserID:   event.UserID,
+		})
+		if err != nil || !progress.IsComplete() {
+			return err
+		}
+		_, err = c.CertificateService.Issue(ctx, &services.IssueCertificateCommand{
+			CourseID: event.CourseID,
+			UserID:   event.UserID,
+		})
+		return err
+	})
+
+	c.EventBus.Subscribe("certificate.issued", func(ctx context.Context, e domain.Event) error {
+		event := e.(*domain.CertificateIssuedEvent)
+		learner, ok := c.UserRepo.GetByID(ctx, event.UserID)
+		if !ok {
+			return nil
+		}
+		certificate, ok := c.CertificateRepo.GetByCode(ctx, event.Code)
+		if !ok {
+			return nil
+		}
+		certificateURL := c.BaseURL + "/certificates/" + event.Code
+		return c.EmailSender.SendCertificateIssuedEmail(ctx, learner.Email, learner.Name, certificate.CourseTitle, event.Code, certificateURL)
+	})
+}
+
+// startReviewReminders periodically reminds reviewers about proposals they
+// have not voted on within staleAfter. It stops when the container closes.
+func (c *Container) startReviewReminders(staleAfter time.Duration) {
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(reviewReminderInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				sent, err := c.ProposalService.RemindStaleReviews(context.Background(), staleAfter)
+				if err != nil {
+					slog.Error("failed to send review reminders", "error", err)
+				} else if sent > 0 {
+					slog.Info("sent review reminders", "count", sent)
+				}
+			}
+		}
+	}()
+
+	closeRest := c.onClose
+	c.onClose = func() error {
+		close(stop)
+		if closeRest != nil {
+			return closeRest()
+		}
+		return nil
+	}
 }
 
 func (c *Container) Close() error {
@@ -313,11 +713,13 @@ func seedAdmin(ctx context.Context, users persistence.UserRepository) error {
 		return err
 	}
 
+	now := time.Now()
 	return users.Create(ctx, &domain.User{
 		Email:        email,
 		PasswordHash: hash,
 		Role:         domain.SystemRoleAdmin,
 		Name:         "Admin",
+		VerifiedAt:   &now,
 	})
 }
 
@@ -355,12 +757,14 @@ func (c *Container) seedUsers(ctx context.Context, path string) error {
 			return fmt.Errorf("hashing password for %s: %w", u.Email, err)
 		}
 
+		now := time.Now()
 		user := &domain.User{
 			ID:           u.ID,
 			Email:        u.Email,
 			Name:         u.Name,
 			PasswordHash: hash,
 			Role:         u.Role,
+			VerifiedAt:   &now,
 		}
 
 		if err := c.UserRepo.Create(ctx, user); err != nil {
@@ -421,6 +825,10 @@ func (c *Container) seedProposals(ctx context.Context, path string) error {
 			ReviewerID:           p.ReviewerID,
 			Status:               status,
 		}
+		if proposal.WasSubmitted() {
+			submittedAt := time.Now()
+			proposal.SubmittedAt = &submittedAt
+		}
 
 		if err := c.ProposalRepo.Create(ctx, proposal); err != nil {
 			return fmt.Errorf("creating proposal %q: %w", p.Title, err)
@@ -478,6 +886,15 @@ func (c *Container) seedCourses(ctx context.Context, path string) error {
 		if err := c.CourseRepo.Create(ctx, course); err != nil {
 			return fmt.Errorf("creating course %q: %w", sc.Title, err)
 		}
+
+		owner := &domain.CourseMember{
+			CourseID: course.ID,
+			UserID:   course.InstructorID,
+			Role:     domain.CourseRoleOwner,
+		}
+		if err := c.CourseMemberRepo.AddMember(ctx, owner); err != nil {
+			return fmt.Errorf("adding owner to course %q: %w", sc.Title, err)
+		}
 	}
 
 	return nil
@@ -572,10 +989,16 @@ func (c *Container) seedContent(ctx context.Context, path string) error {
 			actualModuleID = mappedID
 		}
 
-		if _, ok := c.ModuleRepo.GetByID(ctx, actualModuleID); !ok {
+		module, ok := c.ModuleRepo.GetByID(ctx, actualModuleID)
+		if !ok {
 			return fmt.Errorf("module %d not found for reading %q", sr.ModuleID, sr.Title)
 		}
 
+		course, ok := c.CourseRepo.GetByID(ctx, module.CourseID)
+		if !ok {
+			return fmt.Errorf("course %d not found for reading %q", module.CourseID, sr.Title)
+		}
+
 		existingReadings, err := c.ReadingRepo.ListByModuleID(ctx, actualModuleID)
 		if err != nil {
 			return fmt.Errorf("listing readings for module %d: %w", actualModuleID, err)
@@ -617,6 +1040,18 @@ func (c *Container) seedContent(ctx context.Context, path string) error {
 		if err := c.ReadingRepo.Create(ctx, reading); err != nil {
 			return fmt.Errorf("creating reading %q: %w", sr.Title, err)
 		}
+
+		revision := &domain.ReadingRevision{
+			ReadingID: reading.ID,
+			AuthorID:  course.InstructorID,
+			Format:    reading.Format,
+		}
+		if reading.Content != nil {
+			revision.Content = *reading.Content
+		}
+		if err := c.ReadingRepo.CreateRevision(ctx, revision); err != nil {
+			return fmt.Errorf("creating revision for reading %q: %w", sr.Title, err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+type TokenScope string
+
+const (
+	TokenScopeProposalsRead  TokenScope = "proposals:read"
+	TokenScopeProposalsWrite TokenScope = "proposals:write"
+	TokenScopeCoursesRead    TokenScope = "courses:read"
+	TokenScopeCoursesWrite   TokenScope = "courses:write"
+	TokenScopeContentRead    TokenScope = "content:read"
+	TokenScopeContentWrite   TokenScope = "content:write"
+)
+
+func IsValidTokenScope(scope TokenScope) bool {
+	switch scope {
+	case TokenScopeProposalsRead, TokenScopeProposalsWrite,
+		TokenScopeCoursesRead, TokenScopeCoursesWrite,
+		TokenScopeContentRead, TokenScopeContentWrite:
+		return true
+	default:
+		return false
+	}
+}
+
+type APIToken struct {
+	ID         int64        `json:"id"`
+	UserID     int64        `json:"user_id"`
+	Name       string       `json:"name"`
+	Prefix     string       `json:"prefix"`
+	TokenHash  []byte       `json:"-"`
+	Scopes     []TokenScope `json:"scopes"`
+	ExpiresAt  time.Time    `json:"expires_at"`
+	LastUsedAt *time.Time   `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time    `json:"created_at"`
+}
+
+func (t *APIToken) IsExpired(now time.Time) bool {
+	return !now.Before(t.ExpiresAt)
+}
+
+// HasScope treats a write scope as also granting read on the same resource.
+func (t *APIToken) HasScope(scope TokenScope) bool {
+	resource, _, _ := strings.Cut(string(scope), ":")
+	for _, s := range t.Scopes {
+		if s == scope || s == TokenScope(resource+":write") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package domain
+
+import (
+	"time"
+)
+
+type Certificate struct {
+	ID             int64     `json:"id"`
+	Code           string    `json:"code"`
+	UserID         int64     `json:"user_id"`
+	CourseID       int64     `json:"course_id"`
+	LearnerName    string    `json:"learner_name"`
+	CourseTitle    string    `json:"course_title"`
+	InstructorName string    `json:"instructor_name"`
+	IssuedAt       time.Time `json:"issued_at"`
+}
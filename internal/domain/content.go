@@ -11,6 +11,8 @@ type ContentItem interface {
 var (
 	_ ContentItem = (*Reading)(nil)
 	_ ContentItem = (*File)(nil)
+	_ ContentItem = (*Quiz)(nil)
+	_ ContentItem = (*Assignment)(nil)
 )
 
 type ContentStatus string
@@ -23,8 +25,10 @@ const (
 type ContentType string
 
 const (
-	ContentTypeReading ContentType = "reading"
-	ContentTypeFile    ContentType = "file"
+	ContentTypeReading    ContentType = "reading"
+	ContentTypeFile       ContentType = "file"
+	ContentTypeQuiz       ContentType = "quiz"
+	ContentTypeAssignment ContentType = "assignment"
 )
 
 type BaseContentItem struct {
@@ -55,6 +59,17 @@ func (r *Reading) Type() ContentType {
 	return ContentTypeReading
 }
 
+type ReadingRevision struct {
+	ID           int64         `json:"id"`
+	ReadingID    int64         `json:"reading_id"`
+	Number       int           `json:"number"`
+	AuthorID     int64         `json:"author_id"`
+	Format       ReadingFormat `json:"format"`
+	Content      string        `json:"content"`
+	RestoredFrom *int          `json:"restored_from,omitempty"`
+	CreatedAt    time.Time     `json:"created_at"`
+}
+
 type File struct {
 	BaseContentItem
 	FileName    string `json:"file_name"`
@@ -66,3 +81,88 @@ type File struct {
 func (f *File) Type() ContentType {
 	return ContentTypeFile
 }
+
+type QuestionType string
+
+const (
+	QuestionTypeMultipleChoice QuestionType = "multiple_choice"
+	QuestionTypeMultiSelect    QuestionType = "multi_select"
+	QuestionTypeShortAnswer    QuestionType = "short_answer"
+)
+
+type QuizQuestion struct {
+	Prompt          string       `json:"prompt"`
+	Type            QuestionType `json:"type"`
+	Options         []string     `json:"options,omitempty"`
+	CorrectOptions  []int        `json:"correct_options,omitempty"`
+	AcceptedAnswers []string     `json:"accepted_answers,omitempty"`
+	Points          int          `json:"points"`
+}
+
+type Quiz struct {
+	BaseContentItem
+	Questions    []QuizQuestion `json:"questions"`
+	MaxAttempts  int            `json:"max_attempts"`
+	PassingScore int            `json:"passing_score"`
+}
+
+func (q *Quiz) Type() ContentType {
+	return ContentTypeQuiz
+}
+
+func (q *Quiz) TotalPoints() int {
+	total := 0
+	for _, question := range q.Questions {
+		total += question.Points
+	}
+	return total
+}
+
+func (q *Quiz) HasAttemptLimit() bool {
+	return q.MaxAttempts > 0
+}
+
+// WithoutAnswers returns a copy of the quiz without its answer key, as shown
+// to learners taking it.
+func (q *Quiz) WithoutAnswers() *Quiz {
+	c := *q
+	c.Questions = make([]QuizQuestion, len(q.Questions))
+	for i, question := range q.Questions {
+		question.CorrectOptions = nil
+		question.AcceptedAnswers = nil
+		c.Questions[i] = question
+	}
+	return &c
+}
+
+type QuizAnswer struct {
+	SelectedOptions []int  `json:"selected_options,omitempty"`
+	Text            string `json:"text,omitempty"`
+}
+
+type QuizAttempt struct {
+	ID          int64        `json:"id"`
+	QuizID      int64        `json:"quiz_id"`
+	UserID      int64        `json:"user_id"`
+	Number      int          `json:"number"`
+	Answers     []QuizAnswer `json:"answers"`
+	Score       int          `json:"score"`
+	MaxScore    int          `json:"max_score"`
+	Passed      bool         `json:"passed"`
+	SubmittedAt time.Time    `json:"submitted_at"`
+}
+
+type Assignment struct {
+	BaseContentItem
+	Instructions string     `json:"instructions"`
+	DueAt        *time.Time `json:"due_at,omitempty"`
+	MaxPoints    int        `json:"max_points"`
+}
+
+func (a *Assignment) Type() ContentType {
+	return ContentTypeAssignment
+}
+
+func (a *Assignment) IsPastDue(now time.Time) bool {
+	return a.DueAt != nil && now.After(*a.DueAt)
+}
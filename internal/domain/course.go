@@ -11,6 +11,14 @@ const (
 	CourseStatusPublished CourseStatus = "published"
 )
 
+type CourseSort string
+
+const (
+	CourseSortNewest       CourseSort = "newest"
+	CourseSortMostEnrolled CourseSort = "enrolled"
+	CourseSortTitle        CourseSort = "title"
+)
+
 type Course struct {
 	ID                   int64        `json:"id"`
 	Title                string       `json:"title"`
@@ -42,10 +50,18 @@ func (c *Course) IsLive() bool {
 	return c.Status == CourseStatusPublished
 }
 
-func (c *Course) IsTaughtBy(u *User) bool {
-	return c.InstructorID == u.ID
+type CourseFilter struct {
+	Sort           CourseSort
+	InstructorID   int64
+	EnrolledUserID int64
+	After          *CourseCursor
+	Limit          int
 }
 
-func (c *Course) IsViewableBy(u *User) bool {
-	return u.IsAdmin() || c.IsLive() || c.IsTaughtBy(u)
+type CourseCursor struct {
+	Sort        CourseSort `json:"s"`
+	ID          int64      `json:"id"`
+	CreatedAt   time.Time  `json:"c,omitzero"`
+	Title       string     `json:"t,omitempty"`
+	Enrollments int        `json:"e,omitempty"`
 }
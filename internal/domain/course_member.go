@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"time"
+)
+
+type CourseRole string
+
+const (
+	CourseRoleOwner        CourseRole = "owner"
+	CourseRoleCoInstructor CourseRole = "co_instructor"
+	CourseRoleTA           CourseRole = "ta"
+)
+
+func IsValidCourseRole(role CourseRole) bool {
+	return role == CourseRoleOwner || role == CourseRoleCoInstructor || role == CourseRoleTA
+}
+
+type CourseMember struct {
+	CourseID  int64      `json:"course_id"`
+	UserID    int64      `json:"user_id"`
+	Role      CourseRole `json:"role"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+func (m *CourseMember) IsOwner() bool {
+	return m.Role == CourseRoleOwner
+}
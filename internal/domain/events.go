@@ -15,6 +15,24 @@ var (
 	_ Event = (*UserDeletedEvent)(nil)
 	_ Event = (*PasswordResetRequestedEvent)(nil)
 	_ Event = (*PasswordResetCompletedEvent)(nil)
+	_ Event = (*PasswordChangedEvent)(nil)
+	_ Event = (*EmailVerificationRequestedEvent)(nil)
+	_ Event = (*EmailVerifiedEvent)(nil)
+	_ Event = (*EmailChangeRequestedEvent)(nil)
+	_ Event = (*EmailChangedEvent)(nil)
+	_ Event = (*TwoFactorEnabledEvent)(nil)
+	_ Event = (*TwoFactorDisabledEvent)(nil)
+	_ Event = (*RecoveryCodeUsedEvent)(nil)
+	_ Event = (*RolePolicyUpdatedEvent)(nil)
+	_ Event = (*UserLockedOutEvent)(nil)
+	_ Event = (*UserUnlockedEvent)(nil)
+	_ Event = (*UserRoleChangedEvent)(nil)
+	_ Event = (*UserSuspendedEvent)(nil)
+	_ Event = (*UserReactivatedEvent)(nil)
+	_ Event = (*UserPasswordResetForcedEvent)(nil)
+	_ Event = (*APITokenCreatedEvent)(nil)
+	_ Event = (*APITokenRevokedEvent)(nil)
+	_ Event = (*UserIdentityLinkedEvent)(nil)
 	_ Event = (*ProposalCreatedEvent)(nil)
 	_ Event = (*ProposalUpdatedEvent)(nil)
 	_ Event = (*ProposalSubmittedEvent)(nil)
@@ -23,9 +41,17 @@ var (
 	_ Event = (*ProposalRejectedEvent)(nil)
 	_ Event = (*ProposalChangesRequestedEvent)(nil)
 	_ Event = (*ProposalDeletedEvent)(nil)
+	_ Event = (*ProposalCommentedEvent)(nil)
+	_ Event = (*ProposalReviewerAssignedEvent)(nil)
+	_ Event = (*ProposalReviewerUnassignedEvent)(nil)
+	_ Event = (*ProposalVoteRecordedEvent)(nil)
+	_ Event = (*ProposalReviewReminderEvent)(nil)
 	_ Event = (*CourseCreatedEvent)(nil)
 	_ Event = (*CourseUpdatedEvent)(nil)
 	_ Event = (*CoursePublishedEvent)(nil)
+	_ Event = (*CourseMemberAddedEvent)(nil)
+	_ Event = (*CourseMemberUpdatedEvent)(nil)
+	_ Event = (*CourseMemberRemovedEvent)(nil)
 	_ Event = (*ModuleCreatedEvent)(nil)
 	_ Event = (*ModuleUpdatedEvent)(nil)
 	_ Event = (*ModuleDeletedEvent)(nil)
@@ -35,8 +61,13 @@ var (
 	_ Event = (*ContentDeletedEvent)(nil)
 	_ Event = (*ContentPublishedEvent)(nil)
 	_ Event = (*ContentUnpublishedEvent)(nil)
+	_ Event = (*QuizAttemptSubmittedEvent)(nil)
+	_ Event = (*SubmissionCreatedEvent)(nil)
+	_ Event = (*SubmissionGradedEvent)(nil)
 	_ Event = (*EnrollmentCreatedEvent)(nil)
 	_ Event = (*EnrollmentDeletedEvent)(nil)
+	_ Event = (*ContentCompletedEvent)(nil)
+	_ Event = (*CertificateIssuedEvent)(nil)
 )
 
 type BaseEvent struct {
@@ -145,6 +176,360 @@ func (e *PasswordResetCompletedEvent) EventName() string {
 	return "user.password_reset_completed"
 }
 
+type PasswordChangedEvent struct {
+	BaseEvent
+	UserID int64
+}
+
+func NewPasswordChangedEvent(userID int64) *PasswordChangedEvent {
+	return &PasswordChangedEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+	}
+}
+
+func (e *PasswordChangedEvent) EventName() string {
+	return "user.password_changed"
+}
+
+type EmailVerificationRequestedEvent struct {
+	BaseEvent
+	UserID    int64
+	Email     string
+	Name      string
+	VerifyURL string
+	Token     string
+}
+
+func NewEmailVerificationRequestedEvent(userID int64, email, name, verifyURL, token string) *EmailVerificationRequestedEvent {
+	return &EmailVerificationRequestedEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+		Email:     email,
+		Name:      name,
+		VerifyURL: verifyURL,
+		Token:     token,
+	}
+}
+
+func (e *EmailVerificationRequestedEvent) EventName() string {
+	return "user.email_verification_requested"
+}
+
+type EmailVerifiedEvent struct {
+	BaseEvent
+	UserID int64
+	Email  string
+	Name   string
+}
+
+func NewEmailVerifiedEvent(userID int64, email, name string) *EmailVerifiedEvent {
+	return &EmailVerifiedEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+		Email:     email,
+		Name:      name,
+	}
+}
+
+func (e *EmailVerifiedEvent) EventName() string {
+	return "user.email_verified"
+}
+
+type EmailChangeRequestedEvent struct {
+	BaseEvent
+	UserID     int64
+	Name       string
+	OldEmail   string
+	NewEmail   string
+	ConfirmURL string
+	Token      string
+}
+
+func NewEmailChangeRequestedEvent(userID int64, name, oldEmail, newEmail, confirmURL, token string) *EmailChangeRequestedEvent {
+	return &EmailChangeRequestedEvent{
+		BaseEvent:  NewBaseEvent(),
+		UserID:     userID,
+		Name:       name,
+		OldEmail:   oldEmail,
+		NewEmail:   newEmail,
+		ConfirmURL: confirmURL,
+		Token:      token,
+	}
+}
+
+func (e *EmailChangeRequestedEvent) EventName() string {
+	return "user.email_change_requested"
+}
+
+type EmailChangedEvent struct {
+	BaseEvent
+	UserID   int64
+	Name     string
+	OldEmail string
+	NewEmail string
+}
+
+func NewEmailChangedEvent(userID int64, name, oldEmail, newEmail string) *EmailChangedEvent {
+	return &EmailChangedEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+		Name:      name,
+		OldEmail:  oldEmail,
+		NewEmail:  newEmail,
+	}
+}
+
+func (e *EmailChangedEvent) EventName() string {
+	return "user.email_changed"
+}
+
+type TwoFactorEnabledEvent struct {
+	BaseEvent
+	UserID int64
+}
+
+func NewTwoFactorEnabledEvent(userID int64) *TwoFactorEnabledEvent {
+	return &TwoFactorEnabledEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+	}
+}
+
+func (e *TwoFactorEnabledEvent) EventName() string {
+	return "user.two_factor_enabled"
+}
+
+type TwoFactorDisabledEvent struct {
+	BaseEvent
+	UserID int64
+}
+
+func NewTwoFactorDisabledEvent(userID int64) *TwoFactorDisabledEvent {
+	return &TwoFactorDisabledEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+	}
+}
+
+func (e *TwoFactorDisabledEvent) EventName() string {
+	return "user.two_factor_disabled"
+}
+
+type RecoveryCodeUsedEvent struct {
+	BaseEvent
+	UserID    int64
+	Remaining int
+}
+
+func NewRecoveryCodeUsedEvent(userID int64, remaining int) *RecoveryCodeUsedEvent {
+	return &RecoveryCodeUsedEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+		Remaining: remaining,
+	}
+}
+
+func (e *RecoveryCodeUsedEvent) EventName() string {
+	return "user.recovery_code_used"
+}
+
+type RolePolicyUpdatedEvent struct {
+	BaseEvent
+	Role             SystemRole
+	RequireTwoFactor bool
+	UpdatedBy        int64
+}
+
+func NewRolePolicyUpdatedEvent(role SystemRole, requireTwoFactor bool, updatedBy int64) *RolePolicyUpdatedEvent {
+	return &RolePolicyUpdatedEvent{
+		BaseEvent:        NewBaseEvent(),
+		Role:             role,
+		RequireTwoFactor: requireTwoFactor,
+		UpdatedBy:        updatedBy,
+	}
+}
+
+func (e *RolePolicyUpdatedEvent) EventName() string {
+	return "role_policy.updated"
+}
+
+type UserLockedOutEvent struct {
+	BaseEvent
+	UserID      int64
+	Email       string
+	LockedUntil time.Time
+}
+
+func NewUserLockedOutEvent(userID int64, email string, lockedUntil time.Time) *UserLockedOutEvent {
+	return &UserLockedOutEvent{
+		BaseEvent:   NewBaseEvent(),
+		UserID:      userID,
+		Email:       email,
+		LockedUntil: lockedUntil,
+	}
+}
+
+func (e *UserLockedOutEvent) EventName() string {
+	return "user.locked_out"
+}
+
+type UserUnlockedEvent struct {
+	BaseEvent
+	UserID     int64
+	UnlockedBy int64
+}
+
+func NewUserUnlockedEvent(userID int64, unlockedBy int64) *UserUnlockedEvent {
+	return &UserUnlockedEvent{
+		BaseEvent:  NewBaseEvent(),
+		UserID:     userID,
+		UnlockedBy: unlockedBy,
+	}
+}
+
+func (e *UserUnlockedEvent) EventName() string {
+	return "user.unlocked"
+}
+
+type UserRoleChangedEvent struct {
+	BaseEvent
+	UserID    int64
+	OldRole   SystemRole
+	NewRole   SystemRole
+	ChangedBy int64
+}
+
+func NewUserRoleChangedEvent(userID int64, oldRole, newRole SystemRole, changedBy int64) *UserRoleChangedEvent {
+	return &UserRoleChangedEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+		OldRole:   oldRole,
+		NewRole:   newRole,
+		ChangedBy: changedBy,
+	}
+}
+
+func (e *UserRoleChangedEvent) EventName() string {
+	return "user.role_changed"
+}
+
+type UserSuspendedEvent struct {
+	BaseEvent
+	UserID      int64
+	SuspendedBy int64
+}
+
+func NewUserSuspendedEvent(userID int64, suspendedBy int64) *UserSuspendedEvent {
+	return &UserSuspendedEvent{
+		BaseEvent:   NewBaseEvent(),
+		UserID:      userID,
+		SuspendedBy: suspendedBy,
+	}
+}
+
+func (e *UserSuspendedEvent) EventName() string {
+	return "user.suspended"
+}
+
+type UserReactivatedEvent struct {
+	BaseEvent
+	UserID        int64
+	ReactivatedBy int64
+}
+
+func NewUserReactivatedEvent(userID int64, reactivatedBy int64) *UserReactivatedEvent {
+	return &UserReactivatedEvent{
+		BaseEvent:     NewBaseEvent(),
+		UserID:        userID,
+		ReactivatedBy: reactivatedBy,
+	}
+}
+
+func (e *UserReactivatedEvent) EventName() string {
+	return "user.reactivated"
+}
+
+type UserPasswordResetForcedEvent struct {
+	BaseEvent
+	UserID   int64
+	ForcedBy int64
+}
+
+func NewUserPasswordResetForcedEvent(userID int64, forcedBy int64) *UserPasswordResetForcedEvent {
+	return &UserPasswordResetForcedEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+		ForcedBy:  forcedBy,
+	}
+}
+
+func (e *UserPasswordResetForcedEvent) EventName() string {
+	return "user.password_reset_forced"
+}
+
+type APITokenCreatedEvent struct {
+	BaseEvent
+	UserID  int64
+	TokenID int64
+	Name    string
+	Scopes  []TokenScope
+}
+
+func NewAPITokenCreatedEvent(userID int64, tokenID int64, name string, scopes []TokenScope) *APITokenCreatedEvent {
+	return &APITokenCreatedEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+		TokenID:   tokenID,
+		Name:      name,
+		Scopes:    scopes,
+	}
+}
+
+func (e *APITokenCreatedEvent) EventName() string {
+	return "user.api_token_created"
+}
+
+type APITokenRevokedEvent struct {
+	BaseEvent
+	UserID  int64
+	TokenID int64
+}
+
+func NewAPITokenRevokedEvent(userID int64, tokenID int64) *APITokenRevokedEvent {
+	return &APITokenRevokedEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+		TokenID:   tokenID,
+	}
+}
+
+func (e *APITokenRevokedEvent) EventName() string {
+	return "user.api_token_revoked"
+}
+
+type UserIdentityLinkedEvent struct {
+	BaseEvent
+	UserID  int64
+	Issuer  string
+	Subject string
+	Created bool
+}
+
+func NewUserIdentityLinkedEvent(userID int64, issuer string, subject string, created bool) *UserIdentityLinkedEvent {
+	return &UserIdentityLinkedEvent{
+		BaseEvent: NewBaseEvent(),
+		UserID:    userID,
+		Issuer:    issuer,
+		Subject:   subject,
+		Created:   created,
+	}
+}
+
+func (e *UserIdentityLinkedEvent) EventName() string {
+	return "user.identity_linked"
+}
+
 type ProposalCreatedEvent struct {
 	BaseEvent
 	ProposalID int64
@@ -309,6 +694,110 @@ func (e *ProposalDeletedEvent) EventName() string {
 	return "proposal.deleted"
 }
 
+type ProposalCommentedEvent struct {
+	BaseEvent
+	ProposalID int64
+	CommentID  int64
+	AuthorID   int64
+}
+
+func NewProposalCommentedEvent(proposalID, commentID, authorID int64) *ProposalCommentedEvent {
+	return &ProposalCommentedEvent{
+		BaseEvent:  NewBaseEvent(),
+		ProposalID: proposalID,
+		CommentID:  commentID,
+		AuthorID:   authorID,
+	}
+}
+
+func (e *ProposalCommentedEvent) EventName() string {
+	return "proposal.commented"
+}
+
+type ProposalReviewerAssignedEvent struct {
+	BaseEvent
+	ProposalID int64
+	ReviewerID int64
+	AssignedBy *int64
+	Title      string
+}
+
+func NewProposalReviewerAssignedEvent(proposalID, reviewerID int64, assignedBy *int64, title string) *ProposalReviewerAssignedEvent {
+	return &ProposalReviewerAssignedEvent{
+		BaseEvent:  NewBaseEvent(),
+		ProposalID: proposalID,
+		ReviewerID: reviewerID,
+		AssignedBy: assignedBy,
+		Title:      title,
+	}
+}
+
+func (e *ProposalReviewerAssignedEvent) EventName() string {
+	return "proposal.reviewer_assigned"
+}
+
+type ProposalReviewerUnassignedEvent struct {
+	BaseEvent
+	ProposalID   int64
+	ReviewerID   int64
+	UnassignedBy int64
+}
+
+func NewProposalReviewerUnassignedEvent(proposalID, reviewerID, unassignedBy int64) *ProposalReviewerUnassignedEvent {
+	return &ProposalReviewerUnassignedEvent{
+		BaseEvent:    NewBaseEvent(),
+		ProposalID:   proposalID,
+		ReviewerID:   reviewerID,
+		UnassignedBy: unassignedBy,
+	}
+}
+
+func (e *ProposalReviewerUnassignedEvent) EventName() string {
+	return "proposal.reviewer_unassigned"
+}
+
+type ProposalVoteRecordedEvent struct {
+	BaseEvent
+	ProposalID int64
+	ReviewerID int64
+	Vote       ProposalVote
+}
+
+func NewProposalVoteRecordedEvent(proposalID, reviewerID int64, vote ProposalVote) *ProposalVoteRecordedEvent {
+	return &ProposalVoteRecordedEvent{
+		BaseEvent:  NewBaseEvent(),
+		ProposalID: proposalID,
+		ReviewerID: reviewerID,
+		Vote:       vote,
+	}
+}
+
+func (e *ProposalVoteRecordedEvent) EventName() string {
+	return "proposal.vote_recorded"
+}
+
+type ProposalReviewReminderEvent struct {
+	BaseEvent
+	ProposalID   int64
+	ReviewerID   int64
+	Title        string
+	PendingSince time.Time
+}
+
+func NewProposalReviewReminderEvent(proposalID, reviewerID int64, title string, pendingSince time.Time) *ProposalReviewReminderEvent {
+	return &ProposalReviewReminderEvent{
+		BaseEvent:    NewBaseEvent(),
+		ProposalID:   proposalID,
+		ReviewerID:   reviewerID,
+		Title:        title,
+		PendingSince: pendingSince,
+	}
+}
+
+func (e *ProposalReviewReminderEvent) EventName() string {
+	return "proposal.review_reminder"
+}
+
 type CourseCreatedEvent struct {
 	BaseEvent
 	CourseID     int64
@@ -363,6 +852,70 @@ func (e *CoursePublishedEvent) EventName() string {
 	return "course.published"
 }
 
+type CourseMemberAddedEvent struct {
+	BaseEvent
+	CourseID int64
+	UserID   int64
+	Role     CourseRole
+	ActorID  int64
+}
+
+func NewCourseMemberAddedEvent(courseID, userID int64, role CourseRole, actorID int64) *CourseMemberAddedEvent {
+	return &CourseMemberAddedEvent{
+		BaseEvent: NewBaseEvent(),
+		CourseID:  courseID,
+		UserID:    userID,
+		Role:      role,
+		ActorID:   actorID,
+	}
+}
+
+func (e *CourseMemberAddedEvent) EventName() string {
+	return "course.member_added"
+}
+
+type CourseMemberUpdatedEvent struct {
+	BaseEvent
+	CourseID int64
+	UserID   int64
+	Role     CourseRole
+	ActorID  int64
+}
+
+func NewCourseMemberUpdatedEvent(courseID, userID int64, role CourseRole, actorID int64) *CourseMemberUpdatedEvent {
+	return &CourseMemberUpdatedEvent{
+		BaseEvent: NewBaseEvent(),
+		CourseID:  courseID,
+		UserID:    userID,
+		Role:      role,
+		ActorID:   actorID,
+	}
+}
+
+func (e *CourseMemberUpdatedEvent) EventName() string {
+	return "course.member_updated"
+}
+
+type CourseMemberRemovedEvent struct {
+	BaseEvent
+	CourseID int64
+	UserID   int64
+	ActorID  int64
+}
+
+func NewCourseMemberRemovedEvent(courseID, userID, actorID int64) *CourseMemberRemovedEvent {
+	return &CourseMemberRemovedEvent{
+		BaseEvent: NewBaseEvent(),
+		CourseID:  courseID,
+		UserID:    userID,
+		ActorID:   actorID,
+	}
+}
+
+func (e *CourseMemberRemovedEvent) EventName() string {
+	return "course.member_removed"
+}
+
 type ModuleCreatedEvent struct {
 	BaseEvent
 	ModuleID     int64
@@ -563,6 +1116,94 @@ func (e *ContentUnpublishedEvent) EventName() string {
 	return "content.unpublished"
 }
 
+type QuizAttemptSubmittedEvent struct {
+	BaseEvent
+	AttemptID int64
+	QuizID    int64
+	ModuleID  int64
+	CourseID  int64
+	UserID    int64
+	Score     int
+	MaxScore  int
+	Passed    bool
+}
+
+func NewQuizAttemptSubmittedEvent(attemptID, quizID, moduleID, courseID, userID int64, score, maxScore int, passed bool) *QuizAttemptSubmittedEvent {
+	return &QuizAttemptSubmittedEvent{
+		BaseEvent: NewBaseEvent(),
+		AttemptID: attemptID,
+		QuizID:    quizID,
+		ModuleID:  moduleID,
+		CourseID:  courseID,
+		UserID:    userID,
+		Score:     score,
+		MaxScore:  maxScore,
+		Passed:    passed,
+	}
+}
+
+func (e *QuizAttemptSubmittedEvent) EventName() string {
+	return "quiz.attempt_submitted"
+}
+
+type SubmissionCreatedEvent struct {
+	BaseEvent
+	SubmissionID int64
+	AssignmentID int64
+	ModuleID     int64
+	CourseID     int64
+	UserID       int64
+	InstructorID int64
+}
+
+func NewSubmissionCreatedEvent(submissionID, assignmentID, moduleID, courseID, userID, instructorID int64) *SubmissionCreatedEvent {
+	return &SubmissionCreatedEvent{
+		BaseEvent:    NewBaseEvent(),
+		SubmissionID: submissionID,
+		AssignmentID: assignmentID,
+		ModuleID:     moduleID,
+		CourseID:     courseID,
+		UserID:       userID,
+		InstructorID: instructorID,
+	}
+}
+
+func (e *SubmissionCreatedEvent) EventName() string {
+	return "submission.created"
+}
+
+type SubmissionGradedEvent struct {
+	BaseEvent
+	SubmissionID int64
+	AssignmentID int64
+	ModuleID     int64
+	CourseID     int64
+	UserID       int64
+	GraderID     int64
+	Grade        int
+	MaxPoints    int
+	Feedback     string
+}
+
+func NewSubmissionGradedEvent(submissionID, assignmentID, moduleID, courseID, userID, graderID int64, grade, maxPoints int, feedback string) *SubmissionGradedEvent {
+	return &SubmissionGradedEvent{
+		BaseEvent:    NewBaseEvent(),
+		SubmissionID: submissionID,
+		AssignmentID: assignmentID,
+		ModuleID:     moduleID,
+		CourseID:     courseID,
+		UserID:       userID,
+		GraderID:     graderID,
+		Grade:        grade,
+		MaxPoints:    maxPoints,
+		Feedback:     feedback,
+	}
+}
+
+func (e *SubmissionGradedEvent) EventName() string {
+	return "submission.graded"
+}
+
 type EnrollmentCreatedEvent struct {
 	BaseEvent
 	UserID   int64
@@ -598,3 +1239,49 @@ func NewEnrollmentDeletedEvent(userID, courseID int64) *EnrollmentDeletedEvent {
 func (e *EnrollmentDeletedEvent) EventName() string {
 	return "enrollment.deleted"
 }
+
+type ContentCompletedEvent struct {
+	BaseEvent
+	UserID      int64
+	CourseID    int64
+	ModuleID    int64
+	ContentID   int64
+	ContentType ContentType
+}
+
+func NewContentCompletedEvent(userID, courseID, moduleID, contentID int64, contentType ContentType) *ContentCompletedEvent {
+	return &ContentCompletedEvent{
+		BaseEvent:   NewBaseEvent(),
+		UserID:      userID,
+		CourseID:    courseID,
+		ModuleID:    moduleID,
+		ContentID:   contentID,
+		ContentType: contentType,
+	}
+}
+
+func (e *ContentCompletedEvent) EventName() string {
+	return "progress.content_completed"
+}
+
+type CertificateIssuedEvent struct {
+	BaseEvent
+	CertificateID int64
+	UserID        int64
+	CourseID      int64
+	Code          string
+}
+
+func NewCertificateIssuedEvent(certificateID, userID, courseID int64, code string) *CertificateIssuedEvent {
+	return &CertificateIssuedEvent{
+		BaseEvent:     NewBaseEvent(),
+		CertificateID: certificateID,
+		UserID:        userID,
+		CourseID:      courseID,
+		Code:          code,
+	}
+}
+
+func (e *CertificateIssuedEvent) EventName() string {
+	return "certificate.issued"
+}
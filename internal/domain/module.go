@@ -11,13 +11,60 @@ const (
 	ModuleStatusPublished ModuleStatus = "published"
 )
 
+type ModuleUnlockRule string
+
+const (
+	ModuleUnlockImmediately   ModuleUnlockRule = "immediately"
+	ModuleUnlockAfterDays     ModuleUnlockRule = "after_days"
+	ModuleUnlockOnDate        ModuleUnlockRule = "on_date"
+	ModuleUnlockAfterPrevious ModuleUnlockRule = "after_previous"
+)
+
+func (r ModuleUnlockRule) IsValid() bool {
+	switch r {
+	case ModuleUnlockImmediately, ModuleUnlockAfterDays, ModuleUnlockOnDate, ModuleUnlockAfterPrevious:
+		return true
+	default:
+		return false
+	}
+}
+
 type Module struct {
-	ID          int64        `json:"id"`
-	CourseID    int64        `json:"course_id"`
-	Title       string       `json:"title"`
-	Description string       `json:"description"`
-	Order       int          `json:"order"`
-	Status      ModuleStatus `json:"status"`
-	CreatedAt   time.Time    `json:"created_at"`
-	UpdatedAt   time.Time    `json:"updated_at"`
+	ID          int64            `json:"id"`
+	CourseID    int64            `json:"course_id"`
+	Title       string           `json:"title"`
+	Description string           `json:"description"`
+	Order       int              `json:"order"`
+	Status      ModuleStatus     `json:"status"`
+	UnlockRule  ModuleUnlockRule `json:"unlock_rule"`
+	UnlockDays  int              `json:"unlock_days"`
+	UnlockAt    *time.Time       `json:"unlock_at,omitempty"`
+	Locked      bool             `json:"locked"`
+	AvailableAt *time.Time       `json:"available_at,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+func (m *Module) UnlocksAt(enrolledAt time.Time) *time.Time {
+	switch m.UnlockRule {
+	case ModuleUnlockAfterDays:
+		t := enrolledAt.AddDate(0, 0, m.UnlockDays)
+		return &t
+	case ModuleUnlockOnDate:
+		return m.UnlockAt
+	default:
+		return nil
+	}
+}
+
+func (m *Module) IsUnlocked(enrolledAt, now time.Time, previousCompleted bool) bool {
+	switch m.UnlockRule {
+	case ModuleUnlockAfterDays, ModuleUnlockOnDate:
+		t := m.UnlocksAt(enrolledAt)
+		return t == nil || !now.Before(*t)
+	case ModuleUnlockAfterPrevious:
+		return previousCompleted
+	default:
+		return true
+	}
 }
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+)
+
+type ContentCompletion struct {
+	UserID      int64       `json:"user_id"`
+	CourseID    int64       `json:"course_id"`
+	ModuleID    int64       `json:"module_id"`
+	ContentID   int64       `json:"content_id"`
+	ContentType ContentType `json:"content_type"`
+	CompletedAt time.Time   `json:"completed_at"`
+}
+
+type ModuleProgress struct {
+	ModuleID  int64 `json:"module_id"`
+	Completed int   `json:"completed"`
+	Total     int   `json:"total"`
+	Percent   int   `json:"percent"`
+}
+
+func NewModuleProgress(moduleID int64, completed, total int) ModuleProgress {
+	return ModuleProgress{
+		ModuleID:  moduleID,
+		Completed: completed,
+		Total:     total,
+		Percent:   completionPercent(completed, total),
+	}
+}
+
+type CourseProgress struct {
+	CourseID  int64            `json:"course_id"`
+	Completed int              `json:"completed"`
+	Total     int              `json:"total"`
+	Percent   int              `json:"percent"`
+	Modules   []ModuleProgress `json:"modules"`
+}
+
+func NewCourseProgress(courseID int64, modules []ModuleProgress) *CourseProgress {
+	p := &CourseProgress{
+		CourseID: courseID,
+		Modules:  modules,
+	}
+	for _, m := range modules {
+		p.Completed += m.Completed
+		p.Total += m.Total
+	}
+	p.Percent = completionPercent(p.Completed, p.Total)
+	return p
+}
+
+func (p *CourseProgress) IsComplete() bool {
+	return p.Total > 0 && p.Completed >= p.Total
+}
+
+func (p *CourseProgress) Module(moduleID int64) ModuleProgress {
+	for _, m := range p.Modules {
+		if m.ModuleID == moduleID {
+			return m
+		}
+	}
+	return NewModuleProgress(moduleID, 0, 0)
+}
+
+func completionPercent(completed, total int) int {
+	if total == 0 {
+		return 0
+	}
+	return completed * 100 / total
+}
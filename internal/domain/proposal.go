@@ -16,20 +16,31 @@ const (
 )
 
 type Proposal struct {
-	ID                   int64          `json:"id"`
-	Title                string         `json:"title"`
-	Summary              string         `json:"summary"`
-	Qualifications       string         `json:"qualifications"`
-	TargetAudience       string         `json:"target_audience"`
-	LearningObjectives   string         `json:"learning_objectives"`
-	Outline              string         `json:"outline"`
-	AssumedPrerequisites string         `json:"assumed_prerequisites"`
-	AuthorID             int64          `json:"author_id"`
-	ReviewNotes          string         `json:"review_notes"`
-	ReviewerID           *int64         `json:"reviewer_id"`
-	CreatedAt            time.Time      `json:"created_at"`
-	UpdatedAt            time.Time      `json:"updated_at"`
-	Status               ProposalStatus `json:"status"`
+	ID                   int64           `json:"id"`
+	Title                string          `json:"title"`
+	Summary              string          `json:"summary"`
+	Qualifications       string          `json:"qualifications"`
+	TargetAudience       string          `json:"target_audience"`
+	LearningObjectives   string          `json:"learning_objectives"`
+	Outline              string          `json:"outline"`
+	OutlineModules       []OutlineModule `json:"outline_modules"`
+	AssumedPrerequisites string          `json:"assumed_prerequisites"`
+	AuthorID             int64           `json:"author_id"`
+	ReviewNotes          string          `json:"review_notes"`
+	ReviewerID           *int64          `json:"reviewer_id"`
+	CreatedAt            time.Time       `json:"created_at"`
+	UpdatedAt            time.Time       `json:"updated_at"`
+	SubmittedAt          *time.Time      `json:"submitted_at,omitempty"`
+	Status               ProposalStatus  `json:"status"`
+}
+
+// OutlineModule is one module planned in a proposal's structured outline.
+// Items are the titles of the lessons the module is expected to hold; each
+// becomes a placeholder reading when the course is created.
+type OutlineModule struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Items       []string `json:"items"`
 }
 
 func (p *Proposal) WasSubmitted() bool {
@@ -39,15 +50,119 @@ func (p *Proposal) WasSubmitted() bool {
 		p.Status == ProposalStatusChangesRequested
 }
 
-func (p *Proposal) IsOwnedBy(u *User) bool {
-	return p.AuthorID == u.ID
+// QueuedAt is when the proposal joined the review queue: its last
+// submission, or its creation if no submission time was recorded.
+func (p *Proposal) QueuedAt() time.Time {
+	if p.SubmittedAt != nil {
+		return *p.SubmittedAt
+	}
+	return p.CreatedAt
 }
 
-func (p *Proposal) IsViewableBy(u *User) bool {
-	return p.IsOwnedBy(u) || (u.IsAdmin() && p.WasSubmitted())
+func (p *Proposal) IsOwnedBy(u *User) bool {
+	return p.AuthorID == u.ID
 }
 
 func (p *Proposal) IsAmendable() bool {
 	return p.Status == ProposalStatusDraft ||
 		p.Status == ProposalStatusChangesRequested
 }
+
+// ProposalVersion is a snapshot of a proposal's fields taken each time it is
+// submitted for review. Versions are numbered from 1 per proposal.
+type ProposalVersion struct {
+	ID                   int64           `json:"id"`
+	ProposalID           int64           `json:"proposal_id"`
+	Number               int             `json:"number"`
+	Title                string          `json:"title"`
+	Summary              string          `json:"summary"`
+	Qualifications       string          `json:"qualifications"`
+	TargetAudience       string          `json:"target_audience"`
+	LearningObjectives   string          `json:"learning_objectives"`
+	Outline              string          `json:"outline"`
+	OutlineModules       []OutlineModule `json:"outline_modules"`
+	AssumedPrerequisites string          `json:"assumed_prerequisites"`
+	SubmittedAt          time.Time       `json:"submitted_at"`
+}
+
+func NewProposalVersion(p *Proposal) *ProposalVersion {
+	return &ProposalVersion{
+		ProposalID:           p.ID,
+		Title:                p.Title,
+		Summary:              p.Summary,
+		Qualifications:       p.Qualifications,
+		TargetAudience:       p.TargetAudience,
+		LearningObjectives:   p.LearningObjectives,
+		Outline:              p.Outline,
+		OutlineModules:       p.OutlineModules,
+		AssumedPrerequisites: p.AssumedPrerequisites,
+	}
+}
+
+// ProposalAttachment is a file, such as sample slides or a syllabus, that
+// the author attached to a proposal for reviewers.
+type ProposalAttachment struct {
+	ID          int64     `json:"id"`
+	ProposalID  int64     `json:"proposal_id"`
+	FileName    string    `json:"file_name"`
+	FileSize    int64     `json:"file_size"`
+	MimeType    string    `json:"mime_type"`
+	StoragePath string    `json:"-"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// ReviewableProposalStatuses are the statuses of proposals that have been
+// submitted and are visible to reviewers.
+var ReviewableProposalStatuses = []ProposalStatus{
+	ProposalStatusSubmitted,
+	ProposalStatusChangesRequested,
+	ProposalStatusApproved,
+	ProposalStatusRejected,
+}
+
+type ProposalSort string
+
+const (
+	ProposalSortUpdated   ProposalSort = "updated"
+	ProposalSortSubmitted ProposalSort = "submitted"
+)
+
+// ProposalFilter selects proposals for the review queue. Statuses must be
+// reviewable; an empty list means all of them. Author is matched against the
+// author's name and email.
+type ProposalFilter struct {
+	Statuses  []ProposalStatus
+	Author    string
+	Sort      ProposalSort
+	Ascending bool
+	After     *ProposalCursor
+	Limit     int
+}
+
+type ProposalCursor struct {
+	Sort      ProposalSort `json:"s"`
+	Ascending bool         `json:"a,omitempty"`
+	ID        int64        `json:"id"`
+	At        time.Time    `json:"t"`
+}
+
+type ProposalCommentKind string
+
+const (
+	ProposalCommentKindReview ProposalCommentKind = "review"
+	ProposalCommentKindReply  ProposalCommentKind = "reply"
+)
+
+// ProposalComment is one entry in a proposal's review conversation. Review
+// entries carry the reviewer's vote; Status is the proposal's status once the
+// entry was recorded, so the entry that settled a review shows the decision.
+type ProposalComment struct {
+	ID         int64               `json:"id"`
+	ProposalID int64               `json:"proposal_id"`
+	AuthorID   int64               `json:"author_id"`
+	Kind       ProposalCommentKind `json:"kind"`
+	Body       string              `json:"body"`
+	Vote       ProposalVote        `json:"vote,omitempty"`
+	Status     ProposalStatus      `json:"status"`
+	CreatedAt  time.Time           `json:"created_at"`
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"time"
+)
+
+type ProposalVote string
+
+const (
+	ProposalVoteApprove        ProposalVote = "approve"
+	ProposalVoteReject         ProposalVote = "reject"
+	ProposalVoteRequestChanges ProposalVote = "request_changes"
+)
+
+func IsValidProposalVote(vote ProposalVote) bool {
+	return vote == ProposalVoteApprove || vote == ProposalVoteReject || vote == ProposalVoteRequestChanges
+}
+
+// ProposalAssignment puts a reviewer on a proposal. Vote is empty until the
+// reviewer has voted in the current review round.
+type ProposalAssignment struct {
+	ProposalID int64        `json:"proposal_id"`
+	ReviewerID int64        `json:"reviewer_id"`
+	AssignedBy *int64       `json:"assigned_by,omitempty"`
+	Vote       ProposalVote `json:"vote,omitempty"`
+	Notes      string       `json:"notes"`
+	AssignedAt time.Time    `json:"assigned_at"`
+	VotedAt    *time.Time   `json:"voted_at,omitempty"`
+	// PendingSince is when the reviewer's vote became due: the assignment
+	// itself, or the start of a later review round.
+	PendingSince time.Time  `json:"pending_since"`
+	RemindedAt   *time.Time `json:"reminded_at,omitempty"`
+}
+
+func (a *ProposalAssignment) HasVoted() bool {
+	return a.Vote != ""
+}
+
+// ReviewQuorum decides a proposal once enough assigned reviewers have voted.
+// A strict majority of approvals approves and a strict majority of
+// rejections rejects; anything else sends the proposal back for changes.
+type ReviewQuorum struct {
+	// Votes is how many votes are needed before a decision is made. It is
+	// capped at the number of assigned reviewers so a proposal cannot stall.
+	Votes int
+}
+
+// Decide returns the proposal's final status, or false while more votes are
+// still needed.
+func (q ReviewQuorum) Decide(assignments []ProposalAssignment) (ProposalStatus, bool) {
+	required := q.Votes
+	if required < 1 {
+		required = 1
+	}
+	if required > len(assignments) {
+		required = len(assignments)
+	}
+
+	var cast, approvals, rejections int
+	for i := range assignments {
+		switch assignments[i].Vote {
+		case ProposalVoteApprove:
+			approvals++
+		case ProposalVoteReject:
+			rejections++
+		case "":
+			continue
+		}
+		cast++
+	}
+	if cast == 0 || cast < required {
+		return "", false
+	}
+
+	switch {
+	case approvals*2 > cast:
+		return ProposalStatusApproved, true
+	case rejections*2 > cast:
+		return ProposalStatusRejected, true
+	default:
+		return ProposalStatusChangesRequested, true
+	}
+}
@@ -0,0 +1,27 @@
+package domain
+
+type SearchResultType string
+
+const (
+	SearchResultCourse  SearchResultType = "course"
+	SearchResultModule  SearchResultType = "module"
+	SearchResultReading SearchResultType = "reading"
+)
+
+type SearchResult struct {
+	Type        SearchResultType `json:"type"`
+	ID          int64            `json:"id"`
+	CourseID    int64            `json:"course_id"`
+	ModuleID    int64            `json:"module_id,omitempty"`
+	Title       string           `json:"title"`
+	CourseTitle string           `json:"course_title"`
+	URL         string           `json:"url"`
+	Snippet     []SnippetPart    `json:"snippet"`
+	Rank        float64          `json:"rank"`
+	Text        string           `json:"-"`
+}
+
+type SnippetPart struct {
+	Text  string `json:"text"`
+	Match bool   `json:"match,omitempty"`
+}
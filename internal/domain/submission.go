@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"time"
+)
+
+type SubmissionStatus string
+
+const (
+	SubmissionStatusSubmitted SubmissionStatus = "submitted"
+	SubmissionStatusGraded    SubmissionStatus = "graded"
+)
+
+type Submission struct {
+	ID           int64            `json:"id"`
+	AssignmentID int64            `json:"assignment_id"`
+	UserID       int64            `json:"user_id"`
+	Text         string           `json:"text,omitempty"`
+	FileName     string           `json:"file_name,omitempty"`
+	FileSize     int64            `json:"file_size,omitempty"`
+	MimeType     string           `json:"mime_type,omitempty"`
+	StoragePath  string           `json:"-"`
+	Late         bool             `json:"late"`
+	Status       SubmissionStatus `json:"status"`
+	Grade        *int             `json:"grade,omitempty"`
+	Feedback     string           `json:"feedback,omitempty"`
+	GradedBy     *int64           `json:"graded_by,omitempty"`
+	GradedAt     *time.Time       `json:"graded_at,omitempty"`
+	SubmittedAt  time.Time        `json:"submitted_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+func (s *Submission) HasFile() bool {
+	return s.StoragePath != ""
+}
+
+func (s *Submission) IsGraded() bool {
+	return s.Status == SubmissionStatusGraded
+}
@@ -7,8 +7,9 @@ import (
 type SystemRole string
 
 const (
-	SystemRoleUser  SystemRole = "user"
-	SystemRoleAdmin SystemRole = "admin"
+	SystemRoleUser     SystemRole = "user"
+	SystemRoleReviewer SystemRole = "reviewer"
+	SystemRoleAdmin    SystemRole = "admin"
 )
 
 type User struct {
@@ -17,9 +18,45 @@ type User struct {
 	Name         string     `json:"name"`
 	PasswordHash []byte     `json:"-"`
 	Role         SystemRole `json:"role"`
+	TOTPSecret   string     `json:"-"`
+	TOTPEnabled  bool       `json:"totp_enabled"`
+	TOTPLastStep int64      `json:"-"`
+	VerifiedAt   *time.Time `json:"verified_at,omitempty"`
+	SuspendedAt  *time.Time `json:"suspended_at,omitempty"`
 	CreatedAt    time.Time  `json:"created_at"`
 }
 
 func (u *User) IsAdmin() bool {
 	return u.Role == SystemRoleAdmin
 }
+
+func (u *User) IsVerified() bool {
+	return u.VerifiedAt != nil
+}
+
+func (u *User) IsSuspended() bool {
+	return u.SuspendedAt != nil
+}
+
+// UserFilter selects users for the admin listing. Search matches the email or
+// name case-insensitively; results are ordered newest first.
+type UserFilter struct {
+	Search string
+	Role   SystemRole
+	After  *UserCursor
+	Limit  int
+}
+
+type UserCursor struct {
+	ID int64 `json:"id"`
+}
+
+type RolePolicy struct {
+	Role             SystemRole `json:"role"`
+	RequireTwoFactor bool       `json:"require_two_factor"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+func IsValidSystemRole(role SystemRole) bool {
+	return role == SystemRoleUser || role == SystemRoleReviewer || role == SystemRoleAdmin
+}
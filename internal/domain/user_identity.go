@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// UserIdentity links a local user to an account at an external OpenID
+// Connect provider, keyed by the provider's issuer and subject.
+type UserIdentity struct {
+	ID        int64     `json:"id"`
+	UserID    int64     `json:"user_id"`
+	Issuer    string    `json:"issuer"`
+	Subject   string    `json:"subject"`
+	Email     string    `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
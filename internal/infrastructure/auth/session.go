@@ -3,10 +3,17 @@ package auth
 import (
 	"crypto/rand"
 	"encoding/hex"
+	"sort"
 	"sync"
 	"time"
+
+	"bytecourses/internal/pkg/errors"
 )
 
+// SessionTouchInterval is the resolution of a session's last-seen time;
+// stores skip the write when the previous touch is more recent than this.
+const SessionTouchInterval = time.Minute
+
 type SessionStore interface {
 	Create(userID int64) (sessionID string, err error)
 	Get(sessionID string) (userID int64, ok bool)
@@ -15,6 +22,28 @@ type SessionStore interface {
 	SetCSRFToken(sessionID, token string) error
 	GetCSRFToken(sessionID string) (string, bool)
 	DeleteCSRFToken(sessionID string) error
+	SetClientInfo(sessionID, ip, userAgent string) error
+	Touch(sessionID string, now time.Time) error
+	ListByUserID(userID int64) ([]SessionInfo, error)
+	DeleteByPublicID(userID int64, publicID string) error
+	DeleteOthersByUserID(userID int64, keepSessionID string) error
+}
+
+// SessionInfo describes a session to its owner. ID is the public identifier
+// from SessionPublicID, never the session secret itself.
+type SessionInfo struct {
+	ID         string    `json:"id"`
+	IP         string    `json:"ip"`
+	UserAgent  string    `json:"user_agent"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Current    bool      `json:"current"`
+}
+
+func SessionPublicID(sessionID string) string {
+	hash := HashToken(sessionID)
+	return hex.EncodeToString(hash[:])
 }
 
 var (
@@ -22,9 +51,13 @@ var (
 )
 
 type session struct {
-	userID    int64
-	expiresAt time.Time
-	csrfToken string
+	userID     int64
+	expiresAt  time.Time
+	csrfToken  string
+	ip         string
+	userAgent  string
+	createdAt  time.Time
+	lastSeenAt time.Time
 }
 
 type InMemorySessionStore struct {
@@ -49,10 +82,13 @@ func (s *InMemorySessionStore) Create(userID int64) (string, error) {
 	}
 	sessionID := hex.EncodeToString(tokenBytes)
 
+	now := time.Now()
 	s.mu.Lock()
 	s.sessions[sessionID] = session{
-		userID:    userID,
-		expiresAt: time.Now().Add(s.ttl),
+		userID:     userID,
+		expiresAt:  now.Add(s.ttl),
+		createdAt:  now,
+		lastSeenAt: now,
 	}
 	s.mu.Unlock()
 
@@ -134,6 +170,89 @@ func (s *InMemorySessionStore) DeleteCSRFToken(sessionID string) error {
 	return nil
 }
 
+func (s *InMemorySessionStore) SetClientInfo(sessionID, ip, userAgent string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.sessions[sessionID]
+	if !ok {
+		return nil
+	}
+
+	sess.ip = ip
+	sess.userAgent = userAgent
+	s.sessions[sessionID] = sess
+	return nil
+}
+
+func (s *InMemorySessionStore) Touch(sessionID string, now time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.sessions[sessionID]
+	if !ok || now.Sub(sess.lastSeenAt) < SessionTouchInterval {
+		return nil
+	}
+
+	sess.lastSeenAt = now
+	s.sessions[sessionID] = sess
+	return nil
+}
+
+func (s *InMemorySessionStore) ListByUserID(userID int64) ([]SessionInfo, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	now := time.Now()
+	result := make([]SessionInfo, 0)
+	for id, sess := range s.sessions {
+		if sess.userID != userID || now.After(sess.expiresAt) {
+			continue
+		}
+		result = append(result, SessionInfo{
+			ID:         SessionPublicID(id),
+			IP:         sess.ip,
+			UserAgent:  sess.userAgent,
+			CreatedAt:  sess.createdAt,
+			LastSeenAt: sess.lastSeenAt,
+			ExpiresAt:  sess.expiresAt,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].LastSeenAt.After(result[j].LastSeenAt)
+	})
+
+	return result, nil
+}
+
+func (s *InMemorySessionStore) DeleteByPublicID(userID int64, publicID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, sess := range s.sessions {
+		if sess.userID == userID && SessionPublicID(id) == publicID {
+			delete(s.sessions, id)
+			return nil
+		}
+	}
+
+	return errors.ErrNotFound
+}
+
+func (s *InMemorySessionStore) DeleteOthersByUserID(userID int64, keepSessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, sess := range s.sessions {
+		if sess.userID == userID && id != keepSessionID {
+			delete(s.sessions, id)
+		}
+	}
+
+	return nil
+}
+
 func (s *InMemorySessionStore) cleanup() {
 	ticker := time.NewTicker(5 * time.Minute)
 	for range ticker.C {
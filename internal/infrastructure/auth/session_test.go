@@ -76,3 +76,72 @@ func TestExpiration(t *testing.T) {
 		t.Fatalf("Get: session should be expired")
 	}
 }
+
+func TestListByUserID(t *testing.T) {
+	store := NewInMemorySessionStore(1 * time.Hour)
+
+	sessionID, err := store.Create(1)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if _, err := store.Create(2); err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	if err := store.SetClientInfo(sessionID, "203.0.113.7", "Firefox"); err != nil {
+		t.Fatalf("SetClientInfo failed: %v", err)
+	}
+
+	list, err := store.ListByUserID(1)
+	if err != nil {
+		t.Fatalf("ListByUserID failed: %v", err)
+	}
+	if len(list) != 1 {
+		t.Fatalf("ListByUserID: expected 1 session, got %d", len(list))
+	}
+	if list[0].ID != SessionPublicID(sessionID) || list[0].ID == sessionID {
+		t.Fatalf("ListByUserID: expected public ID, got %q", list[0].ID)
+	}
+	if list[0].IP != "203.0.113.7" || list[0].UserAgent != "Firefox" {
+		t.Fatalf("ListByUserID: unexpected client info %+v", list[0])
+	}
+}
+
+func TestDeleteByPublicID(t *testing.T) {
+	store := NewInMemorySessionStore(1 * time.Hour)
+
+	sessionID, err := store.Create(1)
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+
+	if err := store.DeleteByPublicID(2, SessionPublicID(sessionID)); err == nil {
+		t.Fatalf("DeleteByPublicID: expected error for another user's session")
+	}
+	if err := store.DeleteByPublicID(1, SessionPublicID(sessionID)); err != nil {
+		t.Fatalf("DeleteByPublicID failed: %v", err)
+	}
+	if _, ok := store.Get(sessionID); ok {
+		t.Fatalf("Get: session should not exist after DeleteByPublicID")
+	}
+}
+
+func TestDeleteOthersByUserID(t *testing.T) {
+	store := NewInMemorySessionStore(1 * time.Hour)
+
+	current, _ := store.Create(1)
+	stale, _ := store.Create(1)
+	other, _ := store.Create(2)
+
+	if err := store.DeleteOthersByUserID(1, current); err != nil {
+		t.Fatalf("DeleteOthersByUserID failed: %v", err)
+	}
+	if _, ok := store.Get(current); !ok {
+		t.Fatalf("Get: current session should remain")
+	}
+	if _, ok := store.Get(stale); ok {
+		t.Fatalf("Get: other session should be deleted")
+	}
+	if _, ok := store.Get(other); !ok {
+		t.Fatalf("Get: other user's session should remain")
+	}
+}
@@ -0,0 +1,86 @@
+package email
+
+import (
+	"context"
+	"log/slog"
+)
+
+var (
+	_ Sender = (*LogSender)(nil)
+)
+
+// LogSender writes each email to the log instead of delivering it, so that
+// links and tokens can be followed during development and end-to-end tests.
+// It must not be used in production, since the log then holds credentials.
+type LogSender struct {
+	logger *slog.Logger
+}
+
+func NewLogSender(logger *slog.Logger) *LogSender {
+	return &LogSender{logger: logger}
+}
+
+func (s *LogSender) log(ctx context.Context, kind, to string, args ...any) error {
+	s.logger.InfoContext(ctx, "email", append([]any{"kind", kind, "to", to}, args...)...)
+	return nil
+}
+
+func (s *LogSender) SendWelcomeEmail(ctx context.Context, email, name, getStartedURL string) error {
+	return s.log(ctx, "welcome", email, "url", getStartedURL)
+}
+
+func (s *LogSender) SendPasswordResetEmail(ctx context.Context, email, baseURL, token string) error {
+	return s.log(ctx, "password_reset", email, "url", baseURL, "token", token)
+}
+
+func (s *LogSender) SendVerificationEmail(ctx context.Context, email, name, verifyURL, token string) error {
+	return s.log(ctx, "verification", email, "url", verifyURL, "token", token)
+}
+
+func (s *LogSender) SendEmailChangeConfirmationEmail(ctx context.Context, email, name, confirmURL, token string) error {
+	return s.log(ctx, "email_change_confirmation", email, "url", confirmURL, "token", token)
+}
+
+func (s *LogSender) SendEmailChangeNoticeEmail(ctx context.Context, email, name, newEmail string) error {
+	return s.log(ctx, "email_change_notice", email, "new_email", newEmail)
+}
+
+func (s *LogSender) SendProposalSubmittedEmail(ctx context.Context, email, name, title, proposalURL string) error {
+	return s.log(ctx, "proposal_submitted", email, "url", proposalURL)
+}
+
+func (s *LogSender) SendProposalApprovedEmail(ctx context.Context, email, name, title, courseURL string) error {
+	return s.log(ctx, "proposal_approved", email, "url", courseURL)
+}
+
+func (s *LogSender) SendProposalRejectedEmail(ctx context.Context, email, name, title, reviewNotes, newProposalURL string) error {
+	return s.log(ctx, "proposal_rejected", email, "url", newProposalURL)
+}
+
+func (s *LogSender) SendProposalChangesRequestedEmail(ctx context.Context, email, name, title, reviewNotes, proposalURL string) error {
+	return s.log(ctx, "proposal_changes_requested", email, "url", proposalURL)
+}
+
+func (s *LogSender) SendProposalReviewAssignedEmail(ctx context.Context, email, name, title, reviewURL string) error {
+	return s.log(ctx, "proposal_review_assigned", email, "url", reviewURL)
+}
+
+func (s *LogSender) SendProposalReviewReminderEmail(ctx context.Context, email, name, title, reviewURL string) error {
+	return s.log(ctx, "proposal_review_reminder", email, "url", reviewURL)
+}
+
+func (s *LogSender) SendEnrollmentConfirmationEmail(ctx context.Context, email, name, courseTitle, courseURL string) error {
+	return s.log(ctx, "enrollment_confirmation", email, "url", courseURL)
+}
+
+func (s *LogSender) SendSubmissionReceivedEmail(ctx context.Context, email, name, learnerName, assignmentTitle, submissionURL string) error {
+	return s.log(ctx, "submission_received", email, "url", submissionURL)
+}
+
+func (s *LogSender) SendSubmissionGradedEmail(ctx context.Context, email, name, assignmentTitle string, grade, maxPoints int, feedback, submissionURL string) error {
+	return s.log(ctx, "submission_graded", email, "url", submissionURL)
+}
+
+func (s *LogSender) SendCertificateIssuedEmail(ctx context.Context, email, name, courseTitle, code, certificateURL string) error {
+	return s.log(ctx, "certificate_issued", email, "url", certificateURL)
+}
@@ -106,6 +106,71 @@ func (s *ResendSender) SendPasswordResetEmail(ctx context.Context, email, resetU
 	return s.sendEmail(ctx, email, subject, buf.String())
 }
 
+func (s *ResendSender) SendVerificationEmail(ctx context.Context, email, name, verifyURL, token string) error {
+	subject := "Verify Your Email Address"
+
+	u, err := url.Parse(verifyURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("resend: invalid base url %s", verifyURL)
+	}
+
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+	verifyURL = u.String()
+
+	var buf bytes.Buffer
+	data := struct {
+		Name      string
+		VerifyURL string
+	}{Name: name, VerifyURL: verifyURL}
+	if err := verifyEmailTemplate.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute verify email template: %w", err)
+	}
+
+	return s.sendEmail(ctx, email, subject, buf.String())
+}
+
+func (s *ResendSender) SendEmailChangeConfirmationEmail(ctx context.Context, email, name, confirmURL, token string) error {
+	subject := "Confirm Your New Email Address"
+
+	u, err := url.Parse(confirmURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf("resend: invalid base url %s", confirmURL)
+	}
+
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+	confirmURL = u.String()
+
+	var buf bytes.Buffer
+	data := struct {
+		Name       string
+		ConfirmURL string
+	}{Name: name, ConfirmURL: confirmURL}
+	if err := emailChangeConfirmTemplate.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute email change confirm template: %w", err)
+	}
+
+	return s.sendEmail(ctx, email, subject, buf.String())
+}
+
+func (s *ResendSender) SendEmailChangeNoticeEmail(ctx context.Context, email, name, newEmail string) error {
+	subject := "Email Change Requested"
+
+	var buf bytes.Buffer
+	data := struct {
+		Name     string
+		NewEmail string
+	}{Name: name, NewEmail: newEmail}
+	if err := emailChangeNoticeTemplate.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute email change notice template: %w", err)
+	}
+
+	return s.sendEmail(ctx, email, subject, buf.String())
+}
+
 func (s *ResendSender) SendProposalSubmittedEmail(ctx context.Context, email, name, title, proposalURL string) error {
 	subject := "Proposal Submitted"
 	var buf bytes.Buffer
@@ -164,6 +229,28 @@ func (s *ResendSender) SendProposalChangesRequestedEmail(ctx context.Context, em
 	return s.sendEmail(ctx, email, subject, buf.String())
 }
 
+func (s *ResendSender) SendProposalReviewAssignedEmail(ctx context.Context, email, name, title, reviewURL string) error {
+	return s.sendProposalReviewEmail(ctx, email, "Proposal Review Requested", name, title, reviewURL, false)
+}
+
+func (s *ResendSender) SendProposalReviewReminderEmail(ctx context.Context, email, name, title, reviewURL string) error {
+	return s.sendProposalReviewEmail(ctx, email, "Reminder: Proposal Awaiting Your Review", name, title, reviewURL, true)
+}
+
+func (s *ResendSender) sendProposalReviewEmail(ctx context.Context, email, subject, name, title, reviewURL string, reminder bool) error {
+	var buf bytes.Buffer
+	data := struct {
+		Name          string
+		ProposalTitle string
+		ReviewURL     string
+		Reminder      bool
+	}{Name: name, ProposalTitle: title, ReviewURL: reviewURL, Reminder: reminder}
+	if err := proposalReviewTemplate.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute proposal review requested template: %w", err)
+	}
+	return s.sendEmail(ctx, email, subject, buf.String())
+}
+
 func (s *ResendSender) SendEnrollmentConfirmationEmail(ctx context.Context, email, name, courseTitle, courseURL string) error {
 	subject := "You're Enrolled!"
 	var buf bytes.Buffer
@@ -177,3 +264,50 @@ func (s *ResendSender) SendEnrollmentConfirmationEmail(ctx context.Context, emai
 	}
 	return s.sendEmail(ctx, email, subject, buf.String())
 }
+
+func (s *ResendSender) SendSubmissionReceivedEmail(ctx context.Context, email, name, learnerName, assignmentTitle, submissionURL string) error {
+	subject := "New Assignment Submission"
+	var buf bytes.Buffer
+	data := struct {
+		Name            string
+		LearnerName     string
+		AssignmentTitle string
+		SubmissionURL   string
+	}{Name: name, LearnerName: learnerName, AssignmentTitle: assignmentTitle, SubmissionURL: submissionURL}
+	if err := submissionCreatedTemplate.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute submission created template: %w", err)
+	}
+	return s.sendEmail(ctx, email, subject, buf.String())
+}
+
+func (s *ResendSender) SendSubmissionGradedEmail(ctx context.Context, email, name, assignmentTitle string, grade, maxPoints int, feedback, submissionURL string) error {
+	subject := "Your Submission Was Graded"
+	var buf bytes.Buffer
+	data := struct {
+		Name            string
+		AssignmentTitle string
+		Grade           int
+		MaxPoints       int
+		Feedback        string
+		SubmissionURL   string
+	}{Name: name, AssignmentTitle: assignmentTitle, Grade: grade, MaxPoints: maxPoints, Feedback: feedback, SubmissionURL: submissionURL}
+	if err := submissionGradedTemplate.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute submission graded template: %w", err)
+	}
+	return s.sendEmail(ctx, email, subject, buf.String())
+}
+
+func (s *ResendSender) SendCertificateIssuedEmail(ctx context.Context, email, name, courseTitle, code, certificateURL string) error {
+	subject := "Your Certificate of Completion"
+	var buf bytes.Buffer
+	data := struct {
+		Name           string
+		CourseTitle    string
+		Code           string
+		CertificateURL string
+	}{Name: name, CourseTitle: courseTitle, Code: code, CertificateURL: certificateURL}
+	if err := certificateIssuedTemplate.Execute(&buf, data); err != nil {
+		return fmt.Errorf("failed to execute certificate issued template: %w", err)
+	}
+	return s.sendEmail(ctx, email, subject, buf.String())
+}
@@ -7,11 +7,19 @@ import (
 type Sender interface {
 	SendWelcomeEmail(ctx context.Context, email, name, getStartedURL string) error
 	SendPasswordResetEmail(ctx context.Context, email, baseURL, token string) error
+	SendVerificationEmail(ctx context.Context, email, name, verifyURL, token string) error
+	SendEmailChangeConfirmationEmail(ctx context.Context, email, name, confirmURL, token string) error
+	SendEmailChangeNoticeEmail(ctx context.Context, email, name, newEmail string) error
 	SendProposalSubmittedEmail(ctx context.Context, email, name, title, proposalURL string) error
 	SendProposalApprovedEmail(ctx context.Context, email, name, title, courseURL string) error
 	SendProposalRejectedEmail(ctx context.Context, email, name, title, reviewNotes, newProposalURL string) error
 	SendProposalChangesRequestedEmail(ctx context.Context, email, name, title, reviewNotes, proposalURL string) error
+	SendProposalReviewAssignedEmail(ctx context.Context, email, name, title, reviewURL string) error
+	SendProposalReviewReminderEmail(ctx context.Context, email, name, title, reviewURL string) error
 	SendEnrollmentConfirmationEmail(ctx context.Context, email, name, courseTitle, courseURL string) error
+	SendSubmissionReceivedEmail(ctx context.Context, email, name, learnerName, assignmentTitle, submissionURL string) error
+	SendSubmissionGradedEmail(ctx context.Context, email, name, assignmentTitle string, grade, maxPoints int, feedback, submissionURL string) error
+	SendCertificateIssuedEmail(ctx context.Context, email, name, courseTitle, code, certificateURL string) error
 }
 
 var (
@@ -33,6 +41,18 @@ func (s *NullSender) SendPasswordResetEmail(ctx context.Context, email, baseURL,
 	return nil
 }
 
+func (s *NullSender) SendVerificationEmail(ctx context.Context, email, name, verifyURL, token string) error {
+	return nil
+}
+
+func (s *NullSender) SendEmailChangeConfirmationEmail(ctx context.Context, email, name, confirmURL, token string) error {
+	return nil
+}
+
+func (s *NullSender) SendEmailChangeNoticeEmail(ctx context.Context, email, name, newEmail string) error {
+	return nil
+}
+
 func (s *NullSender) SendProposalSubmittedEmail(ctx context.Context, email, name, title, proposalURL string) error {
 	return nil
 }
@@ -49,6 +69,26 @@ func (s *NullSender) SendProposalChangesRequestedEmail(ctx context.Context, emai
 	return nil
 }
 
+func (s *NullSender) SendProposalReviewAssignedEmail(ctx context.Context, email, name, title, reviewURL string) error {
+	return nil
+}
+
+func (s *NullSender) SendProposalReviewReminderEmail(ctx context.Context, email, name, title, reviewURL string) error {
+	return nil
+}
+
 func (s *NullSender) SendEnrollmentConfirmationEmail(ctx context.Context, email, name, courseTitle, courseURL string) error {
 	return nil
 }
+
+func (s *NullSender) SendSubmissionReceivedEmail(ctx context.Context, email, name, learnerName, assignmentTitle, submissionURL string) error {
+	return nil
+}
+
+func (s *NullSender) SendSubmissionGradedEmail(ctx context.Context, email, name, assignmentTitle string, grade, maxPoints int, feedback, submissionURL string) error {
+	return nil
+}
+
+func (s *NullSender) SendCertificateIssuedEmail(ctx context.Context, email, name, courseTitle, code, certificateURL string) error {
+	return nil
+}
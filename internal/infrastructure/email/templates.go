@@ -11,11 +11,18 @@ var templateFS embed.FS
 var (
 	welcomeTemplate                *template.Template
 	passwordResetTemplate          *template.Template
+	verifyEmailTemplate            *template.Template
+	emailChangeConfirmTemplate     *template.Template
+	emailChangeNoticeTemplate      *template.Template
 	proposalSubmittedTemplate      *template.Template
 	proposalApprovedTemplate       *template.Template
 	proposalRejectedTemplate       *template.Template
 	proposalChangesTemplate        *template.Template
+	proposalReviewTemplate         *template.Template
 	enrollmentConfirmationTemplate *template.Template
+	submissionCreatedTemplate      *template.Template
+	submissionGradedTemplate       *template.Template
+	certificateIssuedTemplate      *template.Template
 )
 
 func init() {
@@ -31,6 +38,21 @@ func init() {
 		panic("failed to parse password reset template: " + err.Error())
 	}
 
+	verifyEmailTemplate, err = template.ParseFS(templateFS, "templates/verify_email.html")
+	if err != nil {
+		panic("failed to parse verify email template: " + err.Error())
+	}
+
+	emailChangeConfirmTemplate, err = template.ParseFS(templateFS, "templates/email_change_confirm.html")
+	if err != nil {
+		panic("failed to parse email change confirm template: " + err.Error())
+	}
+
+	emailChangeNoticeTemplate, err = template.ParseFS(templateFS, "templates/email_change_notice.html")
+	if err != nil {
+		panic("failed to parse email change notice template: " + err.Error())
+	}
+
 	proposalSubmittedTemplate, err = template.ParseFS(templateFS, "templates/proposal_submitted.html")
 	if err != nil {
 		panic("failed to parse proposal submitted template: " + err.Error())
@@ -51,8 +73,28 @@ func init() {
 		panic("failed to parse proposal changes requested template: " + err.Error())
 	}
 
+	proposalReviewTemplate, err = template.ParseFS(templateFS, "templates/proposal_review_requested.html")
+	if err != nil {
+		panic("failed to parse proposal review requested template: " + err.Error())
+	}
+
 	enrollmentConfirmationTemplate, err = template.ParseFS(templateFS, "templates/enrollment_confirmation.html")
 	if err != nil {
 		panic("failed to parse enrollment confirmation template: " + err.Error())
 	}
+
+	submissionCreatedTemplate, err = template.ParseFS(templateFS, "templates/submission_created.html")
+	if err != nil {
+		panic("failed to parse submission created template: " + err.Error())
+	}
+
+	submissionGradedTemplate, err = template.ParseFS(templateFS, "templates/submission_graded.html")
+	if err != nil {
+		panic("failed to parse submission graded template: " + err.Error())
+	}
+
+	certificateIssuedTemplate, err = template.ParseFS(templateFS, "templates/certificate_issued.html")
+	if err != nil {
+		panic("failed to parse certificate issued template: " + err.Error())
+	}
 }
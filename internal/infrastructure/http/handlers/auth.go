@@ -1,26 +1,39 @@
 package handlers
 
 import (
+	"log/slog"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 
+	"github.com/go-chi/chi/v5"
+
+	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/auth"
 	"bytecourses/internal/infrastructure/http/middleware"
 	"bytecourses/internal/pkg/errors"
 	"bytecourses/internal/services"
 )
 
+const (
+	pendingSessionCookie = "pending-2fa"
+	oidcLoginCookie      = "oidc-login"
+)
+
 type AuthHandler struct {
 	Service      *services.AuthService
 	SessionStore auth.SessionStore
 	BaseURL      string
+	TrustProxy   bool
 }
 
-func NewAuthHandler(authService *services.AuthService, sessionStore auth.SessionStore, baseURL string) *AuthHandler {
+func NewAuthHandler(authService *services.AuthService, sessionStore auth.SessionStore, baseURL string, trustProxy bool) *AuthHandler {
 	return &AuthHandler{
 		Service:      authService,
 		SessionStore: sessionStore,
 		BaseURL:      baseURL,
+		TrustProxy:   trustProxy,
 	}
 }
 
@@ -30,11 +43,12 @@ type RegisterRequest struct {
 	Password string `json:"password"`
 }
 
-func (r *RegisterRequest) ToCommand() *services.RegisterCommand {
+func (r *RegisterRequest) ToCommand(baseURL string) *services.RegisterCommand {
 	return &services.RegisterCommand{
 		Name:     strings.TrimSpace(r.Name),
 		Email:    strings.ToLower(strings.TrimSpace(r.Email)),
 		Password: strings.TrimSpace(r.Password),
+		BaseURL:  strings.TrimSpace(baseURL),
 	}
 }
 
@@ -44,7 +58,7 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := h.Service.Register(r.Context(), req.ToCommand())
+	user, err := h.Service.Register(r.Context(), req.ToCommand(h.BaseURL))
 	if err != nil {
 		handleError(w, r, err)
 		return
@@ -58,10 +72,11 @@ type LoginRequest struct {
 	Password string `json:"password"`
 }
 
-func (r *LoginRequest) ToCommand() *services.LoginCommand {
+func (r *LoginRequest) ToCommand(ip string) *services.LoginCommand {
 	return &services.LoginCommand{
 		Email:    strings.ToLower(strings.TrimSpace(r.Email)),
 		Password: strings.TrimSpace(r.Password),
+		IP:       ip,
 	}
 }
 
@@ -71,22 +86,220 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	sessionID, err := h.Service.Login(r.Context(), req.ToCommand())
+	result, err := h.Service.Login(r.Context(), req.ToCommand(clientIP(r, h.TrustProxy)))
 	if err != nil {
 		handleError(w, r, err)
 		return
 	}
 
-	csrfToken, err := auth.GenerateCSRFToken()
+	if result.TwoFactorRequired {
+		h.setPendingSessionCookie(w, r, result.PendingSessionID)
+		writeJSON(w, http.StatusOK, result)
+		return
+	}
+
+	if err := h.startSession(w, r, result.SessionID); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+type VerifyTwoFactorRequest struct {
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+func (r *VerifyTwoFactorRequest) ToCommand(pendingSessionID string) *services.VerifyTwoFactorCommand {
+	return &services.VerifyTwoFactorCommand{
+		PendingSessionID: pendingSessionID,
+		Code:             strings.TrimSpace(r.Code),
+		RecoveryCode:     strings.TrimSpace(r.RecoveryCode),
+	}
+}
+
+func (h *AuthHandler) VerifyTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var req VerifyTwoFactorRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	cookie, err := r.Cookie(pendingSessionCookie)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidToken)
+		return
+	}
+
+	result, err := h.Service.VerifyTwoFactor(r.Context(), req.ToCommand(cookie.Value))
 	if err != nil {
 		handleError(w, r, err)
 		return
 	}
-	if err := h.SessionStore.SetCSRFToken(sessionID, csrfToken); err != nil {
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     pendingSessionCookie,
+		Value:    "",
+		Path:     "/api/login",
+		MaxAge:   -1,
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+		Secure:   isHTTPS(r),
+	})
+
+	if err := h.startSession(w, r, result.SessionID); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *AuthHandler) BeginPendingTwoFactorSetup(w http.ResponseWriter, r *http.Request) {
+	cookie, err := r.Cookie(pendingSessionCookie)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidToken)
+		return
+	}
+
+	setup, err := h.Service.BeginPendingTwoFactorSetup(r.Context(), &services.BeginPendingTwoFactorSetupCommand{
+		PendingSessionID: cookie.Value,
+	})
+	if err != nil {
 		handleError(w, r, err)
 		return
 	}
 
+	writeJSON(w, http.StatusOK, setup)
+}
+
+func (h *AuthHandler) setPendingSessionCookie(w http.ResponseWriter, r *http.Request, pendingSessionID string) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     pendingSessionCookie,
+		Value:    pendingSessionID,
+		Path:     "/api/login",
+		HttpOnly: true,
+		SameSite: http.SameSiteStrictMode,
+		Secure:   isHTTPS(r),
+		MaxAge:   60 * 5,
+	})
+}
+
+// BeginOIDCLogin redirects the browser to the identity provider. The state,
+// nonce and PKCE verifier are kept in a Lax cookie, since the provider's
+// redirect back is a cross-site navigation.
+func (h *AuthHandler) BeginOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	authorization, err := h.Service.BeginOIDCLogin(r.Context())
+	if err == errors.ErrNotFound {
+		handlePageError(w, r, err)
+		return
+	}
+	if err != nil {
+		slog.Error("oidc login failed", "error", err)
+		http.Redirect(w, r, "/login?error=sso", http.StatusFound)
+		return
+	}
+
+	value := strings.Join([]string{
+		authorization.State,
+		authorization.Nonce,
+		authorization.CodeVerifier,
+		url.QueryEscape(localPath(r.URL.Query().Get("next"))),
+	}, ".")
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcLoginCookie,
+		Value:    value,
+		Path:     "/api/login/oidc",
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		Secure:   isHTTPS(r),
+		MaxAge:   60 * 10,
+	})
+
+	http.Redirect(w, r, authorization.URL, http.StatusFound)
+}
+
+// CompleteOIDCLogin handles the provider's redirect. It always lands the
+// browser on the login page, which finishes in a same-site navigation so the
+// Strict session cookie is sent on the next request.
+func (h *AuthHandler) CompleteOIDCLogin(w http.ResponseWriter, r *http.Request) {
+	cookie, err := r.Cookie(oidcLoginCookie)
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcLoginCookie,
+		Value:    "",
+		Path:     "/api/login/oidc",
+		MaxAge:   -1,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+		Secure:   isHTTPS(r),
+	})
+
+	var parts []string
+	if err == nil {
+		parts = strings.SplitN(cookie.Value, ".", 4)
+	}
+	if len(parts) != 4 {
+		slog.Warn("oidc login failed", "error", "missing or malformed login cookie")
+		http.Redirect(w, r, "/login?error=sso", http.StatusFound)
+		return
+	}
+
+	query := r.URL.Query()
+	if providerErr := query.Get("error"); providerErr != "" {
+		slog.Warn("oidc login failed", "error", providerErr, "description", query.Get("error_description"))
+		http.Redirect(w, r, "/login?error=sso", http.StatusFound)
+		return
+	}
+
+	result, err := h.Service.CompleteOIDCLogin(r.Context(), &services.CompleteOIDCLoginCommand{
+		Code:          query.Get("code"),
+		State:         query.Get("state"),
+		ExpectedState: parts[0],
+		Nonce:         parts[1],
+		CodeVerifier:  parts[2],
+		BaseURL:       h.BaseURL,
+	})
+	if err != nil {
+		slog.Warn("oidc login failed", "error", err)
+		code := "sso"
+		if err == errors.ErrConflict {
+			code = "sso_conflict"
+		}
+		http.Redirect(w, r, "/login?error="+code, http.StatusFound)
+		return
+	}
+
+	params := url.Values{"sso": {"1"}}
+	if next, _ := url.QueryUnescape(parts[3]); next != "" {
+		params.Set("next", next)
+	}
+
+	if result.TwoFactorRequired {
+		h.setPendingSessionCookie(w, r, result.PendingSessionID)
+		params.Set("two_factor", "1")
+		if result.EnrollmentRequired {
+			params.Set("enroll", "1")
+		}
+	} else if err := h.startSession(w, r, result.SessionID); err != nil {
+		handlePageError(w, r, err)
+		return
+	}
+
+	http.Redirect(w, r, "/login?"+params.Encode(), http.StatusFound)
+}
+
+func (h *AuthHandler) startSession(w http.ResponseWriter, r *http.Request, sessionID string) error {
+	csrfToken, err := auth.GenerateCSRFToken()
+	if err != nil {
+		return err
+	}
+	if err := h.SessionStore.SetCSRFToken(sessionID, csrfToken); err != nil {
+		return err
+	}
+	if err := h.SessionStore.SetClientInfo(sessionID, clientIP(r, h.TrustProxy), userAgent(r)); err != nil {
+		return err
+	}
+
 	http.SetCookie(w, &http.Cookie{
 		Name:     "session",
 		Value:    sessionID,
@@ -106,7 +319,7 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		MaxAge:   60 * 60 * 24,
 	})
 
-	w.WriteHeader(http.StatusOK)
+	return nil
 }
 
 func (h *AuthHandler) Logout(w http.ResponseWriter, r *http.Request) {
@@ -204,10 +417,11 @@ type RequestPasswordResetRequest struct {
 	Email string `json:"email"`
 }
 
-func (r *RequestPasswordResetRequest) ToCommand(baseURL string) *services.RequestPasswordResetCommand {
+func (r *RequestPasswordResetRequest) ToCommand(baseURL string, ip string) *services.RequestPasswordResetCommand {
 	return &services.RequestPasswordResetCommand{
 		Email:   strings.ToLower(strings.TrimSpace(r.Email)),
 		BaseURL: strings.TrimSpace(baseURL),
+		IP:      ip,
 	}
 }
 
@@ -220,7 +434,7 @@ func (h *AuthHandler) RequestPasswordReset(w http.ResponseWriter, r *http.Reques
 	// Always return 202 Accepted to avoid email enumeration
 	w.WriteHeader(http.StatusAccepted)
 
-	_ = h.Service.RequestPasswordReset(r.Context(), req.ToCommand(h.BaseURL))
+	_ = h.Service.RequestPasswordReset(r.Context(), req.ToCommand(h.BaseURL, clientIP(r, h.TrustProxy)))
 }
 
 type ConfirmPasswordResetRequest struct {
@@ -248,3 +462,446 @@ func (h *AuthHandler) ConfirmPasswordReset(w http.ResponseWriter, r *http.Reques
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+func (r *ChangePasswordRequest) ToCommand(userID int64, sessionID string) *services.ChangePasswordCommand {
+	return &services.ChangePasswordCommand{
+		UserID:           userID,
+		CurrentSessionID: sessionID,
+		CurrentPassword:  r.CurrentPassword,
+		NewPassword:      strings.TrimSpace(r.NewPassword),
+	}
+}
+
+func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	sessionID, _ := middleware.SessionFromContext(r.Context())
+
+	var req ChangePasswordRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	if err := h.Service.ChangePassword(r.Context(), req.ToCommand(user.ID, sessionID)); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type RequestEmailChangeRequest struct {
+	NewEmail        string `json:"new_email"`
+	CurrentPassword string `json:"current_password"`
+}
+
+func (r *RequestEmailChangeRequest) ToCommand(userID int64, baseURL string) *services.RequestEmailChangeCommand {
+	return &services.RequestEmailChangeCommand{
+		UserID:          userID,
+		NewEmail:        strings.ToLower(strings.TrimSpace(r.NewEmail)),
+		CurrentPassword: r.CurrentPassword,
+		BaseURL:         strings.TrimSpace(baseURL),
+	}
+}
+
+func (h *AuthHandler) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	var req RequestEmailChangeRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	if err := h.Service.RequestEmailChange(r.Context(), req.ToCommand(user.ID, h.BaseURL)); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+type ConfirmEmailChangeRequest struct {
+	Token string `json:"token"`
+}
+
+func (r *ConfirmEmailChangeRequest) ToCommand() *services.ConfirmEmailChangeCommand {
+	return &services.ConfirmEmailChangeCommand{
+		Token: strings.TrimSpace(r.Token),
+	}
+}
+
+func (h *AuthHandler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	var req ConfirmEmailChangeRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	if err := h.Service.ConfirmEmailChange(r.Context(), req.ToCommand()); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token"`
+}
+
+func (r *VerifyEmailRequest) ToCommand() *services.VerifyEmailCommand {
+	return &services.VerifyEmailCommand{
+		Token: strings.TrimSpace(r.Token),
+	}
+}
+
+func (h *AuthHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	var req VerifyEmailRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	if err := h.Service.VerifyEmail(r.Context(), req.ToCommand()); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AuthHandler) ResendVerification(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	err := h.Service.ResendVerification(r.Context(), &services.ResendVerificationCommand{
+		UserID:  user.ID,
+		BaseURL: strings.TrimSpace(h.BaseURL),
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func (h *AuthHandler) BeginTwoFactorSetup(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	setup, err := h.Service.BeginTwoFactorSetup(r.Context(), &services.BeginTwoFactorSetupCommand{
+		UserID: user.ID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, setup)
+}
+
+type TwoFactorCodeRequest struct {
+	Code string `json:"code"`
+}
+
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func (h *AuthHandler) ConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	var req TwoFactorCodeRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	codes, err := h.Service.ConfirmTwoFactor(r.Context(), &services.ConfirmTwoFactorCommand{
+		UserID: user.ID,
+		Code:   strings.TrimSpace(req.Code),
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+func (h *AuthHandler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	var req TwoFactorCodeRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	codes, err := h.Service.RegenerateRecoveryCodes(r.Context(), &services.RegenerateRecoveryCodesCommand{
+		UserID: user.ID,
+		Code:   strings.TrimSpace(req.Code),
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, RecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+func (h *AuthHandler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	var req TwoFactorCodeRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	err := h.Service.DisableTwoFactor(r.Context(), &services.DisableTwoFactorCommand{
+		UserID: user.ID,
+		Code:   strings.TrimSpace(req.Code),
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AuthHandler) GetRolePolicy(w http.ResponseWriter, r *http.Request) {
+	policy, err := h.Service.GetRolePolicy(r.Context(), &services.GetRolePolicyQuery{
+		Role: domain.SystemRole(chi.URLParam(r, "role")),
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, policy)
+}
+
+type UpdateRolePolicyRequest struct {
+	RequireTwoFactor bool `json:"require_two_factor"`
+}
+
+func (r *UpdateRolePolicyRequest) ToCommand(role string, userID int64) *services.UpdateRolePolicyCommand {
+	return &services.UpdateRolePolicyCommand{
+		Role:             domain.SystemRole(role),
+		RequireTwoFactor: r.RequireTwoFactor,
+		UserID:           userID,
+	}
+}
+
+func (h *AuthHandler) UpdateRolePolicy(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	var req UpdateRolePolicyRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	policy, err := h.Service.UpdateRolePolicy(r.Context(), req.ToCommand(chi.URLParam(r, "role"), user.ID))
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, policy)
+}
+
+func (h *AuthHandler) UnlockUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	userID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	if err := h.Service.UnlockUser(r.Context(), &services.UnlockUserCommand{
+		UserID:  userID,
+		AdminID: user.ID,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AuthHandler) ListAPITokens(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	tokens, err := h.Service.ListAPITokens(r.Context(), &services.ListAPITokensQuery{
+		UserID: user.ID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, tokens)
+}
+
+type CreateAPITokenRequest struct {
+	Name          string              `json:"name"`
+	Scopes        []domain.TokenScope `json:"scopes"`
+	ExpiresInDays int                 `json:"expires_in_days"`
+}
+
+func (r *CreateAPITokenRequest) ToCommand(userID int64) *services.CreateAPITokenCommand {
+	return &services.CreateAPITokenCommand{
+		UserID:        userID,
+		Name:          strings.TrimSpace(r.Name),
+		Scopes:        r.Scopes,
+		ExpiresInDays: r.ExpiresInDays,
+	}
+}
+
+func (h *AuthHandler) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	var req CreateAPITokenRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	token, err := h.Service.CreateAPIToken(r.Context(), req.ToCommand(user.ID))
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, token)
+}
+
+func (h *AuthHandler) RevokeAPIToken(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	tokenID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	if err := h.Service.RevokeAPIToken(r.Context(), &services.RevokeAPITokenCommand{
+		UserID:  user.ID,
+		TokenID: tokenID,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AuthHandler) ListSessions(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+	sessionID, _ := middleware.SessionFromContext(r.Context())
+
+	sessions, err := h.Service.ListSessions(r.Context(), &services.ListSessionsQuery{
+		UserID:           user.ID,
+		CurrentSessionID: sessionID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, sessions)
+}
+
+func (h *AuthHandler) RevokeSession(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	if err := h.Service.RevokeSession(r.Context(), &services.RevokeSessionCommand{
+		UserID:    user.ID,
+		SessionID: chi.URLParam(r, "id"),
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AuthHandler) RevokeOtherSessions(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+	sessionID, ok := middleware.SessionFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	if err := h.Service.RevokeOtherSessions(r.Context(), &services.RevokeOtherSessionsCommand{
+		UserID:           user.ID,
+		CurrentSessionID: sessionID,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"bytecourses/internal/infrastructure/http/middleware"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/services"
+)
+
+type CertificateHandler struct {
+	Service *services.CertificateService
+}
+
+func NewCertificateHandler(certificateService *services.CertificateService) *CertificateHandler {
+	return &CertificateHandler{
+		Service: certificateService,
+	}
+}
+
+func (h *CertificateHandler) Verify(w http.ResponseWriter, r *http.Request) {
+	certificate, err := h.Service.Get(r.Context(), &services.GetCertificateQuery{
+		Code: chi.URLParam(r, "code"),
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, certificate)
+}
+
+func (h *CertificateHandler) GetForCourse(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	courseID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	certificate, err := h.Service.GetForCourse(r.Context(), &services.GetCourseCertificateQuery{
+		CourseID: courseID,
+		UserID:   user.ID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, certificate)
+}
+
+func (h *CertificateHandler) ListByUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	certificates, err := h.Service.List(r.Context(), &services.ListCertificatesQuery{
+		UserID: user.ID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, certificates)
+}
@@ -33,23 +33,55 @@ func NewContentHandler(contentService *services.ContentService, enrollmentServic
 }
 
 type CreateContentRequest struct {
-	Type    string `json:"type"`
-	Title   string `json:"title"`
-	Order   int    `json:"order"`
-	Format  string `json:"format"`
-	Content string `json:"content"`
+	Type         string                `json:"type"`
+	Title        string                `json:"title"`
+	Order        int                   `json:"order"`
+	Format       string                `json:"format"`
+	Content      string                `json:"content"`
+	Questions    []domain.QuizQuestion `json:"questions"`
+	MaxAttempts  int                   `json:"max_attempts"`
+	PassingScore int                   `json:"passing_score"`
+	Instructions string                `json:"instructions"`
+	DueAt        *time.Time            `json:"due_at"`
+	MaxPoints    int                   `json:"max_points"`
 }
 
 func (r *CreateContentRequest) ToCommand(moduleID, userID int64) *services.CreateContentCommand {
 	return &services.CreateContentCommand{
-		Type:     domain.ContentType(strings.TrimSpace(r.Type)),
-		ModuleID: moduleID,
-		Title:    strings.TrimSpace(r.Title),
-		Order:    r.Order,
-		Format:   strings.TrimSpace(r.Format),
-		Content:  strings.TrimSpace(r.Content),
-		UserID:   userID,
+		Type:         domain.ContentType(strings.TrimSpace(r.Type)),
+		ModuleID:     moduleID,
+		Title:        strings.TrimSpace(r.Title),
+		Order:        r.Order,
+		Format:       strings.TrimSpace(r.Format),
+		Content:      strings.TrimSpace(r.Content),
+		Questions:    trimQuizQuestions(r.Questions),
+		MaxAttempts:  r.MaxAttempts,
+		PassingScore: r.PassingScore,
+		Instructions: strings.TrimSpace(r.Instructions),
+		DueAt:        r.DueAt,
+		MaxPoints:    r.MaxPoints,
+		UserID:       userID,
+	}
+}
+
+func trimQuizQuestions(questions []domain.QuizQuestion) []domain.QuizQuestion {
+	trimmed := make([]domain.QuizQuestion, len(questions))
+	for i, q := range questions {
+		q.Prompt = strings.TrimSpace(q.Prompt)
+		q.Type = domain.QuestionType(strings.TrimSpace(string(q.Type)))
+		options := make([]string, len(q.Options))
+		for j, option := range q.Options {
+			options[j] = strings.TrimSpace(option)
+		}
+		q.Options = options
+		answers := make([]string, len(q.AcceptedAnswers))
+		for j, answer := range q.AcceptedAnswers {
+			answers[j] = strings.TrimSpace(answer)
+		}
+		q.AcceptedAnswers = answers
+		trimmed[i] = q
 	}
+	return trimmed
 }
 
 func (h *ContentHandler) Create(w http.ResponseWriter, r *http.Request) {
@@ -243,22 +275,34 @@ func (h *ContentHandler) Upload(w http.ResponseWriter, r *http.Request) {
 }
 
 type UpdateContentRequest struct {
-	Type    string `json:"type"`
-	Title   string `json:"title"`
-	Order   int    `json:"order"`
-	Format  string `json:"format"`
-	Content string `json:"content"`
+	Type         string                `json:"type"`
+	Title        string                `json:"title"`
+	Order        int                   `json:"order"`
+	Format       string                `json:"format"`
+	Content      string                `json:"content"`
+	Questions    []domain.QuizQuestion `json:"questions"`
+	MaxAttempts  int                   `json:"max_attempts"`
+	PassingScore int                   `json:"passing_score"`
+	Instructions string                `json:"instructions"`
+	DueAt        *time.Time            `json:"due_at"`
+	MaxPoints    int                   `json:"max_points"`
 }
 
 func (r *UpdateContentRequest) ToCommand(contentID, userID int64) *services.UpdateContentCommand {
 	return &services.UpdateContentCommand{
-		Type:      domain.ContentType(strings.TrimSpace(r.Type)),
-		ContentID: contentID,
-		Title:     strings.TrimSpace(r.Title),
-		Order:     r.Order,
-		Format:    strings.TrimSpace(r.Format),
-		Content:   strings.TrimSpace(r.Content),
-		UserID:    userID,
+		Type:         domain.ContentType(strings.TrimSpace(r.Type)),
+		ContentID:    contentID,
+		Title:        strings.TrimSpace(r.Title),
+		Order:        r.Order,
+		Format:       strings.TrimSpace(r.Format),
+		Content:      strings.TrimSpace(r.Content),
+		Questions:    trimQuizQuestions(r.Questions),
+		MaxAttempts:  r.MaxAttempts,
+		PassingScore: r.PassingScore,
+		Instructions: strings.TrimSpace(r.Instructions),
+		DueAt:        r.DueAt,
+		MaxPoints:    r.MaxPoints,
+		UserID:       userID,
 	}
 }
 
@@ -450,6 +494,14 @@ func (h *ContentHandler) List(w http.ResponseWriter, r *http.Request) {
 		UserID:   user.ID,
 		UserRole: user.Role,
 	})
+	if err == errors.ErrForbidden {
+		items, err = h.Service.List(r.Context(), &services.ListContentQuery{
+			ModuleID:        moduleID,
+			UserID:          user.ID,
+			UserRole:        user.Role,
+			EnrolledLearner: true,
+		})
+	}
 	if err != nil {
 		handleError(w, r, err)
 		return
@@ -492,6 +544,15 @@ func (h *ContentHandler) Get(w http.ResponseWriter, r *http.Request) {
 		UserID:    user.ID,
 		UserRole:  user.Role,
 	})
+	if err == errors.ErrForbidden {
+		content, err = h.Service.Get(r.Context(), &services.GetContentQuery{
+			ContentID:       contentID,
+			ModuleID:        moduleID,
+			UserID:          user.ID,
+			UserRole:        user.Role,
+			EnrolledLearner: true,
+		})
+	}
 	if err != nil {
 		handleError(w, r, err)
 		return
@@ -500,6 +561,213 @@ func (h *ContentHandler) Get(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, content)
 }
 
+type SubmitQuizAttemptRequest struct {
+	Answers []domain.QuizAnswer `json:"answers"`
+}
+
+func (r *SubmitQuizAttemptRequest) ToCommand(quizID, moduleID, userID int64) *services.SubmitQuizAttemptCommand {
+	return &services.SubmitQuizAttemptCommand{
+		QuizID:   quizID,
+		ModuleID: moduleID,
+		UserID:   userID,
+		Answers:  r.Answers,
+	}
+}
+
+func (h *ContentHandler) SubmitAttempt(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	_, err := strconv.ParseInt(chi.URLParam(r, "courseId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	moduleID, err := strconv.ParseInt(chi.URLParam(r, "moduleId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentID, err := strconv.ParseInt(chi.URLParam(r, "contentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	var req SubmitQuizAttemptRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	attempt, err := h.Service.SubmitQuizAttempt(r.Context(), req.ToCommand(contentID, moduleID, user.ID))
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, attempt)
+}
+
+func (h *ContentHandler) ListAttempts(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	_, err := strconv.ParseInt(chi.URLParam(r, "courseId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	moduleID, err := strconv.ParseInt(chi.URLParam(r, "moduleId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentID, err := strconv.ParseInt(chi.URLParam(r, "contentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	attempts, err := h.Service.ListQuizAttempts(r.Context(), &services.ListQuizAttemptsQuery{
+		QuizID:   contentID,
+		ModuleID: moduleID,
+		UserID:   user.ID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, attempts)
+}
+
+func (h *ContentHandler) ListRevisions(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	moduleID, err := strconv.ParseInt(chi.URLParam(r, "moduleId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentID, err := strconv.ParseInt(chi.URLParam(r, "contentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	revisions, err := h.Service.ListRevisions(r.Context(), &services.ListReadingRevisionsQuery{
+		ReadingID: contentID,
+		ModuleID:  moduleID,
+		UserID:    user.ID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, revisions)
+}
+
+func (h *ContentHandler) DiffRevisions(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	moduleID, err := strconv.ParseInt(chi.URLParam(r, "moduleId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentID, err := strconv.ParseInt(chi.URLParam(r, "contentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	from, err := strconv.Atoi(r.URL.Query().Get("from"))
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	to, err := strconv.Atoi(r.URL.Query().Get("to"))
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	result, err := h.Service.DiffRevisions(r.Context(), &services.DiffReadingRevisionsQuery{
+		ReadingID: contentID,
+		ModuleID:  moduleID,
+		From:      from,
+		To:        to,
+		UserID:    user.ID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
+
+func (h *ContentHandler) RestoreRevision(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	moduleID, err := strconv.ParseInt(chi.URLParam(r, "moduleId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentID, err := strconv.ParseInt(chi.URLParam(r, "contentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	number, err := strconv.Atoi(chi.URLParam(r, "revision"))
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	revision, err := h.Service.RestoreRevision(r.Context(), &services.RestoreReadingRevisionCommand{
+		ReadingID: contentID,
+		ModuleID:  moduleID,
+		Number:    number,
+		UserID:    user.ID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, revision)
+}
+
 func (h *ContentHandler) Download(w http.ResponseWriter, r *http.Request) {
 	user, ok := middleware.UserFromContext(r.Context())
 	if !ok {
@@ -552,6 +820,13 @@ func (h *ContentHandler) Download(w http.ResponseWriter, r *http.Request) {
 			handleError(w, r, errors.ErrForbidden)
 			return
 		}
+		_ = h.EnrollmentService.MarkComplete(r.Context(), &services.MarkContentCompleteCommand{
+			CourseID:    course.ID,
+			ModuleID:    module.ID,
+			ContentID:   file.ID,
+			ContentType: domain.ContentTypeFile,
+			UserID:      user.ID,
+		})
 	}
 	if err != nil {
 		handleError(w, r, err)
@@ -120,14 +120,46 @@ func (h *CourseHandler) Get(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *CourseHandler) List(w http.ResponseWriter, r *http.Request) {
-	courses, err := h.Service.List(r.Context())
+	query, err := listCoursesQuery(r)
 	if err != nil {
 		handleError(w, r, err)
 		return
 	}
-	if courses == nil {
-		courses = make([]domain.Course, 0)
+
+	page, err := h.Service.List(r.Context(), query)
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+func listCoursesQuery(r *http.Request) (*services.ListCoursesQuery, error) {
+	params := r.URL.Query()
+	query := services.ListCoursesQuery{
+		Sort:     domain.CourseSort(params.Get("sort")),
+		Enrolled: params.Get("enrolled") == "true",
+		Cursor:   params.Get("cursor"),
+	}
+
+	if s := params.Get("instructor_id"); s != "" {
+		id, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, errors.ErrInvalidInput
+		}
+		query.InstructorID = id
+	}
+	if s := params.Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, errors.ErrInvalidInput
+		}
+		query.Limit = n
+	}
+	if user, ok := middleware.UserFromContext(r.Context()); ok {
+		query.UserID = user.ID
 	}
 
-	writeJSON(w, http.StatusOK, courses)
+	return &query, nil
 }
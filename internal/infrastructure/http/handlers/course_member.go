@@ -0,0 +1,170 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/http/middleware"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/services"
+)
+
+type AddCourseMemberRequest struct {
+	Email string            `json:"email"`
+	Role  domain.CourseRole `json:"role"`
+}
+
+type UpdateCourseMemberRequest struct {
+	Role domain.CourseRole `json:"role"`
+}
+
+func (h *CourseHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	courseID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	members, err := h.Service.ListMembers(r.Context(), &services.ListCourseMembersQuery{
+		CourseID: courseID,
+		UserID:   user.ID,
+		UserRole: user.Role,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, members)
+}
+
+func (h *CourseHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	courseID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	var req AddCourseMemberRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	member, err := h.Service.AddMember(r.Context(), &services.AddCourseMemberCommand{
+		CourseID: courseID,
+		Email:    strings.TrimSpace(req.Email),
+		Role:     req.Role,
+		UserID:   user.ID,
+		UserRole: user.Role,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, member)
+}
+
+func (h *CourseHandler) UpdateMember(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	courseID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	memberID, err := strconv.ParseInt(chi.URLParam(r, "userId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	var req UpdateCourseMemberRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	if err := h.Service.UpdateMember(r.Context(), &services.UpdateCourseMemberCommand{
+		CourseID: courseID,
+		MemberID: memberID,
+		Role:     req.Role,
+		UserID:   user.ID,
+		UserRole: user.Role,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CourseHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	courseID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	memberID, err := strconv.ParseInt(chi.URLParam(r, "userId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	if err := h.Service.RemoveMember(r.Context(), &services.RemoveCourseMemberCommand{
+		CourseID: courseID,
+		MemberID: memberID,
+		UserID:   user.ID,
+		UserRole: user.Role,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *CourseHandler) ListTeaching(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	courses, err := h.Service.ListTeaching(r.Context(), &services.ListTeachingQuery{
+		UserID: user.ID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, courses)
+}
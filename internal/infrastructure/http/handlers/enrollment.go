@@ -118,3 +118,115 @@ func (h *EnrollmentHandler) ListByUser(w http.ResponseWriter, r *http.Request) {
 
 	writeJSON(w, http.StatusOK, enrollments)
 }
+
+func (h *EnrollmentHandler) GetProgress(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	courseID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	progress, err := h.Service.GetCourseProgress(r.Context(), &services.GetCourseProgressQuery{
+		CourseID: courseID,
+		UserID:   user.ID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, progress)
+}
+
+func (h *EnrollmentHandler) MarkComplete(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	courseID, err := strconv.ParseInt(chi.URLParam(r, "courseId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	moduleID, err := strconv.ParseInt(chi.URLParam(r, "moduleId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentID, err := strconv.ParseInt(chi.URLParam(r, "contentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentTypeStr := r.URL.Query().Get("type")
+	if contentTypeStr == "" {
+		contentTypeStr = string(domain.ContentTypeReading)
+	}
+
+	if err := h.Service.MarkComplete(r.Context(), &services.MarkContentCompleteCommand{
+		CourseID:    courseID,
+		ModuleID:    moduleID,
+		ContentID:   contentID,
+		ContentType: domain.ContentType(contentTypeStr),
+		UserID:      user.ID,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *EnrollmentHandler) MarkIncomplete(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	courseID, err := strconv.ParseInt(chi.URLParam(r, "courseId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	_, err = strconv.ParseInt(chi.URLParam(r, "moduleId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentID, err := strconv.ParseInt(chi.URLParam(r, "contentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentTypeStr := r.URL.Query().Get("type")
+	if contentTypeStr == "" {
+		contentTypeStr = string(domain.ContentTypeReading)
+	}
+
+	if err := h.Service.MarkIncomplete(r.Context(), &services.MarkContentIncompleteCommand{
+		CourseID:    courseID,
+		ContentID:   contentID,
+		ContentType: domain.ContentType(contentTypeStr),
+		UserID:      user.ID,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
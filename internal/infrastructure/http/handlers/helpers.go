@@ -5,7 +5,9 @@ import (
 	"errors"
 	"io"
 	"log/slog"
+	"net"
 	"net/http"
+	"strings"
 
 	apperrors "bytecourses/internal/pkg/errors"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -122,3 +124,40 @@ func handlePageError(w http.ResponseWriter, r *http.Request, err error) {
 func isHTTPS(r *http.Request) bool {
 	return r.Header.Get("X-Forwarded-Proto") == "https"
 }
+
+// localPath returns next if it is a path on this site, and "" otherwise, so
+// it can be used as a redirect target without creating an open redirect.
+func localPath(next string) string {
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+		return ""
+	}
+	return next
+}
+
+// clientIP uses the last X-Forwarded-For hop when trustProxy is set, since
+// that hop is appended by the proxy in front of the app rather than supplied
+// by the client. Otherwise the header is ignored in favour of RemoteAddr.
+func clientIP(r *http.Request, trustProxy bool) string {
+	if forwarded := r.Header.Values("X-Forwarded-For"); trustProxy && len(forwarded) > 0 {
+		hops := strings.Split(forwarded[len(forwarded)-1], ",")
+		if ip := strings.TrimSpace(hops[len(hops)-1]); ip != "" {
+			return ip
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// userAgent is capped so that a client cannot store arbitrarily large
+// strings in its session.
+func userAgent(r *http.Request) string {
+	ua := r.UserAgent()
+	if len(ua) > 256 {
+		ua = ua[:256]
+	}
+	return strings.ToValidUTF8(ua, "")
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 
@@ -24,9 +25,12 @@ func NewModuleHandler(moduleService *services.ModuleService) *ModuleHandler {
 }
 
 type CreateModuleRequest struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Order       int    `json:"order"`
+	Title       string                  `json:"title"`
+	Description string                  `json:"description"`
+	Order       int                     `json:"order"`
+	UnlockRule  domain.ModuleUnlockRule `json:"unlock_rule"`
+	UnlockDays  int                     `json:"unlock_days"`
+	UnlockAt    *time.Time              `json:"unlock_at"`
 }
 
 func (r *CreateModuleRequest) ToCommand(courseID, userID int64) *services.CreateModuleCommand {
@@ -35,6 +39,9 @@ func (r *CreateModuleRequest) ToCommand(courseID, userID int64) *services.Create
 		Title:       strings.TrimSpace(r.Title),
 		Description: strings.TrimSpace(r.Description),
 		Order:       r.Order,
+		UnlockRule:  r.UnlockRule,
+		UnlockDays:  r.UnlockDays,
+		UnlockAt:    r.UnlockAt,
 		UserID:      userID,
 	}
 }
@@ -67,9 +74,12 @@ func (h *ModuleHandler) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 type UpdateModuleRequest struct {
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Order       int    `json:"order"`
+	Title       string                  `json:"title"`
+	Description string                  `json:"description"`
+	Order       int                     `json:"order"`
+	UnlockRule  domain.ModuleUnlockRule `json:"unlock_rule"`
+	UnlockDays  int                     `json:"unlock_days"`
+	UnlockAt    *time.Time              `json:"unlock_at"`
 }
 
 func (r *UpdateModuleRequest) ToCommand(moduleID, userID int64) *services.UpdateModuleCommand {
@@ -78,6 +88,9 @@ func (r *UpdateModuleRequest) ToCommand(moduleID, userID int64) *services.Update
 		Title:       strings.TrimSpace(r.Title),
 		Description: strings.TrimSpace(r.Description),
 		Order:       r.Order,
+		UnlockRule:  r.UnlockRule,
+		UnlockDays:  r.UnlockDays,
+		UnlockAt:    r.UnlockAt,
 		UserID:      userID,
 	}
 }
@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"bytes"
+	"context"
 	"embed"
 	"html/template"
 	"io/fs"
@@ -9,6 +10,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-chi/chi/v5"
 	"github.com/yuin/goldmark"
@@ -17,6 +19,7 @@ import (
 	"bytecourses/internal/infrastructure/http/middleware"
 	"bytecourses/internal/infrastructure/persistence"
 	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/policy"
 	"bytecourses/internal/services"
 )
 
@@ -31,6 +34,21 @@ type ProposalPageData struct {
 	Proposal         *domain.Proposal
 	CourseExists     bool
 	ExistingCourseID *int64
+	// Assignment is the viewer's own reviewer assignment, if any. CanVote
+	// is also true for a reviewer who can claim an unassigned proposal.
+	Assignment  *domain.ProposalAssignment
+	CanVote     bool
+	Attachments []domain.ProposalAttachment
+}
+
+type CertificatePageData struct {
+	User        *domain.User
+	Certificate *domain.Certificate
+	VerifyURL   string
+}
+
+type LoginPageData struct {
+	SSOProviderName string
 }
 
 type CoursesPageData struct {
@@ -38,6 +56,14 @@ type CoursesPageData struct {
 	Courses      []domain.Course
 	Instructors  map[int64]*domain.User
 	ModuleCounts map[int64]int
+	Query        *services.ListCoursesQuery
+	NextURL      string
+}
+
+type SearchPageData struct {
+	User    *domain.User
+	Query   string
+	Results []domain.SearchResult
 }
 
 type CoursePageData struct {
@@ -45,9 +71,12 @@ type CoursePageData struct {
 	Course           *domain.Course
 	Instructor       *domain.User
 	IsInstructor     bool
+	IsStaff          bool
 	IsEnrolled       bool
 	Modules          []domain.Module
 	ReadingsByModule map[int64][]domain.Reading
+	Progress         *domain.CourseProgress
+	Certificate      *domain.Certificate
 	ActiveNavItem    string
 }
 
@@ -78,17 +107,22 @@ type CourseContentPageData struct {
 }
 
 type PageHandler struct {
-	templates         map[string]*template.Template
-	funcMap           template.FuncMap
-	proposalService   *services.ProposalService
-	courseService     *services.CourseService
-	moduleService     *services.ModuleService
-	contentService    *services.ContentService
-	enrollmentService *services.EnrollmentService
-	userRepo          persistence.UserRepository
+	templates          map[string]*template.Template
+	funcMap            template.FuncMap
+	authService        *services.AuthService
+	proposalService    *services.ProposalService
+	courseService      *services.CourseService
+	moduleService      *services.ModuleService
+	contentService     *services.ContentService
+	enrollmentService  *services.EnrollmentService
+	submissionService  *services.SubmissionService
+	certificateService *services.CertificateService
+	searchService      *services.SearchService
+	userRepo           persistence.UserRepository
+	authorizer         *policy.Authorizer
 }
 
-func NewPageHandler(templatesFS embed.FS, proposalService *services.ProposalService, courseService *services.CourseService, moduleService *services.ModuleService, contentService *services.ContentService, enrollmentService *services.EnrollmentService, userRepo persistence.UserRepository) *PageHandler {
+func NewPageHandler(templatesFS embed.FS, authService *services.AuthService, proposalService *services.ProposalService, courseService *services.CourseService, moduleService *services.ModuleService, contentService *services.ContentService, enrollmentService *services.EnrollmentService, submissionService *services.SubmissionService, certificateService *services.CertificateService, searchService *services.SearchService, userRepo persistence.UserRepository, authorizer *policy.Authorizer) *PageHandler {
 	funcMap := template.FuncMap{
 		"markdown": renderMarkdown,
 		"add": func(a, b int) int {
@@ -101,17 +135,25 @@ func NewPageHandler(templatesFS embed.FS, proposalService *services.ProposalServ
 			}
 			return *s
 		},
+		"canReview": func(u *domain.User) bool {
+			return u != nil && policy.Allows(u.Role, policy.ReviewProposals)
+		},
 	}
 
 	h := &PageHandler{
-		templates:         make(map[string]*template.Template),
-		funcMap:           funcMap,
-		proposalService:   proposalService,
-		courseService:     courseService,
-		moduleService:     moduleService,
-		contentService:    contentService,
-		enrollmentService: enrollmentService,
-		userRepo:          userRepo,
+		templates:          make(map[string]*template.Template),
+		funcMap:            funcMap,
+		authService:        authService,
+		proposalService:    proposalService,
+		courseService:      courseService,
+		moduleService:      moduleService,
+		contentService:     contentService,
+		enrollmentService:  enrollmentService,
+		submissionService:  submissionService,
+		certificateService: certificateService,
+		searchService:      searchService,
+		userRepo:           userRepo,
+		authorizer:         authorizer,
 	}
 
 	layoutContent, err := fs.ReadFile(templatesFS, "templates/layout.html")
@@ -229,7 +271,9 @@ func (h *PageHandler) About(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *PageHandler) Login(w http.ResponseWriter, r *http.Request) {
-	h.render(w, r, "login.html", nil)
+	h.render(w, r, "login.html", &LoginPageData{
+		SSOProviderName: h.authService.OIDCProviderName(),
+	})
 }
 
 func (h *PageHandler) Register(w http.ResponseWriter, r *http.Request) {
@@ -244,16 +288,41 @@ func (h *PageHandler) ConfirmPasswordReset(w http.ResponseWriter, r *http.Reques
 	h.render(w, r, "reset_password.html", nil)
 }
 
+func (h *PageHandler) VerifyEmail(w http.ResponseWriter, r *http.Request) {
+	h.render(w, r, "verify_email.html", nil)
+}
+
+func (h *PageHandler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	h.render(w, r, "confirm_email_change.html", nil)
+}
+
 func (h *PageHandler) Profile(w http.ResponseWriter, r *http.Request) {
 	h.render(w, r, "profile.html", nil)
 }
 
+func (h *PageHandler) AdminUsers(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok || !user.IsAdmin() {
+		handlePageError(w, r, errors.ErrForbidden)
+		return
+	}
+
+	h.render(w, r, "admin_users.html", nil)
+}
+
 func (h *PageHandler) Courses(w http.ResponseWriter, r *http.Request) {
-	courses, err := h.courseService.List(r.Context())
+	query, err := listCoursesQuery(r)
+	if err != nil {
+		handlePageError(w, r, err)
+		return
+	}
+
+	page, err := h.courseService.List(r.Context(), query)
 	if err != nil {
 		handlePageError(w, r, err)
 		return
 	}
+	courses := page.Courses
 
 	instructors := make(map[int64]*domain.User)
 	instructorIDs := make(map[int64]bool)
@@ -286,11 +355,20 @@ func (h *PageHandler) Courses(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	var nextURL string
+	if page.NextCursor != "" {
+		params := r.URL.Query()
+		params.Set("cursor", page.NextCursor)
+		nextURL = "/courses?" + params.Encode()
+	}
+
 	pd := CoursesPageData{
 		User:         user,
 		Courses:      courses,
 		Instructors:  instructors,
 		ModuleCounts: moduleCounts,
+		Query:        query,
+		NextURL:      nextURL,
 	}
 
 	tmpl, ok := h.templates["courses.html"]
@@ -308,6 +386,54 @@ func (h *PageHandler) Courses(w http.ResponseWriter, r *http.Request) {
 	buf.WriteTo(w)
 }
 
+func (h *PageHandler) Search(w http.ResponseWriter, r *http.Request) {
+	user, _ := middleware.UserFromContext(r.Context())
+
+	pd := SearchPageData{
+		User:  user,
+		Query: strings.TrimSpace(r.URL.Query().Get("q")),
+	}
+
+	if pd.Query != "" {
+		results, err := h.searchService.Search(r.Context(), &services.SearchQuery{
+			Query: pd.Query,
+		})
+		if err != nil {
+			handlePageError(w, r, err)
+			return
+		}
+		pd.Results = results
+	}
+
+	tmpl, ok := h.templates["search.html"]
+	if !ok {
+		handlePageError(w, r, errors.ErrNotFound)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "layout", pd); err != nil {
+		handlePageError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	buf.WriteTo(w)
+}
+
+// courseAccess reports whether the user may edit the course and whether they
+// teach it in any role. Only course membership counts here, so admins see
+// course pages the way learners do.
+func (h *PageHandler) courseAccess(ctx context.Context, user *domain.User, course *domain.Course) (isInstructor, isStaff bool) {
+	if user == nil {
+		return false, false
+	}
+
+	actor := policy.Actor{UserID: user.ID}
+	isInstructor = h.authorizer.Can(ctx, actor, course, policy.EditCourse)
+	isStaff = h.authorizer.Can(ctx, actor, course, policy.ViewCourse)
+	return isInstructor, isStaff
+}
+
 func (h *PageHandler) CourseView(w http.ResponseWriter, r *http.Request) {
 	user, _ := middleware.UserFromContext(r.Context())
 
@@ -339,10 +465,10 @@ func (h *PageHandler) CourseView(w http.ResponseWriter, r *http.Request) {
 		instructor = inst
 	}
 
-	isInstructor := user != nil && course.IsTaughtBy(user)
+	isInstructor, isStaff := h.courseAccess(r.Context(), user, course)
 
 	var isEnrolled bool
-	if user != nil && !isInstructor {
+	if user != nil && !isStaff {
 		enrolled, err := h.enrollmentService.IsEnrolled(r.Context(), &services.IsEnrolledQuery{
 			CourseID: courseID,
 			UserID:   user.ID,
@@ -391,6 +517,7 @@ func (h *PageHandler) CourseView(w http.ResponseWriter, r *http.Request) {
 		Course:           course,
 		Instructor:       instructor,
 		IsInstructor:     isInstructor,
+		IsStaff:          isStaff,
 		IsEnrolled:       isEnrolled,
 		Modules:          modules,
 		ReadingsByModule: readingsByModule,
@@ -441,10 +568,10 @@ func (h *PageHandler) CourseHome(w http.ResponseWriter, r *http.Request) {
 		instructor = inst
 	}
 
-	isInstructor := course.IsTaughtBy(user)
+	isInstructor, isStaff := h.courseAccess(r.Context(), user, course)
 
 	var isEnrolled bool
-	if !isInstructor {
+	if !isStaff {
 		enrolled, err := h.enrollmentService.IsEnrolled(r.Context(), &services.IsEnrolledQuery{
 			CourseID: courseID,
 			UserID:   user.ID,
@@ -460,7 +587,7 @@ func (h *PageHandler) CourseHome(w http.ResponseWriter, r *http.Request) {
 		CourseID:        courseID,
 		UserID:          user.ID,
 		UserRole:        user.Role,
-		EnrolledLearner: !isInstructor && isEnrolled,
+		EnrolledLearner: !isStaff && isEnrolled,
 	})
 	if err != nil {
 		log.Printf("error fetching modules: %v", err)
@@ -473,7 +600,7 @@ func (h *PageHandler) CourseHome(w http.ResponseWriter, r *http.Request) {
 			ModuleID:        module.ID,
 			UserID:          user.ID,
 			UserRole:        user.Role,
-			EnrolledLearner: !isInstructor && isEnrolled,
+			EnrolledLearner: !isStaff && isEnrolled,
 		})
 		if err == nil {
 			readings := make([]domain.Reading, 0, len(items))
@@ -486,14 +613,38 @@ func (h *PageHandler) CourseHome(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	var progress *domain.CourseProgress
+	if !isStaff {
+		progress, err = h.enrollmentService.GetCourseProgress(r.Context(), &services.GetCourseProgressQuery{
+			CourseID: courseID,
+			UserID:   user.ID,
+		})
+		if err != nil {
+			log.Printf("error fetching progress: %v", err)
+		}
+	}
+
+	var certificate *domain.Certificate
+	if !isStaff {
+		if cert, err := h.certificateService.GetForCourse(r.Context(), &services.GetCourseCertificateQuery{
+			CourseID: courseID,
+			UserID:   user.ID,
+		}); err == nil {
+			certificate = cert
+		}
+	}
+
 	pd := CoursePageData{
 		User:             user,
 		Course:           course,
 		Instructor:       instructor,
 		IsInstructor:     isInstructor,
+		IsStaff:          isStaff,
 		IsEnrolled:       true,
 		Modules:          modulesList,
 		ReadingsByModule: readingsByModule,
+		Progress:         progress,
+		Certificate:      certificate,
 		ActiveNavItem:    "home",
 	}
 
@@ -535,7 +686,7 @@ func (h *PageHandler) CourseEdit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if !course.IsTaughtBy(user) {
+	if isInstructor, _ := h.courseAccess(r.Context(), user, course); !isInstructor {
 		handlePageError(w, r, errors.ErrForbidden)
 		return
 	}
@@ -627,10 +778,10 @@ func (h *PageHandler) CourseContent(w http.ResponseWriter, r *http.Request) {
 		instructor = inst
 	}
 
-	isInstructor := course.IsTaughtBy(user)
+	isInstructor, isStaff := h.courseAccess(r.Context(), user, course)
 
 	var isEnrolled bool
-	if !isInstructor {
+	if !isStaff {
 		enrolled, err := h.enrollmentService.IsEnrolled(r.Context(), &services.IsEnrolledQuery{
 			CourseID: courseID,
 			UserID:   user.ID,
@@ -646,7 +797,7 @@ func (h *PageHandler) CourseContent(w http.ResponseWriter, r *http.Request) {
 		CourseID:        courseID,
 		UserID:          user.ID,
 		UserRole:        user.Role,
-		EnrolledLearner: !isInstructor && isEnrolled,
+		EnrolledLearner: !isStaff && isEnrolled,
 	})
 	if err != nil {
 		log.Printf("error fetching modules: %v", err)
@@ -665,7 +816,7 @@ func (h *PageHandler) CourseContent(w http.ResponseWriter, r *http.Request) {
 			ModuleID:        module.ID,
 			UserID:          user.ID,
 			UserRole:        user.Role,
-			EnrolledLearner: !isInstructor && isEnrolled,
+			EnrolledLearner: !isStaff && isEnrolled,
 		})
 		if err == nil {
 			readings := make([]domain.Reading, 0, len(items))
@@ -689,7 +840,7 @@ func (h *PageHandler) CourseContent(w http.ResponseWriter, r *http.Request) {
 		Course:           course,
 		Instructor:       instructor,
 		IsInstructor:     isInstructor,
-		IsEnrolled:       isEnrolled || isInstructor,
+		IsEnrolled:       isEnrolled || isStaff,
 		Modules:          modulesList,
 		ReadingsByModule: readingsByModule,
 		CurrentReading:   nil,
@@ -750,10 +901,10 @@ func (h *PageHandler) ModuleView(w http.ResponseWriter, r *http.Request) {
 		instructor = inst
 	}
 
-	isInstructor := course.IsTaughtBy(user)
+	isInstructor, isStaff := h.courseAccess(r.Context(), user, course)
 
 	var isEnrolled bool
-	if !isInstructor {
+	if !isStaff {
 		enrolled, err := h.enrollmentService.IsEnrolled(r.Context(), &services.IsEnrolledQuery{
 			CourseID: courseID,
 			UserID:   user.ID,
@@ -770,7 +921,7 @@ func (h *PageHandler) ModuleView(w http.ResponseWriter, r *http.Request) {
 		CourseID:        courseID,
 		UserID:          user.ID,
 		UserRole:        user.Role,
-		EnrolledLearner: !isInstructor && isEnrolled,
+		EnrolledLearner: !isStaff && isEnrolled,
 	})
 	if err != nil {
 		handlePageError(w, r, err)
@@ -781,7 +932,7 @@ func (h *PageHandler) ModuleView(w http.ResponseWriter, r *http.Request) {
 		ModuleID:        moduleID,
 		UserID:          user.ID,
 		UserRole:        user.Role,
-		EnrolledLearner: !isInstructor && isEnrolled,
+		EnrolledLearner: !isStaff && isEnrolled,
 	})
 	contentItems := make([]ContentItemView, 0)
 	if err == nil && items != nil {
@@ -804,19 +955,69 @@ func (h *PageHandler) ModuleView(w http.ResponseWriter, r *http.Request) {
 					Type:    "file",
 					FileURL: h.contentService.GetFileURL(file),
 				}
+			} else if quiz, ok := item.(*domain.Quiz); ok {
+				view = ContentItemView{
+					ID:     quiz.ID,
+					Title:  quiz.Title,
+					Order:  quiz.Order,
+					Status: quiz.Status,
+					Type:   "quiz",
+				}
+			} else if assignment, ok := item.(*domain.Assignment); ok {
+				view = ContentItemView{
+					ID:     assignment.ID,
+					Title:  assignment.Title,
+					Order:  assignment.Order,
+					Status: assignment.Status,
+					Type:   "assignment",
+				}
 			}
 			contentItems = append(contentItems, view)
 		}
 	}
 
+	var progress *domain.ModuleProgress
+	if !isStaff {
+		completions, err := h.enrollmentService.ListCompletions(r.Context(), &services.ListCompletionsQuery{
+			CourseID: courseID,
+			UserID:   user.ID,
+		})
+		if err != nil {
+			log.Printf("error fetching completions: %v", err)
+		}
+
+		completed := make(map[string]bool, len(completions))
+		for _, c := range completions {
+			if c.ModuleID == moduleID {
+				completed[string(c.ContentType)+":"+strconv.FormatInt(c.ContentID, 10)] = true
+			}
+		}
+
+		var done, total int
+		for i := range contentItems {
+			item := &contentItems[i]
+			if item.Type != "reading" && item.Type != "file" {
+				continue
+			}
+			total++
+			if completed[item.Type+":"+strconv.FormatInt(item.ID, 10)] {
+				item.Completed = true
+				done++
+			}
+		}
+		mp := domain.NewModuleProgress(moduleID, done, total)
+		progress = &mp
+	}
+
 	pd := ModuleViewPageData{
 		User:          user,
 		Course:        course,
 		Module:        module,
 		Instructor:    instructor,
 		IsInstructor:  isInstructor,
-		IsEnrolled:    isEnrolled || isInstructor,
+		IsEnrolled:    isEnrolled || isStaff,
 		Items:         contentItems,
+		Progress:      progress,
 		ActiveNavItem: "content",
 	}
 
@@ -835,10 +1036,86 @@ func (h *PageHandler) ModuleView(w http.ResponseWriter, r *http.Request) {
 	buf.WriteTo(w)
 }
 
+func (h *PageHandler) CertificateView(w http.ResponseWriter, r *http.Request) {
+	h.renderCertificate(w, r, "certificate_view.html", "layout")
+}
+
+func (h *PageHandler) CertificatePrint(w http.ResponseWriter, r *http.Request) {
+	h.renderCertificate(w, r, "certificate_print.html", "certificate-print")
+}
+
+func (h *PageHandler) renderCertificate(w http.ResponseWriter, r *http.Request, page, name string) {
+	user, _ := middleware.UserFromContext(r.Context())
+
+	certificate, err := h.certificateService.Get(r.Context(), &services.GetCertificateQuery{
+		Code: chi.URLParam(r, "code"),
+	})
+	if err != nil {
+		handlePageError(w, r, err)
+		return
+	}
+
+	pd := CertificatePageData{
+		User:        user,
+		Certificate: certificate,
+		VerifyURL:   r.Host + "/certificates/" + certificate.Code,
+	}
+
+	tmpl, ok := h.templates[page]
+	if !ok {
+		handlePageError(w, r, errors.ErrNotFound)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, name, pd); err != nil {
+		handlePageError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	buf.WriteTo(w)
+}
+
 func (h *PageHandler) Proposals(w http.ResponseWriter, r *http.Request) {
 	h.render(w, r, "proposals.html", nil)
 }
 
+func (h *PageHandler) Reviews(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok || !policy.Allows(user.Role, policy.ReviewProposals) {
+		handlePageError(w, r, errors.ErrForbidden)
+		return
+	}
+
+	h.render(w, r, "reviews.html", nil)
+}
+
+func (h *PageHandler) ProposalVersions(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handlePageError(w, r, errors.ErrUnauthorized)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handlePageError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	proposal, err := h.proposalService.Get(r.Context(), &services.GetProposalQuery{
+		ProposalID: proposalID,
+		UserID:     user.ID,
+		UserRole:   user.Role,
+	})
+	if err != nil {
+		handlePageError(w, r, err)
+		return
+	}
+
+	h.render(w, r, "proposal_versions.html", proposal)
+}
+
 func (h *PageHandler) ProposalView(w http.ResponseWriter, r *http.Request) {
 	user, ok := middleware.UserFromContext(r.Context())
 	if !ok {
@@ -874,6 +1151,20 @@ func (h *PageHandler) ProposalView(w http.ResponseWriter, r *http.Request) {
 		CourseExists: false,
 	}
 
+	if policy.Allows(user.Role, policy.ReviewProposals) {
+		pd.Assignment, pd.CanVote = h.proposalService.ReviewerAssignment(r.Context(), proposal, user.ID)
+	}
+
+	pd.Attachments, err = h.proposalService.ListAttachments(r.Context(), &services.ListProposalAttachmentsQuery{
+		ProposalID: proposal.ID,
+		UserID:     user.ID,
+		UserRole:   user.Role,
+	})
+	if err != nil {
+		handlePageError(w, r, err)
+		return
+	}
+
 	if proposal.Status == domain.ProposalStatusApproved && proposal.AuthorID == user.ID {
 		existing, ok := h.courseService.GetByProposalID(r.Context(), proposalID)
 		if ok && existing != nil {
@@ -900,7 +1191,7 @@ func (h *PageHandler) ProposalEdit(w http.ResponseWriter, r *http.Request) {
 
 	proposalIDStr := chi.URLParam(r, "id")
 	if proposalIDStr == "" {
-		if user.Role == domain.SystemRoleAdmin {
+		if policy.Allows(user.Role, policy.ReviewProposals) {
 			handlePageError(w, r, errors.ErrForbidden)
 			return
 		}
@@ -966,12 +1257,13 @@ func (h *PageHandler) ProposalEdit(w http.ResponseWriter, r *http.Request) {
 }
 
 type ContentItemView struct {
-	ID      int64
-	Title   string
-	Order   int
-	Status  domain.ContentStatus
-	Type    string
-	FileURL string
+	ID        int64
+	Title     string
+	Order     int
+	Status    domain.ContentStatus
+	Type      string
+	FileURL   string
+	Completed bool
 }
 
 type ModuleViewPageData struct {
@@ -982,6 +1274,7 @@ type ModuleViewPageData struct {
 	IsInstructor  bool
 	IsEnrolled    bool
 	Items         []ContentItemView
+	Progress      *domain.ModuleProgress
 	ActiveNavItem string
 }
 
@@ -1006,6 +1299,33 @@ type FilePageData struct {
 	ActiveNavItem string
 }
 
+type QuizPageData struct {
+	User              *domain.User
+	Course            *domain.Course
+	Module            *domain.Module
+	Quiz              *domain.Quiz
+	Attempts          []domain.QuizAttempt
+	AttemptsRemaining int
+	IsInstructor      bool
+	IsStaff           bool
+	IsEnrolled        bool
+	ActiveNavItem     string
+}
+
+type AssignmentPageData struct {
+	User          *domain.User
+	Course        *domain.Course
+	Module        *domain.Module
+	Assignment    *domain.Assignment
+	Submissions   []domain.Submission
+	Learners      map[int64]*domain.User
+	IsPastDue     bool
+	IsInstructor  bool
+	CanGrade      bool
+	IsEnrolled    bool
+	ActiveNavItem string
+}
+
 type ContentNewPageData struct {
 	User          *domain.User
 	Course        *domain.Course
@@ -1050,10 +1370,10 @@ func (h *PageHandler) LectureView(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isInstructor := course.IsTaughtBy(user)
+	isInstructor, isStaff := h.courseAccess(r.Context(), user, course)
 
 	var isEnrolled bool
-	if !isInstructor {
+	if !isStaff {
 		enrolled, err := h.enrollmentService.IsEnrolled(r.Context(), &services.IsEnrolledQuery{
 			CourseID: courseID,
 			UserID:   user.ID,
@@ -1070,7 +1390,7 @@ func (h *PageHandler) LectureView(w http.ResponseWriter, r *http.Request) {
 		CourseID:        courseID,
 		UserID:          user.ID,
 		UserRole:        user.Role,
-		EnrolledLearner: !isInstructor && isEnrolled,
+		EnrolledLearner: !isStaff && isEnrolled,
 	})
 	if err != nil {
 		if err == errors.ErrNotFound {
@@ -1087,26 +1407,48 @@ func (h *PageHandler) LectureView(w http.ResponseWriter, r *http.Request) {
 		ModuleID:        moduleID,
 		UserID:          user.ID,
 		UserRole:        user.Role,
-		EnrolledLearner: !isInstructor && isEnrolled,
+		EnrolledLearner: !isStaff && isEnrolled,
 	})
 	if err != nil {
 		handlePageError(w, r, err)
 		return
 	}
 
+	if quiz, ok := content.(*domain.Quiz); ok {
+		h.renderQuiz(w, r, user, course, module, quiz, isInstructor, isStaff, isEnrolled)
+		return
+	}
+
+	if assignment, ok := content.(*domain.Assignment); ok {
+		h.renderAssignment(w, r, user, course, module, assignment, isInstructor, isStaff, isEnrolled)
+		return
+	}
+
 	reading, ok := content.(*domain.Reading)
 	if !ok {
 		handlePageError(w, r, errors.ErrInvalidInput)
 		return
 	}
 
+	if !isStaff && isEnrolled {
+		if err := h.enrollmentService.MarkComplete(r.Context(), &services.MarkContentCompleteCommand{
+			CourseID:    courseID,
+			ModuleID:    moduleID,
+			ContentID:   reading.ID,
+			ContentType: domain.ContentTypeReading,
+			UserID:      user.ID,
+		}); err != nil {
+			log.Printf("error marking reading complete: %v", err)
+		}
+	}
+
 	pd := ReadingPageData{
 		User:          user,
 		Course:        course,
 		Module:        module,
 		Reading:       reading,
 		IsInstructor:  isInstructor,
-		IsEnrolled:    isEnrolled || isInstructor,
+		IsEnrolled:    isEnrolled || isStaff,
 		ActiveNavItem: "content",
 	}
 
@@ -1125,6 +1467,108 @@ func (h *PageHandler) LectureView(w http.ResponseWriter, r *http.Request) {
 	buf.WriteTo(w)
 }
 
+func (h *PageHandler) renderQuiz(w http.ResponseWriter, r *http.Request, user *domain.User, course *domain.Course, module *domain.Module, quiz *domain.Quiz, isInstructor, isStaff, isEnrolled bool) {
+	attempts := make([]domain.QuizAttempt, 0)
+	if isEnrolled {
+		if list, err := h.contentService.ListQuizAttempts(r.Context(), &services.ListQuizAttemptsQuery{
+			QuizID:   quiz.ID,
+			ModuleID: module.ID,
+			UserID:   user.ID,
+		}); err == nil {
+			attempts = list
+		}
+	}
+
+	remaining := -1
+	if quiz.HasAttemptLimit() {
+		remaining = quiz.MaxAttempts - len(attempts)
+		if remaining < 0 {
+			remaining = 0
+		}
+	}
+
+	pd := QuizPageData{
+		User:              user,
+		Course:            course,
+		Module:            module,
+		Quiz:              quiz,
+		Attempts:          attempts,
+		AttemptsRemaining: remaining,
+		IsInstructor:      isInstructor,
+		IsStaff:           isStaff,
+		IsEnrolled:        isEnrolled || isStaff,
+		ActiveNavItem:     "content",
+	}
+
+	tmpl, ok := h.templates["quiz_view.html"]
+	if !ok {
+		handlePageError(w, r, errors.ErrNotFound)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "layout", pd); err != nil {
+		handlePageError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	buf.WriteTo(w)
+}
+
+func (h *PageHandler) renderAssignment(w http.ResponseWriter, r *http.Request, user *domain.User, course *domain.Course, module *domain.Module, assignment *domain.Assignment, isInstructor, isStaff, isEnrolled bool) {
+	submissions, err := h.submissionService.List(r.Context(), &services.ListSubmissionsQuery{
+		AssignmentID: assignment.ID,
+		ModuleID:     module.ID,
+		UserID:       user.ID,
+		UserRole:     user.Role,
+	})
+	if err != nil {
+		submissions = make([]domain.Submission, 0)
+	}
+
+	canGrade := h.authorizer.Can(r.Context(), policy.Actor{UserID: user.ID}, course, policy.GradeSubmissions)
+
+	learners := make(map[int64]*domain.User)
+	if canGrade {
+		for _, submission := range submissions {
+			if _, ok := learners[submission.UserID]; ok {
+				continue
+			}
+			if learner, ok := h.userRepo.GetByID(r.Context(), submission.UserID); ok {
+				learners[submission.UserID] = learner
+			}
+		}
+	}
+
+	pd := AssignmentPageData{
+		User:          user,
+		Course:        course,
+		Module:        module,
+		Assignment:    assignment,
+		Submissions:   submissions,
+		Learners:      learners,
+		IsPastDue:     assignment.IsPastDue(time.Now()),
+		IsInstructor:  isInstructor,
+		CanGrade:      canGrade,
+		IsEnrolled:    isEnrolled || isStaff,
+		ActiveNavItem: "content",
+	}
+
+	tmpl, ok := h.templates["assignment_view.html"]
+	if !ok {
+		handlePageError(w, r, errors.ErrNotFound)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "layout", pd); err != nil {
+		handlePageError(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	buf.WriteTo(w)
+}
+
 func (h *PageHandler) LectureEdit(w http.ResponseWriter, r *http.Request) {
 	user, ok := middleware.UserFromContext(r.Context())
 	if !ok {
@@ -1188,10 +1632,10 @@ func (h *PageHandler) LectureEdit(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isInstructor := course.IsTaughtBy(user)
+	isInstructor, isStaff := h.courseAccess(r.Context(), user, course)
 
 	var isEnrolled bool
-	if !isInstructor {
+	if !isStaff {
 		enrolled, err := h.enrollmentService.IsEnrolled(r.Context(), &services.IsEnrolledQuery{
 			CourseID: courseID,
 			UserID:   user.ID,
@@ -1207,7 +1651,7 @@ func (h *PageHandler) LectureEdit(w http.ResponseWriter, r *http.Request) {
 		Module:        module,
 		Reading:       reading,
 		IsInstructor:  isInstructor,
-		IsEnrolled:    isEnrolled || isInstructor,
+		IsEnrolled:    isEnrolled || isStaff,
 		ActiveNavItem: "content",
 	}
 
@@ -1255,7 +1699,7 @@ func (h *PageHandler) ContentNew(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	isInstructor := course.IsTaughtBy(user)
+	isInstructor, _ := h.courseAccess(r.Context(), user, course)
 	if !isInstructor {
 		handlePageError(w, r, errors.ErrForbidden)
 		return
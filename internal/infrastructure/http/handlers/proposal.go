@@ -7,8 +7,10 @@ import (
 
 	"github.com/go-chi/chi/v5"
 
+	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/http/middleware"
 	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/policy"
 	"bytecourses/internal/services"
 )
 
@@ -25,13 +27,14 @@ func NewProposalHandler(proposalService *services.ProposalService, courseService
 }
 
 type CreateProposalRequest struct {
-	Title                string `json:"title"`
-	Summary              string `json:"summary"`
-	Qualifications       string `json:"qualifications"`
-	TargetAudience       string `json:"target_audience"`
-	LearningObjectives   string `json:"learning_objectives"`
-	Outline              string `json:"outline"`
-	AssumedPrerequisites string `json:"assumed_prerequisites"`
+	Title                string                 `json:"title"`
+	Summary              string                 `json:"summary"`
+	Qualifications       string                 `json:"qualifications"`
+	TargetAudience       string                 `json:"target_audience"`
+	LearningObjectives   string                 `json:"learning_objectives"`
+	Outline              string                 `json:"outline"`
+	OutlineModules       []domain.OutlineModule `json:"outline_modules"`
+	AssumedPrerequisites string                 `json:"assumed_prerequisites"`
 }
 
 func (r *CreateProposalRequest) ToCommand(authorID int64) *services.CreateProposalCommand {
@@ -43,10 +46,26 @@ func (r *CreateProposalRequest) ToCommand(authorID int64) *services.CreatePropos
 		TargetAudience:       strings.TrimSpace(r.TargetAudience),
 		LearningObjectives:   strings.TrimSpace(r.LearningObjectives),
 		Outline:              strings.TrimSpace(r.Outline),
+		OutlineModules:       trimOutlineModules(r.OutlineModules),
 		AssumedPrerequisites: strings.TrimSpace(r.AssumedPrerequisites),
 	}
 }
 
+func trimOutlineModules(modules []domain.OutlineModule) []domain.OutlineModule {
+	trimmed := make([]domain.OutlineModule, len(modules))
+	for i, m := range modules {
+		m.Title = strings.TrimSpace(m.Title)
+		m.Description = strings.TrimSpace(m.Description)
+		items := make([]string, len(m.Items))
+		for j, item := range m.Items {
+			items[j] = strings.TrimSpace(item)
+		}
+		m.Items = items
+		trimmed[i] = m
+	}
+	return trimmed
+}
+
 func (h *ProposalHandler) Create(w http.ResponseWriter, r *http.Request) {
 	user, ok := middleware.UserFromContext(r.Context())
 	if !ok {
@@ -69,13 +88,14 @@ func (h *ProposalHandler) Create(w http.ResponseWriter, r *http.Request) {
 }
 
 type UpdateProposalRequest struct {
-	Title                string `json:"title"`
-	Summary              string `json:"summary"`
-	Qualifications       string `json:"qualifications"`
-	TargetAudience       string `json:"target_audience"`
-	LearningObjectives   string `json:"learning_objectives"`
-	Outline              string `json:"outline"`
-	AssumedPrerequisites string `json:"assumed_prerequisites"`
+	Title                string                 `json:"title"`
+	Summary              string                 `json:"summary"`
+	Qualifications       string                 `json:"qualifications"`
+	TargetAudience       string                 `json:"target_audience"`
+	LearningObjectives   string                 `json:"learning_objectives"`
+	Outline              string                 `json:"outline"`
+	OutlineModules       []domain.OutlineModule `json:"outline_modules"`
+	AssumedPrerequisites string                 `json:"assumed_prerequisites"`
 }
 
 func (r *UpdateProposalRequest) ToCommand(proposalID, userID int64) *services.UpdateProposalCommand {
@@ -87,6 +107,7 @@ func (r *UpdateProposalRequest) ToCommand(proposalID, userID int64) *services.Up
 		TargetAudience:       strings.TrimSpace(r.TargetAudience),
 		LearningObjectives:   strings.TrimSpace(r.LearningObjectives),
 		Outline:              strings.TrimSpace(r.Outline),
+		OutlineModules:       trimOutlineModules(r.OutlineModules),
 		AssumedPrerequisites: strings.TrimSpace(r.AssumedPrerequisites),
 		UserID:               userID,
 	}
@@ -189,9 +210,10 @@ func (h *ProposalHandler) Approve(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.Service.Approve(r.Context(), &services.ReviewProposalCommand{
-		ProposalID:  proposalID,
-		ReviewNotes: strings.TrimSpace(req.ReviewNotes),
-		ReviewerID:  user.ID,
+		ProposalID:   proposalID,
+		ReviewNotes:  strings.TrimSpace(req.ReviewNotes),
+		ReviewerID:   user.ID,
+		ReviewerRole: user.Role,
 	}); err != nil {
 		handleError(w, r, err)
 		return
@@ -219,9 +241,10 @@ func (h *ProposalHandler) Reject(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.Service.Reject(r.Context(), &services.ReviewProposalCommand{
-		ProposalID:  proposalID,
-		ReviewNotes: strings.TrimSpace(req.ReviewNotes),
-		ReviewerID:  user.ID,
+		ProposalID:   proposalID,
+		ReviewNotes:  strings.TrimSpace(req.ReviewNotes),
+		ReviewerID:   user.ID,
+		ReviewerRole: user.Role,
 	}); err != nil {
 		handleError(w, r, err)
 		return
@@ -249,9 +272,10 @@ func (h *ProposalHandler) RequestChanges(w http.ResponseWriter, r *http.Request)
 	}
 
 	if err := h.Service.RequestChanges(r.Context(), &services.ReviewProposalCommand{
-		ProposalID:  proposalID,
-		ReviewNotes: strings.TrimSpace(req.ReviewNotes),
-		ReviewerID:  user.ID,
+		ProposalID:   proposalID,
+		ReviewNotes:  strings.TrimSpace(req.ReviewNotes),
+		ReviewerID:   user.ID,
+		ReviewerRole: user.Role,
 	}); err != nil {
 		handleError(w, r, err)
 		return
@@ -317,6 +341,25 @@ func (h *ProposalHandler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if policy.Allows(user.Role, policy.ReviewProposals) {
+		query, err := proposalQueueQuery(r)
+		if err != nil {
+			handleError(w, r, err)
+			return
+		}
+		query.UserID = user.ID
+		query.UserRole = user.Role
+
+		page, err := h.Service.ListQueue(r.Context(), query)
+		if err != nil {
+			handleError(w, r, err)
+			return
+		}
+
+		writeJSON(w, http.StatusOK, page)
+		return
+	}
+
 	proposals, err := h.Service.List(r.Context(), &services.ListProposalsQuery{
 		UserID:   user.ID,
 		UserRole: user.Role,
@@ -329,7 +372,42 @@ func (h *ProposalHandler) List(w http.ResponseWriter, r *http.Request) {
 	writeJSON(w, http.StatusOK, proposals)
 }
 
-func (h *ProposalHandler) CreateCourse(w http.ResponseWriter, r *http.Request) {
+func proposalQueueQuery(r *http.Request) (*services.ListProposalQueueQuery, error) {
+	params := r.URL.Query()
+	query := services.ListProposalQueueQuery{
+		Author: strings.TrimSpace(params.Get("author")),
+		Sort:   domain.ProposalSort(params.Get("sort")),
+		Order:  params.Get("order"),
+		Cursor: params.Get("cursor"),
+	}
+
+	// Statuses may be repeated or given as a comma-separated list.
+	for _, value := range params["status"] {
+		for _, status := range strings.Split(value, ",") {
+			if status = strings.TrimSpace(status); status != "" {
+				query.Statuses = append(query.Statuses, domain.ProposalStatus(status))
+			}
+		}
+	}
+	if s := params.Get("waiting_days"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, errors.ErrInvalidInput
+		}
+		query.WaitingDays = n
+	}
+	if s := params.Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return nil, errors.ErrInvalidInput
+		}
+		query.Limit = n
+	}
+
+	return &query, nil
+}
+
+func (h *ProposalHandler) ListComments(w http.ResponseWriter, r *http.Request) {
 	user, ok := middleware.UserFromContext(r.Context())
 	if !ok {
 		handleError(w, r, errors.ErrInvalidCredentials)
@@ -342,9 +420,82 @@ func (h *ProposalHandler) CreateCourse(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	course, err := h.CourseService.CreateFromProposal(r.Context(), &services.CreateCourseFromProposalCommand{
+	comments, err := h.Service.ListComments(r.Context(), &services.ListProposalCommentsQuery{
+		ProposalID: proposalID,
+		UserID:     user.ID,
+		UserRole:   user.Role,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, comments)
+}
+
+type AddProposalCommentRequest struct {
+	Body string `json:"body"`
+}
+
+func (h *ProposalHandler) AddComment(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	var req AddProposalCommentRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	comment, err := h.Service.AddComment(r.Context(), &services.AddProposalCommentCommand{
 		ProposalID: proposalID,
+		Body:       strings.TrimSpace(req.Body),
 		UserID:     user.ID,
+		UserRole:   user.Role,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, comment)
+}
+
+type CreateCourseRequest struct {
+	CopyAttachments bool `json:"copy_attachments"`
+}
+
+func (h *ProposalHandler) CreateCourse(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	// The request body is optional.
+	var req CreateCourseRequest
+	if r.ContentLength != 0 && !decodeJSON(w, r, &req) {
+		return
+	}
+
+	course, err := h.CourseService.CreateFromProposal(r.Context(), &services.CreateCourseFromProposalCommand{
+		ProposalID:      proposalID,
+		CopyAttachments: req.CopyAttachments,
+		UserID:          user.ID,
 	})
 	if err != nil {
 		handleError(w, r, err)
@@ -0,0 +1,173 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"bytecourses/internal/infrastructure/http/middleware"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/services"
+)
+
+func (h *ProposalHandler) ListAttachments(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	attachments, err := h.Service.ListAttachments(r.Context(), &services.ListProposalAttachmentsQuery{
+		ProposalID: proposalID,
+		UserID:     user.ID,
+		UserRole:   user.Role,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, attachments)
+}
+
+func (h *ProposalHandler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
+		http.Error(w, "file too large", http.StatusBadRequest)
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+	defer file.Close()
+
+	mimeType := header.Header.Get("Content-Type")
+	validatedContent, err := validateFileType(header.Filename, mimeType, file)
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+
+	ext := filepath.Ext(header.Filename)
+	attachment, err := h.Service.AddAttachment(r.Context(), &services.AddProposalAttachmentCommand{
+		ProposalID:  proposalID,
+		FileName:    filepath.Base(header.Filename),
+		FileSize:    header.Size,
+		MimeType:    mimeType,
+		StorageName: fmt.Sprintf("proposals/%d/%d_%d%s", proposalID, time.Now().UnixNano(), user.ID, ext),
+		UserID:      user.ID,
+		Content:     validatedContent,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, attachment)
+}
+
+func (h *ProposalHandler) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	attachmentID, err := strconv.ParseInt(chi.URLParam(r, "attachmentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	attachment, err := h.Service.GetAttachmentForDownload(r.Context(), &services.GetProposalAttachmentQuery{
+		ProposalID:   proposalID,
+		AttachmentID: attachmentID,
+		UserID:       user.ID,
+		UserRole:     user.Role,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	fileContent, err := h.Service.GetAttachmentContent(r.Context(), attachment)
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+	defer fileContent.Close()
+
+	w.Header().Set("Content-Type", attachment.MimeType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, strings.ReplaceAll(attachment.FileName, `"`, "")))
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.FileSize, 10))
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, fileContent)
+}
+
+func (h *ProposalHandler) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	attachmentID, err := strconv.ParseInt(chi.URLParam(r, "attachmentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	if err := h.Service.DeleteAttachment(r.Context(), &services.DeleteProposalAttachmentCommand{
+		ProposalID:   proposalID,
+		AttachmentID: attachmentID,
+		UserID:       user.ID,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+
+	"bytecourses/internal/infrastructure/http/middleware"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/services"
+)
+
+func (h *ProposalHandler) ListReviewers(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	reviewers, err := h.Service.ListReviewers(r.Context(), &services.ListProposalReviewersQuery{
+		ProposalID: proposalID,
+		UserID:     user.ID,
+		UserRole:   user.Role,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, reviewers)
+}
+
+type AssignReviewerRequest struct {
+	ReviewerID int64 `json:"reviewer_id"`
+}
+
+func (h *ProposalHandler) AssignReviewer(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	var req AssignReviewerRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	if err := h.Service.AssignReviewer(r.Context(), &services.AssignReviewerCommand{
+		ProposalID: proposalID,
+		ReviewerID: req.ReviewerID,
+		UserID:     user.ID,
+		UserRole:   user.Role,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ProposalHandler) AutoAssignReviewer(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	reviewerID, err := h.Service.AutoAssignReviewer(r.Context(), &services.AutoAssignReviewerCommand{
+		ProposalID: proposalID,
+		UserID:     user.ID,
+		UserRole:   user.Role,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]int64{"reviewer_id": reviewerID})
+}
+
+func (h *ProposalHandler) UnassignReviewer(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	proposalID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	reviewerID, err := strconv.ParseInt(chi.URLParam(r, "userId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	if err := h.Service.UnassignReviewer(r.Context(), &services.UnassignReviewerCommand{
+		ProposalID: proposalID,
+		ReviewerID: reviewerID,
+		UserID:     user.ID,
+		UserRole:   user.Role,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *ProposalHandler) ReviewQueue(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	queue, err := h.Service.ListReviewQueue(r.Context(), &services.ListReviewQueueQuery{
+		UserID: user.ID,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, queue)
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/services"
+)
+
+type SearchHandler struct {
+	Service *services.SearchService
+}
+
+func NewSearchHandler(searchService *services.SearchService) *SearchHandler {
+	return &SearchHandler{
+		Service: searchService,
+	}
+}
+
+func (h *SearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	var limit int
+	if s := r.URL.Query().Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			handleError(w, r, errors.ErrInvalidInput)
+			return
+		}
+		limit = n
+	}
+
+	results, err := h.Service.Search(r.Context(), &services.SearchQuery{
+		Query: r.URL.Query().Get("q"),
+		Limit: limit,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, results)
+}
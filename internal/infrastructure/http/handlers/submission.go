@@ -0,0 +1,261 @@
+package handlers
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+
+	"bytecourses/internal/infrastructure/http/middleware"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/services"
+)
+
+type SubmissionHandler struct {
+	Service *services.SubmissionService
+}
+
+func NewSubmissionHandler(submissionService *services.SubmissionService) *SubmissionHandler {
+	return &SubmissionHandler{
+		Service: submissionService,
+	}
+}
+
+type CreateSubmissionRequest struct {
+	Text string `json:"text"`
+}
+
+func (r *CreateSubmissionRequest) ToCommand(assignmentID, moduleID, userID int64) *services.CreateSubmissionCommand {
+	return &services.CreateSubmissionCommand{
+		AssignmentID: assignmentID,
+		ModuleID:     moduleID,
+		UserID:       userID,
+		Text:         strings.TrimSpace(r.Text),
+	}
+}
+
+func (h *SubmissionHandler) Create(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	_, err := strconv.ParseInt(chi.URLParam(r, "courseId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	moduleID, err := strconv.ParseInt(chi.URLParam(r, "moduleId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentID, err := strconv.ParseInt(chi.URLParam(r, "contentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		var req CreateSubmissionRequest
+		if !decodeJSON(w, r, &req) {
+			return
+		}
+
+		submission, err := h.Service.Create(r.Context(), req.ToCommand(contentID, moduleID, user.ID))
+		if err != nil {
+			handleError(w, r, err)
+			return
+		}
+
+		writeJSON(w, http.StatusCreated, submission)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	if err := r.ParseMultipartForm(maxUploadSize); err != nil {
+		http.Error(w, "file too large", http.StatusBadRequest)
+		return
+	}
+
+	req := CreateSubmissionRequest{Text: r.FormValue("text")}
+	cmd := req.ToCommand(contentID, moduleID, user.ID)
+
+	file, header, err := r.FormFile("file")
+	if err == nil {
+		defer file.Close()
+
+		mimeType := header.Header.Get("Content-Type")
+		validatedContent, err := validateFileType(header.Filename, mimeType, file)
+		if err != nil {
+			handleError(w, r, err)
+			return
+		}
+
+		if mimeType == "" {
+			mimeType = "application/octet-stream"
+		}
+
+		ext := filepath.Ext(header.Filename)
+		cmd.FileName = filepath.Base(header.Filename)
+		cmd.FileSize = header.Size
+		cmd.MimeType = mimeType
+		cmd.StorageName = fmt.Sprintf("submissions/%d/%d_%d%s", contentID, time.Now().UnixNano(), user.ID, ext)
+		cmd.Content = validatedContent
+	} else if err != http.ErrMissingFile {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	submission, err := h.Service.Create(r.Context(), cmd)
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusCreated, submission)
+}
+
+func (h *SubmissionHandler) List(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	_, err := strconv.ParseInt(chi.URLParam(r, "courseId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	moduleID, err := strconv.ParseInt(chi.URLParam(r, "moduleId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentID, err := strconv.ParseInt(chi.URLParam(r, "contentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	submissions, err := h.Service.List(r.Context(), &services.ListSubmissionsQuery{
+		AssignmentID: contentID,
+		ModuleID:     moduleID,
+		UserID:       user.ID,
+		UserRole:     user.Role,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, submissions)
+}
+
+type GradeSubmissionRequest struct {
+	Grade    int    `json:"grade"`
+	Feedback string `json:"feedback"`
+}
+
+func (r *GradeSubmissionRequest) ToCommand(submissionID, assignmentID, userID int64) *services.GradeSubmissionCommand {
+	return &services.GradeSubmissionCommand{
+		SubmissionID: submissionID,
+		AssignmentID: assignmentID,
+		UserID:       userID,
+		Grade:        r.Grade,
+		Feedback:     strings.TrimSpace(r.Feedback),
+	}
+}
+
+func (h *SubmissionHandler) Grade(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	_, err := strconv.ParseInt(chi.URLParam(r, "courseId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	_, err = strconv.ParseInt(chi.URLParam(r, "moduleId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	contentID, err := strconv.ParseInt(chi.URLParam(r, "contentId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	submissionID, err := strconv.ParseInt(chi.URLParam(r, "submissionId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	var req GradeSubmissionRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	submission, err := h.Service.Grade(r.Context(), req.ToCommand(submissionID, contentID, user.ID))
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, submission)
+}
+
+func (h *SubmissionHandler) Download(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	submissionID, err := strconv.ParseInt(chi.URLParam(r, "submissionId"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	submission, err := h.Service.GetFileForDownload(r.Context(), &services.GetSubmissionFileQuery{
+		SubmissionID: submissionID,
+		UserID:       user.ID,
+		UserRole:     user.Role,
+	})
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	fileContent, err := h.Service.GetFileContent(r.Context(), submission)
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+	defer fileContent.Close()
+
+	w.Header().Set("Content-Type", submission.MimeType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, strings.ReplaceAll(submission.FileName, `"`, "")))
+	w.Header().Set("Content-Length", strconv.FormatInt(submission.FileSize, 10))
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, fileContent)
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/http/middleware"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/services"
+)
+
+func (h *AuthHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	query := services.ListUsersQuery{
+		Search: strings.TrimSpace(params.Get("q")),
+		Role:   domain.SystemRole(params.Get("role")),
+		Cursor: params.Get("cursor"),
+	}
+	if s := params.Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			handleError(w, r, errors.ErrInvalidInput)
+			return
+		}
+		query.Limit = n
+	}
+
+	page, err := h.Service.ListUsers(r.Context(), &query)
+	if err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, page)
+}
+
+type ChangeUserRoleRequest struct {
+	Role domain.SystemRole `json:"role"`
+}
+
+func (h *AuthHandler) ChangeUserRole(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	userID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	var req ChangeUserRoleRequest
+	if !decodeJSON(w, r, &req) {
+		return
+	}
+
+	if err := h.Service.ChangeUserRole(r.Context(), &services.ChangeUserRoleCommand{
+		UserID:  userID,
+		Role:    req.Role,
+		AdminID: user.ID,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AuthHandler) SuspendUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	userID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	if err := h.Service.SuspendUser(r.Context(), &services.SuspendUserCommand{
+		UserID:  userID,
+		AdminID: user.ID,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AuthHandler) ReactivateUser(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	userID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	if err := h.Service.ReactivateUser(r.Context(), &services.ReactivateUserCommand{
+		UserID:  userID,
+		AdminID: user.ID,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AuthHandler) ForcePasswordReset(w http.ResponseWriter, r *http.Request) {
+	user, ok := middleware.UserFromContext(r.Context())
+	if !ok {
+		handleError(w, r, errors.ErrInvalidCredentials)
+		return
+	}
+
+	userID, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		handleError(w, r, errors.ErrInvalidInput)
+		return
+	}
+
+	if err := h.Service.ForcePasswordReset(r.Context(), &services.ForcePasswordResetCommand{
+		UserID:  userID,
+		AdminID: user.ID,
+		BaseURL: h.BaseURL,
+	}); err != nil {
+		handleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -1,13 +1,17 @@
 package middleware
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/auth"
 	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/policy"
 )
 
 func userFromRequest(r *http.Request, sessions auth.SessionStore, users persistence.UserRepository) (*domain.User, string, bool) {
@@ -21,35 +25,106 @@ func userFromRequest(r *http.Request, sessions auth.SessionStore, users persiste
 		return nil, "", false
 	}
 	user, ok := users.GetByID(r.Context(), userID)
-	if !ok {
+	if !ok || user.IsSuspended() {
 		return nil, "", false
 	}
 
+	if err := sessions.Touch(c.Value, time.Now()); err != nil {
+		slog.Warn("failed to record session activity", "user_id", userID, "error", err)
+	}
+
 	return user, c.Value, true
 }
 
-func RequireUser(sessions auth.SessionStore, users persistence.UserRepository) func(http.Handler) http.Handler {
+func bearerToken(r *http.Request) (string, bool) {
+	header := r.Header.Get("Authorization")
+	if len(header) < 7 || !strings.EqualFold(header[:7], "Bearer ") {
+		return "", false
+	}
+	return strings.TrimSpace(header[7:]), true
+}
+
+func userFromToken(r *http.Request, secret string, tokens persistence.APITokenRepository, users persistence.UserRepository) (*domain.User, *domain.APIToken, bool) {
+	hash := auth.HashToken(secret)
+	token, ok := tokens.GetAPITokenByHash(r.Context(), hash[:])
+	if !ok {
+		return nil, nil, false
+	}
+
+	now := time.Now()
+	if token.IsExpired(now) {
+		return nil, nil, false
+	}
+	user, ok := users.GetByID(r.Context(), token.UserID)
+	if !ok || user.IsSuspended() {
+		return nil, nil, false
+	}
+
+	if token.LastUsedAt == nil || now.Sub(*token.LastUsedAt) > time.Minute {
+		if err := tokens.TouchAPIToken(r.Context(), token.ID, now); err != nil {
+			slog.Warn("failed to record API token use", "token_id", token.ID, "error", err)
+		}
+	}
+
+	return user, token, true
+}
+
+// authenticate resolves the caller from a bearer token or the session cookie.
+// A bearer token is never combined with the cookie, and it is only accepted
+// on routes that opted in through AcceptTokens.
+func authenticate(w http.ResponseWriter, r *http.Request, sessions auth.SessionStore, tokens persistence.APITokenRepository, users persistence.UserRepository) (context.Context, *domain.User, bool) {
+	secret, hasBearer := bearerToken(r)
+	if !hasBearer {
+		user, sessionID, ok := userFromRequest(r, sessions, users)
+		if !ok {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return nil, nil, false
+		}
+
+		ctx := WithUser(r.Context(), user)
+		ctx = WithSession(ctx, sessionID)
+		return ctx, user, true
+	}
+
+	user, token, ok := userFromToken(r, secret, tokens, users)
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return nil, nil, false
+	}
+
+	scope, ok := requiredScope(r)
+	if !ok {
+		http.Error(w, "API tokens are not accepted for this endpoint", http.StatusForbidden)
+		return nil, nil, false
+	}
+	if !token.HasScope(scope) {
+		http.Error(w, "token is missing scope "+string(scope), http.StatusForbidden)
+		return nil, nil, false
+	}
+
+	ctx := WithUser(r.Context(), user)
+	ctx = WithAPIToken(ctx, token)
+	return ctx, user, true
+}
+
+func RequireUser(sessions auth.SessionStore, tokens persistence.APITokenRepository, users persistence.UserRepository) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			user, sessionID, ok := userFromRequest(r, sessions, users)
+			ctx, _, ok := authenticate(w, r, sessions, tokens, users)
 			if !ok {
-				http.Error(w, "unauthorized", http.StatusUnauthorized)
 				return
 			}
 
-			ctx := WithUser(r.Context(), user)
-			ctx = WithSession(ctx, sessionID)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
-func RequireAdmin(sessions auth.SessionStore, users persistence.UserRepository) func(http.Handler) http.Handler {
+func RequireAdmin(sessions auth.SessionStore, tokens persistence.APITokenRepository, users persistence.UserRepository) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			user, sessionID, ok := userFromRequest(r, sessions, users)
+			ctx, user, ok := authenticate(w, r, sessions, tokens, users)
 			if !ok {
-				http.Error(w, "unauthorized", http.StatusUnauthorized)
 				return
 			}
 
@@ -58,13 +133,48 @@ func RequireAdmin(sessions auth.SessionStore, users persistence.UserRepository)
 				return
 			}
 
-			ctx := WithUser(r.Context(), user)
-			ctx = WithSession(ctx, sessionID)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// RequireReviewer admits users whose system role may review proposals, which
+// includes admins.
+func RequireReviewer(sessions auth.SessionStore, tokens persistence.APITokenRepository, users persistence.UserRepository) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, user, ok := authenticate(w, r, sessions, tokens, users)
+			if !ok {
+				return
+			}
+
+			if !policy.Allows(user.Role, policy.ReviewProposals) {
+				http.Error(w, "forbidden", http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// AcceptTokens lets bearer tokens through RequireUser, RequireAdmin and
+// RequireReviewer on the wrapped routes, requiring the read scope for safe
+// methods and the write scope otherwise. It must run before the auth
+// middleware.
+func AcceptTokens(read, write domain.TokenScope) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			scope := write
+			if r.Method == http.MethodGet || r.Method == http.MethodHead {
+				scope = read
+			}
+
+			next.ServeHTTP(w, r.WithContext(withRequiredScope(r.Context(), scope)))
+		})
+	}
+}
+
 func RequireLogin(sessions auth.SessionStore, users persistence.UserRepository) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -82,6 +192,23 @@ func RequireLogin(sessions auth.SessionStore, users persistence.UserRepository)
 	}
 }
 
+func RequireVerified(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := UserFromContext(r.Context())
+		if !ok {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		if !user.IsVerified() {
+			http.Error(w, "email not verified", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
 func nextRedirectTarget(r *http.Request) string {
 	path := r.URL.Path
 	if path == "" {
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"context"
+	"net/http"
 
 	"bytecourses/internal/domain"
 )
@@ -9,8 +10,10 @@ import (
 type contextKey string
 
 const (
-	userContextKey    contextKey = "user"
-	sessionContextKey contextKey = "session"
+	userContextKey     contextKey = "user"
+	sessionContextKey  contextKey = "session"
+	apiTokenContextKey contextKey = "api_token"
+	scopeContextKey    contextKey = "scope"
 )
 
 func WithUser(ctx context.Context, u *domain.User) context.Context {
@@ -30,3 +33,21 @@ func SessionFromContext(ctx context.Context) (string, bool) {
 	sessionID, ok := ctx.Value(sessionContextKey).(string)
 	return sessionID, ok
 }
+
+func WithAPIToken(ctx context.Context, token *domain.APIToken) context.Context {
+	return context.WithValue(ctx, apiTokenContextKey, token)
+}
+
+func APITokenFromContext(ctx context.Context) (*domain.APIToken, bool) {
+	token, ok := ctx.Value(apiTokenContextKey).(*domain.APIToken)
+	return token, ok
+}
+
+func withRequiredScope(ctx context.Context, scope domain.TokenScope) context.Context {
+	return context.WithValue(ctx, scopeContextKey, scope)
+}
+
+func requiredScope(r *http.Request) (domain.TokenScope, bool) {
+	scope, ok := r.Context().Value(scopeContextKey).(domain.TokenScope)
+	return scope, ok
+}
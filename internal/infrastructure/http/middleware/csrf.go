@@ -21,6 +21,13 @@ func isHTTPS(r *http.Request) bool {
 func CSRFProtection(sessions auth.SessionStore, baseURL string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Browsers never attach bearer tokens on their own, and requests
+			// carrying one are authenticated by the token alone.
+			if _, ok := bearerToken(r); ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			sessionID, hasSession := SessionFromContext(r.Context())
 			cookie, _ := r.Cookie(csrfCookieName)
 
@@ -9,6 +9,7 @@ import (
 	chimw "github.com/go-chi/chi/v5/middleware"
 
 	"bytecourses/internal/bootstrap"
+	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/http/handlers"
 	"bytecourses/internal/infrastructure/http/middleware"
 )
@@ -19,18 +20,27 @@ func NewRouter(c *bootstrap.Container, webFS embed.FS) http.Handler {
 	r.Use(chimw.Logger)
 	r.Use(middleware.CSRFProtection(c.SessionStore, c.BaseURL))
 
-	pageHandler := handlers.NewPageHandler(webFS, c.ProposalService, c.CourseService, c.ModuleService, c.ContentService, c.EnrollmentService, c.UserRepo)
-	authHandler := handlers.NewAuthHandler(c.AuthService, c.SessionStore, c.BaseURL)
+	pageHandler := handlers.NewPageHandler(webFS, c.AuthService, c.ProposalService, c.CourseService, c.ModuleService, c.ContentService, c.EnrollmentService, c.SubmissionService, c.CertificateService, c.SearchService, c.UserRepo, c.Authorizer)
+	authHandler := handlers.NewAuthHandler(c.AuthService, c.SessionStore, c.BaseURL, c.TrustProxy)
 	proposalHandler := handlers.NewProposalHandler(c.ProposalService, c.CourseService)
 	courseHandler := handlers.NewCourseHandler(c.CourseService)
 	moduleHandler := handlers.NewModuleHandler(c.ModuleService)
 	contentHandler := handlers.NewContentHandler(c.ContentService, c.EnrollmentService, c.CourseService)
 	enrollmentHandler := handlers.NewEnrollmentHandler(c.EnrollmentService)
+	submissionHandler := handlers.NewSubmissionHandler(c.SubmissionService)
+	certificateHandler := handlers.NewCertificateHandler(c.CertificateService)
+	searchHandler := handlers.NewSearchHandler(c.SearchService)
 
-	requireUser := middleware.RequireUser(c.SessionStore, c.UserRepo)
+	requireUser := middleware.RequireUser(c.SessionStore, c.APITokenRepo, c.UserRepo)
 	requireLogin := middleware.RequireLogin(c.SessionStore, c.UserRepo)
-	requireAdmin := middleware.RequireAdmin(c.SessionStore, c.UserRepo)
+	requireAdmin := middleware.RequireAdmin(c.SessionStore, c.APITokenRepo, c.UserRepo)
+	requireReviewer := middleware.RequireReviewer(c.SessionStore, c.APITokenRepo, c.UserRepo)
 	optionalUser := middleware.OptionalUser(c.SessionStore, c.UserRepo)
+	requireVerified := middleware.RequireVerified
+
+	proposalTokens := middleware.AcceptTokens(domain.TokenScopeProposalsRead, domain.TokenScopeProposalsWrite)
+	courseTokens := middleware.AcceptTokens(domain.TokenScopeCoursesRead, domain.TokenScopeCoursesWrite)
+	contentTokens := middleware.AcceptTokens(domain.TokenScopeContentRead, domain.TokenScopeContentWrite)
 
 	r.Route("/api", func(r chi.Router) {
 		r.Get("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -40,41 +50,96 @@ func NewRouter(c *bootstrap.Container, webFS embed.FS) http.Handler {
 
 		r.Post("/register", authHandler.Register)
 		r.Post("/login", authHandler.Login)
+		r.Post("/login/2fa", authHandler.VerifyTwoFactor)
+		r.Post("/login/2fa/setup", authHandler.BeginPendingTwoFactorSetup)
+		r.Get("/login/oidc", authHandler.BeginOIDCLogin)
+		r.Get("/login/oidc/callback", authHandler.CompleteOIDCLogin)
 		r.Post("/password-reset/request", authHandler.RequestPasswordReset)
 		r.Post("/password-reset/confirm", authHandler.ConfirmPasswordReset)
+		r.Post("/email-verification/confirm", authHandler.VerifyEmail)
+		r.Post("/email-change/confirm", authHandler.ConfirmEmailChange)
 
 		r.With(requireUser).Post("/logout", authHandler.Logout)
 		r.With(requireUser).Get("/me", authHandler.Me)
 		r.With(requireUser).Patch("/me", authHandler.UpdateProfile)
 		r.With(requireUser).Delete("/me", authHandler.Delete)
+		r.With(requireUser).Patch("/me/password", authHandler.ChangePassword)
+		r.With(requireUser).Post("/me/email", authHandler.RequestEmailChange)
+		r.With(requireUser).Post("/me/email-verification", authHandler.ResendVerification)
 		r.With(requireUser).Get("/me/enrollments", enrollmentHandler.ListByUser)
+		r.With(requireUser).Get("/me/certificates", certificateHandler.ListByUser)
+		r.With(requireUser).Get("/me/teaching", courseHandler.ListTeaching)
+		r.With(requireReviewer).Get("/me/reviews", proposalHandler.ReviewQueue)
+		r.With(requireUser).Post("/me/2fa/setup", authHandler.BeginTwoFactorSetup)
+		r.With(requireUser).Post("/me/2fa/confirm", authHandler.ConfirmTwoFactor)
+		r.With(requireUser).Post("/me/2fa/recovery-codes", authHandler.RegenerateRecoveryCodes)
+		r.With(requireUser).Delete("/me/2fa", authHandler.DisableTwoFactor)
+		r.With(requireUser).Get("/me/tokens", authHandler.ListAPITokens)
+		r.With(requireUser).Post("/me/tokens", authHandler.CreateAPIToken)
+		r.With(requireUser).Delete("/me/tokens/{id}", authHandler.RevokeAPIToken)
+		r.With(requireUser).Get("/me/sessions", authHandler.ListSessions)
+		r.With(requireUser).Delete("/me/sessions/{id}", authHandler.RevokeSession)
+		r.With(requireUser).Post("/me/sessions/actions/revoke-others", authHandler.RevokeOtherSessions)
+
+		r.With(requireAdmin).Get("/admin/roles/{role}/policy", authHandler.GetRolePolicy)
+		r.With(requireAdmin).Put("/admin/roles/{role}/policy", authHandler.UpdateRolePolicy)
+		r.With(requireAdmin).Get("/admin/users", authHandler.ListUsers)
+		r.With(requireAdmin).Put("/admin/users/{id}/role", authHandler.ChangeUserRole)
+		r.With(requireAdmin).Post("/admin/users/{id}/actions/suspend", authHandler.SuspendUser)
+		r.With(requireAdmin).Post("/admin/users/{id}/actions/reactivate", authHandler.ReactivateUser)
+		r.With(requireAdmin).Post("/admin/users/{id}/actions/force-password-reset", authHandler.ForcePasswordReset)
+		r.With(requireAdmin).Post("/admin/users/{id}/actions/unlock", authHandler.UnlockUser)
+
+		r.Get("/certificates/{code}", certificateHandler.Verify)
+		r.Get("/search", searchHandler.Search)
 
 		r.Route("/proposals", func(r chi.Router) {
+			r.Use(proposalTokens)
 			r.Use(requireUser)
-			r.Post("/", proposalHandler.Create)
+			r.With(requireVerified).Post("/", proposalHandler.Create)
 			r.Get("/", proposalHandler.List)
 			r.Patch("/{id}", proposalHandler.Update)
 			r.Delete("/{id}", proposalHandler.Delete)
 			r.Get("/{id}", proposalHandler.Get)
 			r.Post("/{id}/actions/submit", proposalHandler.Submit)
 			r.Post("/{id}/actions/withdraw", proposalHandler.Withdraw)
-			r.With(requireAdmin).Post("/{id}/actions/approve", proposalHandler.Approve)
-			r.With(requireAdmin).Post("/{id}/actions/reject", proposalHandler.Reject)
-			r.With(requireAdmin).Post("/{id}/actions/request-changes", proposalHandler.RequestChanges)
+			r.With(requireReviewer).Post("/{id}/actions/approve", proposalHandler.Approve)
+			r.With(requireReviewer).Post("/{id}/actions/reject", proposalHandler.Reject)
+			r.With(requireReviewer).Post("/{id}/actions/request-changes", proposalHandler.RequestChanges)
 			r.Post("/{id}/actions/create-course", proposalHandler.CreateCourse)
+			r.Get("/{id}/comments", proposalHandler.ListComments)
+			r.Post("/{id}/comments", proposalHandler.AddComment)
+			r.Get("/{id}/versions", proposalHandler.ListVersions)
+			r.Get("/{id}/versions/diff", proposalHandler.DiffVersions)
+			r.Get("/{id}/attachments", proposalHandler.ListAttachments)
+			r.Post("/{id}/attachments", proposalHandler.UploadAttachment)
+			r.Get("/{id}/attachments/{attachmentId}", proposalHandler.DownloadAttachment)
+			r.Delete("/{id}/attachments/{attachmentId}", proposalHandler.DeleteAttachment)
+			r.With(requireReviewer).Get("/{id}/reviewers", proposalHandler.ListReviewers)
+			r.With(requireAdmin).Post("/{id}/reviewers", proposalHandler.AssignReviewer)
+			r.With(requireAdmin).Post("/{id}/reviewers/actions/auto-assign", proposalHandler.AutoAssignReviewer)
+			r.With(requireAdmin).Delete("/{id}/reviewers/{userId}", proposalHandler.UnassignReviewer)
 		})
 
 		r.Route("/courses", func(r chi.Router) {
+			r.Use(courseTokens)
 			r.With(optionalUser).Get("/", courseHandler.List)
 
 			r.With(requireUser).Get("/{id}", courseHandler.Get)
 			r.With(requireUser).Patch("/{id}", courseHandler.Update)
 			r.With(requireUser).Post("/{id}/actions/publish", courseHandler.Publish)
-			r.With(requireUser).Post("/{id}/actions/enroll", enrollmentHandler.Enroll)
+			r.With(requireUser).Get("/{id}/members", courseHandler.ListMembers)
+			r.With(requireUser).Post("/{id}/members", courseHandler.AddMember)
+			r.With(requireUser).Patch("/{id}/members/{userId}", courseHandler.UpdateMember)
+			r.With(requireUser).Delete("/{id}/members/{userId}", courseHandler.RemoveMember)
+			r.With(requireUser, requireVerified).Post("/{id}/actions/enroll", enrollmentHandler.Enroll)
 			r.With(requireUser).Delete("/{id}/actions/enroll", enrollmentHandler.Unenroll)
 			r.With(requireUser).Get("/{id}/enrollment", enrollmentHandler.GetStatus)
+			r.With(requireUser).Get("/{id}/progress", enrollmentHandler.GetProgress)
+			r.With(requireUser).Get("/{id}/certificate", certificateHandler.GetForCourse)
 
 			r.Route("/{courseId}/modules", func(r chi.Router) {
+				r.Use(contentTokens)
 				r.Use(requireUser)
 				r.Post("/", moduleHandler.Create)
 				r.Get("/", moduleHandler.List)
@@ -93,6 +158,16 @@ func NewRouter(c *bootstrap.Container, webFS embed.FS) http.Handler {
 					r.Delete("/{contentId}", contentHandler.Delete)
 					r.Post("/{contentId}/actions/publish", contentHandler.Publish)
 					r.Post("/{contentId}/actions/unpublish", contentHandler.Unpublish)
+					r.Post("/{contentId}/actions/complete", enrollmentHandler.MarkComplete)
+					r.Delete("/{contentId}/actions/complete", enrollmentHandler.MarkIncomplete)
+					r.Post("/{contentId}/attempts", contentHandler.SubmitAttempt)
+					r.Get("/{contentId}/attempts", contentHandler.ListAttempts)
+					r.Get("/{contentId}/revisions", contentHandler.ListRevisions)
+					r.Get("/{contentId}/revisions/diff", contentHandler.DiffRevisions)
+					r.Post("/{contentId}/revisions/{revision}/actions/restore", contentHandler.RestoreRevision)
+					r.Post("/{contentId}/submissions", submissionHandler.Create)
+					r.Get("/{contentId}/submissions", submissionHandler.List)
+					r.Post("/{contentId}/submissions/{submissionId}/actions/grade", submissionHandler.Grade)
 				})
 			})
 		})
@@ -101,7 +176,8 @@ func NewRouter(c *bootstrap.Container, webFS embed.FS) http.Handler {
 	staticFS, _ := fs.Sub(webFS, "static")
 	r.Handle("/static/*", http.StripPrefix("/static/", http.FileServer(http.FS(staticFS))))
 
-	r.With(requireUser).Get("/files/{fileId}", contentHandler.Download)
+	r.With(contentTokens, requireUser).Get("/files/{fileId}", contentHandler.Download)
+	r.With(contentTokens, requireUser).Get("/submissions/{submissionId}/file", submissionHandler.Download)
 
 	r.Group(func(r chi.Router) {
 		r.Use(optionalUser)
@@ -111,20 +187,28 @@ func NewRouter(c *bootstrap.Container, webFS embed.FS) http.Handler {
 		r.Get("/register", pageHandler.Register)
 		r.Get("/forgot-password", pageHandler.RequestPasswordReset)
 		r.Get("/reset-password", pageHandler.ConfirmPasswordReset)
+		r.Get("/verify-email", pageHandler.VerifyEmail)
+		r.Get("/confirm-email-change", pageHandler.ConfirmEmailChange)
 		r.Get("/courses", pageHandler.Courses)
+		r.Get("/search", pageHandler.Search)
 		r.Get("/courses/{id}", pageHandler.CourseView)
 		r.Get("/courses/{id}/modules", pageHandler.CourseContent)
+		r.Get("/certificates/{code}", pageHandler.CertificateView)
+		r.Get("/certificates/{code}/print", pageHandler.CertificatePrint)
 	})
 
 	r.Group(func(r chi.Router) {
 		r.Use(requireLogin)
 		r.Get("/profile", pageHandler.Profile)
+		r.Get("/admin/users", pageHandler.AdminUsers)
 
 		r.Get("/proposals", pageHandler.Proposals)
 		r.Get("/proposals/new", pageHandler.ProposalEdit)
 		r.Get("/proposals/mine", pageHandler.Proposals)
+		r.Get("/reviews", pageHandler.Reviews)
 		r.Get("/proposals/{id}", pageHandler.ProposalView)
 		r.Get("/proposals/{id}/edit", pageHandler.ProposalEdit)
+		r.Get("/proposals/{id}/versions", pageHandler.ProposalVersions)
 
 		r.Get("/courses/{id}/home", pageHandler.CourseHome)
 		r.Get("/courses/{id}/modules/{moduleId}", pageHandler.ModuleView)
@@ -0,0 +1,238 @@
+package oidc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"bytecourses/internal/infrastructure/oidc/oidctest"
+)
+
+const testRedirectURL = "http://app.test/api/login/oidc/callback"
+
+func newTestProvider(t *testing.T) (*Provider, *oidctest.Issuer) {
+	t.Helper()
+
+	issuer := oidctest.NewIssuer(t)
+	provider := NewProvider(Config{
+		IssuerURL:    issuer.URL(),
+		ClientID:     oidctest.ClientID,
+		ClientSecret: oidctest.ClientSecret,
+		RedirectURL:  testRedirectURL,
+	}, issuer.Client())
+
+	return provider, issuer
+}
+
+// authorize follows the authorization URL and returns the code and state
+// from the redirect back to the application.
+func authorize(t *testing.T, provider *Provider, authURL string) (string, string) {
+	t.Helper()
+
+	client := *provider.client
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	resp, err := client.Get(authURL)
+	if err != nil {
+		t.Fatalf("authorize request failed: %v", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize: expected 302, got %d", resp.StatusCode)
+	}
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatalf("authorize: invalid redirect: %v", err)
+	}
+	if !strings.HasPrefix(location.String(), testRedirectURL) {
+		t.Fatalf("authorize: unexpected redirect %q", location)
+	}
+
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func TestAuthorizationCodeFlow(t *testing.T) {
+	ctx := context.Background()
+	provider, issuer := newTestProvider(t)
+	issuer.SetIdentity(oidctest.Identity{
+		Subject:       "abc123",
+		Email:         "ada@example.edu",
+		EmailVerified: true,
+		Name:          "Ada Lovelace",
+	})
+
+	authURL, err := provider.AuthCodeURL(ctx, "state-1", "nonce-1", "verifier-1")
+	if err != nil {
+		t.Fatalf("AuthCodeURL failed: %v", err)
+	}
+
+	u, _ := url.Parse(authURL)
+	if got := u.Query().Get("code_challenge"); got != CodeChallenge("verifier-1") {
+		t.Fatalf("AuthCodeURL: unexpected code_challenge %q", got)
+	}
+	if got := u.Query().Get("redirect_uri"); got != testRedirectURL {
+		t.Fatalf("AuthCodeURL: unexpected redirect_uri %q", got)
+	}
+
+	code, state := authorize(t, provider, authURL)
+	if state != "state-1" {
+		t.Fatalf("authorize: expected state %q, got %q", "state-1", state)
+	}
+
+	claims, err := provider.Exchange(ctx, code, "verifier-1", "nonce-1")
+	if err != nil {
+		t.Fatalf("Exchange failed: %v", err)
+	}
+	if claims.Subject != "abc123" || claims.Email != "ada@example.edu" || !claims.EmailVerified {
+		t.Fatalf("Exchange: unexpected claims %+v", claims)
+	}
+	if claims.Issuer != issuer.URL() {
+		t.Fatalf("Exchange: expected issuer %q, got %q", issuer.URL(), claims.Issuer)
+	}
+}
+
+func TestExchangeRejectsWrongVerifier(t *testing.T) {
+	ctx := context.Background()
+	provider, _ := newTestProvider(t)
+
+	authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", "verifier")
+	if err != nil {
+		t.Fatalf("AuthCodeURL failed: %v", err)
+	}
+	code, _ := authorize(t, provider, authURL)
+
+	if _, err := provider.Exchange(ctx, code, "other-verifier", "nonce"); err == nil {
+		t.Fatalf("Exchange: expected error for wrong code verifier")
+	}
+}
+
+func TestExchangeRejectsWrongNonce(t *testing.T) {
+	ctx := context.Background()
+	provider, _ := newTestProvider(t)
+
+	authURL, err := provider.AuthCodeURL(ctx, "state", "nonce", "verifier")
+	if err != nil {
+		t.Fatalf("AuthCodeURL failed: %v", err)
+	}
+	code, _ := authorize(t, provider, authURL)
+
+	if _, err := provider.Exchange(ctx, code, "verifier", "other-nonce"); err == nil {
+		t.Fatalf("Exchange: expected error for wrong nonce")
+	}
+}
+
+func TestVerifyIDTokenRejectsInvalidClaims(t *testing.T) {
+	ctx := context.Background()
+	provider, issuer := newTestProvider(t)
+	identity := oidctest.Identity{Subject: "abc123", Email: "ada@example.edu"}
+
+	tests := []struct {
+		name   string
+		mutate func(map[string]any)
+	}{
+		{"wrong issuer", func(c map[string]any) { c["iss"] = "https://evil.example" }},
+		{"wrong audience", func(c map[string]any) { c["aud"] = "other-client" }},
+		{"expired", func(c map[string]any) { c["exp"] = time.Now().Add(-5 * time.Minute).Unix() }},
+		{"issued in future", func(c map[string]any) { c["iat"] = time.Now().Add(10 * time.Minute).Unix() }},
+		{"missing subject", func(c map[string]any) { delete(c, "sub") }},
+		{"multiple audiences without azp", func(c map[string]any) {
+			c["aud"] = []string{oidctest.ClientID, "other-client"}
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := issuer.Claims(identity, "nonce")
+			tt.mutate(claims)
+
+			if _, err := provider.VerifyIDToken(ctx, issuer.Sign(claims), "nonce"); err == nil {
+				t.Fatalf("VerifyIDToken: expected error")
+			}
+		})
+	}
+}
+
+func TestVerifyIDTokenEmailVerifiedString(t *testing.T) {
+	ctx := context.Background()
+	provider, issuer := newTestProvider(t)
+
+	claims := issuer.Claims(oidctest.Identity{Subject: "abc123", Email: "ada@example.edu"}, "nonce")
+	claims["email_verified"] = "true"
+
+	got, err := provider.VerifyIDToken(ctx, issuer.Sign(claims), "nonce")
+	if err != nil {
+		t.Fatalf("VerifyIDToken failed: %v", err)
+	}
+	if !got.EmailVerified {
+		t.Fatalf("VerifyIDToken: expected email to be verified")
+	}
+}
+
+func TestVerifyIDTokenRejectsBadSignature(t *testing.T) {
+	ctx := context.Background()
+	provider, issuer := newTestProvider(t)
+
+	token := issuer.Sign(issuer.Claims(oidctest.Identity{Subject: "abc123"}, "nonce"))
+	parts := strings.Split(token, ".")
+
+	other := issuer.Sign(issuer.Claims(oidctest.Identity{Subject: "mallory"}, "nonce"))
+	forged := strings.Split(other, ".")[0] + "." + strings.Split(other, ".")[1] + "." + parts[2]
+
+	if _, err := provider.VerifyIDToken(ctx, forged, "nonce"); err == nil {
+		t.Fatalf("VerifyIDToken: expected error for forged token")
+	}
+}
+
+func TestVerifyIDTokenRejectsUnsignedToken(t *testing.T) {
+	ctx := context.Background()
+	provider, issuer := newTestProvider(t)
+
+	token := issuer.Sign(issuer.Claims(oidctest.Identity{Subject: "abc123"}, "nonce"))
+	parts := strings.Split(token, ".")
+	unsigned := "eyJhbGciOiJub25lIn0." + parts[1] + "."
+
+	if _, err := provider.VerifyIDToken(ctx, unsigned, "nonce"); err == nil {
+		t.Fatalf("VerifyIDToken: expected error for alg none")
+	}
+}
+
+func TestVerifyIDTokenRefetchesRotatedKeys(t *testing.T) {
+	ctx := context.Background()
+	provider, issuer := newTestProvider(t)
+	identity := oidctest.Identity{Subject: "abc123"}
+
+	if _, err := provider.VerifyIDToken(ctx, issuer.Sign(issuer.Claims(identity, "nonce")), "nonce"); err != nil {
+		t.Fatalf("VerifyIDToken failed: %v", err)
+	}
+
+	issuer.RotateKey()
+	rotated := issuer.Sign(issuer.Claims(identity, "nonce"))
+
+	if _, err := provider.VerifyIDToken(ctx, rotated, "nonce"); err == nil {
+		t.Fatalf("VerifyIDToken: expected refetch to be rate limited")
+	}
+
+	provider.now = func() time.Time { return time.Now().Add(keyRefreshInterval) }
+	if _, err := provider.VerifyIDToken(ctx, rotated, "nonce"); err != nil {
+		t.Fatalf("VerifyIDToken after rotation failed: %v", err)
+	}
+}
+
+func TestDiscoveryRejectsMismatchedIssuer(t *testing.T) {
+	issuer := oidctest.NewIssuer(t)
+	provider := NewProvider(Config{
+		IssuerURL: strings.Replace(issuer.URL(), "127.0.0.1", "localhost", 1),
+		ClientID:  oidctest.ClientID,
+	}, issuer.Client())
+
+	if _, err := provider.AuthCodeURL(context.Background(), "state", "nonce", "verifier"); err == nil {
+		t.Fatalf("AuthCodeURL: expected error for mismatched issuer")
+	}
+}
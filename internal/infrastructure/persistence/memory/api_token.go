@@ -0,0 +1,112 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.APITokenRepository = (*APITokenRepository)(nil)
+)
+
+type APITokenRepository struct {
+	mu     sync.RWMutex
+	tokens map[int64]domain.APIToken
+	nextID int64
+}
+
+func NewAPITokenRepository() *APITokenRepository {
+	return &APITokenRepository{
+		tokens: make(map[int64]domain.APIToken),
+		nextID: 1,
+	}
+}
+
+func (r *APITokenRepository) CreateAPIToken(ctx context.Context, t *domain.APIToken) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.tokens {
+		if bytes.Equal(existing.TokenHash, t.TokenHash) {
+			return errors.ErrConflict
+		}
+	}
+
+	t.ID = r.nextID
+	r.nextID++
+	t.CreatedAt = time.Now()
+
+	stored := *t
+	stored.Scopes = slices.Clone(t.Scopes)
+	r.tokens[t.ID] = stored
+
+	return nil
+}
+
+func (r *APITokenRepository) GetAPITokenByHash(ctx context.Context, tokenHash []byte) (*domain.APIToken, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, t := range r.tokens {
+		if bytes.Equal(t.TokenHash, tokenHash) {
+			t.Scopes = slices.Clone(t.Scopes)
+			return &t, true
+		}
+	}
+
+	return nil, false
+}
+
+func (r *APITokenRepository) ListAPITokensByUserID(ctx context.Context, userID int64) ([]domain.APIToken, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.APIToken, 0)
+	for _, t := range r.tokens {
+		if t.UserID == userID {
+			t.Scopes = slices.Clone(t.Scopes)
+			result = append(result, t)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+func (r *APITokenRepository) TouchAPIToken(ctx context.Context, id int64, usedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t, ok := r.tokens[id]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	t.LastUsedAt = &usedAt
+	r.tokens[id] = t
+
+	return nil
+}
+
+func (r *APITokenRepository) DeleteAPIToken(ctx context.Context, userID int64, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t, ok := r.tokens[id]
+	if !ok || t.UserID != userID {
+		return errors.ErrNotFound
+	}
+	delete(r.tokens, id)
+
+	return nil
+}
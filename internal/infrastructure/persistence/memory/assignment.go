@@ -0,0 +1,90 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var _ persistence.AssignmentRepository = (*AssignmentRepository)(nil)
+
+type AssignmentRepository struct {
+	mu          sync.RWMutex
+	assignments map[int64]domain.Assignment
+	nextID      int64
+}
+
+func NewAssignmentRepository() *AssignmentRepository {
+	return &AssignmentRepository{
+		assignments: make(map[int64]domain.Assignment),
+		nextID:      1,
+	}
+}
+
+func (r *AssignmentRepository) Create(ctx context.Context, assignment *domain.Assignment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	assignment.ID = r.nextID
+	r.nextID++
+	assignment.CreatedAt = time.Now()
+	assignment.UpdatedAt = time.Now()
+
+	r.assignments[assignment.ID] = *assignment
+	return nil
+}
+
+func (r *AssignmentRepository) GetByID(ctx context.Context, id int64) (*domain.Assignment, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	assignment, ok := r.assignments[id]
+	if !ok {
+		return nil, false
+	}
+
+	return &assignment, true
+}
+
+func (r *AssignmentRepository) Update(ctx context.Context, assignment *domain.Assignment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.assignments[assignment.ID]; !ok {
+		return nil
+	}
+
+	assignment.UpdatedAt = time.Now()
+	r.assignments[assignment.ID] = *assignment
+	return nil
+}
+
+func (r *AssignmentRepository) ListByModuleID(ctx context.Context, moduleID int64) ([]domain.Assignment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.Assignment, 0)
+	for _, assignment := range r.assignments {
+		if assignment.ModuleID == moduleID {
+			result = append(result, assignment)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Order < result[j].Order
+	})
+
+	return result, nil
+}
+
+func (r *AssignmentRepository) DeleteByID(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.assignments, id)
+	return nil
+}
@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.CertificateRepository = (*CertificateRepository)(nil)
+)
+
+type CertificateRepository struct {
+	mu           sync.RWMutex
+	certificates map[int64]domain.Certificate
+	byCode       map[string]int64
+	nextID       int64
+}
+
+func NewCertificateRepository() *CertificateRepository {
+	return &CertificateRepository{
+		certificates: make(map[int64]domain.Certificate),
+		byCode:       make(map[string]int64),
+		nextID:       1,
+	}
+}
+
+func (r *CertificateRepository) Create(ctx context.Context, c *domain.Certificate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.byCode[c.Code]; exists {
+		return errors.ErrConflict
+	}
+	for _, existing := range r.certificates {
+		if existing.UserID == c.UserID && existing.CourseID == c.CourseID {
+			return errors.ErrConflict
+		}
+	}
+
+	c.ID = r.nextID
+	r.nextID++
+	c.IssuedAt = time.Now()
+
+	r.certificates[c.ID] = *c
+	r.byCode[c.Code] = c.ID
+
+	return nil
+}
+
+func (r *CertificateRepository) GetByCode(ctx context.Context, code string) (*domain.Certificate, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	id, ok := r.byCode[code]
+	if !ok {
+		return nil, false
+	}
+
+	c := r.certificates[id]
+	return &c, true
+}
+
+func (r *CertificateRepository) GetByUserAndCourse(ctx context.Context, userID, courseID int64) (*domain.Certificate, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, c := range r.certificates {
+		if c.UserID == userID && c.CourseID == courseID {
+			return &c, true
+		}
+	}
+
+	return nil, false
+}
+
+func (r *CertificateRepository) ListByUser(ctx context.Context, userID int64) ([]domain.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.Certificate, 0)
+	for _, c := range r.certificates {
+		if c.UserID == userID {
+			result = append(result, c)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].IssuedAt.After(result[j].IssuedAt)
+	})
+
+	return result, nil
+}
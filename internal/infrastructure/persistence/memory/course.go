@@ -2,6 +2,8 @@ package memory
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -14,15 +16,17 @@ var (
 )
 
 type CourseRepository struct {
-	mu      sync.RWMutex
-	courses map[int64]domain.Course
-	nextID  int64
+	mu          sync.RWMutex
+	courses     map[int64]domain.Course
+	enrollments persistence.EnrollmentRepository
+	nextID      int64
 }
 
-func NewCourseRepository() *CourseRepository {
+func NewCourseRepository(enrollments persistence.EnrollmentRepository) *CourseRepository {
 	return &CourseRepository{
-		courses: make(map[int64]domain.Course),
-		nextID:  1,
+		courses:     make(map[int64]domain.Course),
+		enrollments: enrollments,
+		nextID:      1,
 	}
 }
 
@@ -65,6 +69,99 @@ func (r *CourseRepository) ListAllLive(ctx context.Context) ([]domain.Course, er
 	return result, nil
 }
 
+func (r *CourseRepository) ListLive(ctx context.Context, filter *domain.CourseFilter) ([]domain.Course, *domain.CourseCursor, error) {
+	r.mu.RLock()
+	candidates := make([]domain.Course, 0)
+	for _, c := range r.courses {
+		if c.Status != domain.CourseStatusPublished {
+			continue
+		}
+		if filter.InstructorID != 0 && c.InstructorID != filter.InstructorID {
+			continue
+		}
+		candidates = append(candidates, c)
+	}
+	r.mu.RUnlock()
+
+	type entry struct {
+		course domain.Course
+		cursor *domain.CourseCursor
+	}
+
+	entries := make([]entry, 0, len(candidates))
+	for _, c := range candidates {
+		if filter.EnrolledUserID != 0 {
+			if _, ok := r.enrollments.GetByUserAndCourse(ctx, filter.EnrolledUserID, c.ID); !ok {
+				continue
+			}
+		}
+
+		enrollments := 0
+		if filter.Sort == domain.CourseSortMostEnrolled {
+			list, err := r.enrollments.ListByCourse(ctx, c.ID)
+			if err != nil {
+				return nil, nil, err
+			}
+			enrollments = len(list)
+		}
+
+		cursor := newCourseCursor(filter.Sort, &c, enrollments)
+		if filter.After != nil && !courseCursorBefore(filter.After, cursor) {
+			continue
+		}
+		entries = append(entries, entry{course: c, cursor: cursor})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return courseCursorBefore(entries[i].cursor, entries[j].cursor)
+	})
+
+	var next *domain.CourseCursor
+	if filter.Limit > 0 && len(entries) > filter.Limit {
+		entries = entries[:filter.Limit]
+		next = entries[len(entries)-1].cursor
+	}
+
+	result := make([]domain.Course, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, e.course)
+	}
+	return result, next, nil
+}
+
+func newCourseCursor(by domain.CourseSort, c *domain.Course, enrollments int) *domain.CourseCursor {
+	cursor := &domain.CourseCursor{Sort: by, ID: c.ID}
+	switch by {
+	case domain.CourseSortMostEnrolled:
+		cursor.Enrollments = enrollments
+	case domain.CourseSortTitle:
+		cursor.Title = strings.ToLower(c.Title)
+	default:
+		cursor.CreatedAt = c.CreatedAt
+	}
+	return cursor
+}
+
+func courseCursorBefore(a, b *domain.CourseCursor) bool {
+	switch a.Sort {
+	case domain.CourseSortMostEnrolled:
+		if a.Enrollments != b.Enrollments {
+			return a.Enrollments > b.Enrollments
+		}
+		return a.ID > b.ID
+	case domain.CourseSortTitle:
+		if a.Title != b.Title {
+			return a.Title < b.Title
+		}
+		return a.ID < b.ID
+	default:
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.After(b.CreatedAt)
+		}
+		return a.ID > b.ID
+	}
+}
+
 func (r *CourseRepository) Update(ctx context.Context, c *domain.Course) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -0,0 +1,133 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.CourseMemberRepository = (*CourseMemberRepository)(nil)
+)
+
+type CourseMemberRepository struct {
+	mu      sync.RWMutex
+	members map[int64]map[int64]domain.CourseMember
+}
+
+func NewCourseMemberRepository() *CourseMemberRepository {
+	return &CourseMemberRepository{
+		members: make(map[int64]map[int64]domain.CourseMember),
+	}
+}
+
+func (r *CourseMemberRepository) AddMember(ctx context.Context, m *domain.CourseMember) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.members[m.CourseID] == nil {
+		r.members[m.CourseID] = make(map[int64]domain.CourseMember)
+	}
+
+	if _, exists := r.members[m.CourseID][m.UserID]; exists {
+		return errors.ErrConflict
+	}
+
+	m.CreatedAt = time.Now()
+	r.members[m.CourseID][m.UserID] = *m
+
+	return nil
+}
+
+func (r *CourseMemberRepository) GetMember(ctx context.Context, courseID, userID int64) (*domain.CourseMember, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	member, ok := r.members[courseID][userID]
+	if !ok {
+		return nil, false
+	}
+
+	return &member, true
+}
+
+func (r *CourseMemberRepository) ListMembers(ctx context.Context, courseID int64) ([]domain.CourseMember, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.CourseMember, 0, len(r.members[courseID]))
+	for _, m := range r.members[courseID] {
+		result = append(result, m)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].UserID < result[j].UserID
+	})
+
+	return result, nil
+}
+
+func (r *CourseMemberRepository) ListMembershipsByUser(ctx context.Context, userID int64) ([]domain.CourseMember, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.CourseMember, 0)
+	for _, courseMembers := range r.members {
+		if m, ok := courseMembers[userID]; ok {
+			result = append(result, m)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.After(result[j].CreatedAt)
+		}
+		return result[i].CourseID > result[j].CourseID
+	})
+
+	return result, nil
+}
+
+func (r *CourseMemberRepository) UpdateMemberRole(ctx context.Context, courseID, userID int64, role domain.CourseRole) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	member, ok := r.members[courseID][userID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	member.Role = role
+	r.members[courseID][userID] = member
+
+	return nil
+}
+
+func (r *CourseMemberRepository) RemoveMember(ctx context.Context, courseID, userID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	courseMembers, ok := r.members[courseID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	if _, ok := courseMembers[userID]; !ok {
+		return errors.ErrNotFound
+	}
+
+	delete(courseMembers, userID)
+	if len(courseMembers) == 0 {
+		delete(r.members, courseID)
+	}
+
+	return nil
+}
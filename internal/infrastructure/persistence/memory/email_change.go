@@ -0,0 +1,69 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var (
+	_ persistence.EmailChangeRepository = (*EmailChangeRepository)(nil)
+)
+
+type emailChangeToken struct {
+	userID    int64
+	newEmail  string
+	tokenHash []byte
+	expiresAt time.Time
+}
+
+type EmailChangeRepository struct {
+	mu     sync.Mutex
+	tokens []emailChangeToken
+}
+
+func NewEmailChangeRepository() *EmailChangeRepository {
+	return &EmailChangeRepository{
+		tokens: make([]emailChangeToken, 0),
+	}
+}
+
+func (r *EmailChangeRepository) CreateEmailChangeToken(ctx context.Context, userID int64, newEmail string, tokenHash []byte, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tokens := r.tokens[:0]
+	for _, t := range r.tokens {
+		if t.userID != userID {
+			tokens = append(tokens, t)
+		}
+	}
+	r.tokens = append(tokens, emailChangeToken{
+		userID:    userID,
+		newEmail:  newEmail,
+		tokenHash: tokenHash,
+		expiresAt: expiresAt,
+	})
+
+	return nil
+}
+
+func (r *EmailChangeRepository) ConsumeEmailChangeToken(ctx context.Context, tokenHash []byte, now time.Time) (int64, string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, t := range r.tokens {
+		if bytes.Equal(t.tokenHash, tokenHash) {
+			r.tokens = append(r.tokens[:i], r.tokens[i+1:]...)
+			if !now.Before(t.expiresAt) {
+				return 0, "", false
+			}
+			return t.userID, t.newEmail, true
+		}
+	}
+
+	return 0, "", false
+}
@@ -0,0 +1,60 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var (
+	_ persistence.EmailVerificationRepository = (*EmailVerificationRepository)(nil)
+)
+
+type verificationToken struct {
+	userID    int64
+	tokenHash []byte
+	expiresAt time.Time
+	consumed  bool
+}
+
+type EmailVerificationRepository struct {
+	mu     sync.Mutex
+	tokens []verificationToken
+}
+
+func NewEmailVerificationRepository() *EmailVerificationRepository {
+	return &EmailVerificationRepository{
+		tokens: make([]verificationToken, 0),
+	}
+}
+
+func (r *EmailVerificationRepository) CreateVerificationToken(ctx context.Context, userID int64, tokenHash []byte, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens = append(r.tokens, verificationToken{
+		userID:    userID,
+		tokenHash: tokenHash,
+		expiresAt: expiresAt,
+	})
+
+	return nil
+}
+
+func (r *EmailVerificationRepository) ConsumeVerificationToken(ctx context.Context, tokenHash []byte, now time.Time) (int64, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.tokens {
+		t := &r.tokens[i]
+		if !t.consumed && bytes.Equal(t.tokenHash, tokenHash) && now.Before(t.expiresAt) {
+			t.consumed = true
+			return t.userID, true
+		}
+	}
+
+	return 0, false
+}
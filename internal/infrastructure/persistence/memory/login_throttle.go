@@ -0,0 +1,75 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.LoginThrottleRepository = (*LoginThrottleRepository)(nil)
+)
+
+type LoginThrottleRepository struct {
+	mu        sync.RWMutex
+	throttles map[string]domain.LoginThrottle
+}
+
+func NewLoginThrottleRepository() *LoginThrottleRepository {
+	return &LoginThrottleRepository{
+		throttles: make(map[string]domain.LoginThrottle),
+	}
+}
+
+func (r *LoginThrottleRepository) GetThrottle(ctx context.Context, key string) (*domain.LoginThrottle, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	t, ok := r.throttles[key]
+	if !ok {
+		return nil, false
+	}
+
+	return &t, true
+}
+
+func (r *LoginThrottleRepository) RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (*domain.LoginThrottle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t, ok := r.throttles[key]
+	if !ok || now.Sub(t.LastFailureAt) > window {
+		t = domain.LoginThrottle{Key: key}
+	}
+	t.Failures++
+	t.LastFailureAt = now
+	r.throttles[key] = t
+
+	return &t, nil
+}
+
+func (r *LoginThrottleRepository) LockThrottle(ctx context.Context, key string, until time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t, ok := r.throttles[key]
+	if !ok {
+		return errors.ErrNotFound
+	}
+	t.LockedUntil = &until
+	r.throttles[key] = t
+
+	return nil
+}
+
+func (r *LoginThrottleRepository) ResetThrottle(ctx context.Context, key string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.throttles, key)
+	return nil
+}
@@ -15,19 +15,35 @@ func TestUserRepository(t *testing.T) {
 
 func TestProposalRepository(t *testing.T) {
 	test.TestProposalRepository(t, func(t *testing.T) persistence.ProposalRepository {
-		return NewProposalRepository()
+		return NewProposalRepository(NewUserRepository())
 	}, func(t *testing.T) persistence.UserRepository {
 		return NewUserRepository()
 	})
 }
 
+func TestProposalQueue(t *testing.T) {
+	test.TestProposalQueue(t, func(t *testing.T) (persistence.ProposalRepository, persistence.UserRepository) {
+		users := NewUserRepository()
+		return NewProposalRepository(users), users
+	})
+}
+
 func TestCourseRepository(t *testing.T) {
 	test.TestCourseRepository(t, func(t *testing.T) persistence.CourseRepository {
-		return NewCourseRepository()
+		return NewCourseRepository(NewEnrollmentRepository())
 	}, func(t *testing.T) persistence.UserRepository {
 		return NewUserRepository()
 	}, func(t *testing.T) persistence.ProposalRepository {
-		return NewProposalRepository()
+		return NewProposalRepository(NewUserRepository())
+	})
+}
+
+func TestCourseListing(t *testing.T) {
+	test.TestCourseListing(t, func(t *testing.T) (persistence.CourseRepository, persistence.EnrollmentRepository) {
+		enrollments := NewEnrollmentRepository()
+		return NewCourseRepository(enrollments), enrollments
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
 	})
 }
 
@@ -39,11 +55,63 @@ func TestPasswordResetRepository(t *testing.T) {
 	})
 }
 
+func TestEmailVerificationRepository(t *testing.T) {
+	test.TestEmailVerificationRepository(t, func(t *testing.T) persistence.EmailVerificationRepository {
+		return NewEmailVerificationRepository()
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestEmailChangeRepository(t *testing.T) {
+	test.TestEmailChangeRepository(t, func(t *testing.T) persistence.EmailChangeRepository {
+		return NewEmailChangeRepository()
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestRecoveryCodeRepository(t *testing.T) {
+	test.TestRecoveryCodeRepository(t, func(t *testing.T) persistence.RecoveryCodeRepository {
+		return NewRecoveryCodeRepository()
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestRolePolicyRepository(t *testing.T) {
+	test.TestRolePolicyRepository(t, func(t *testing.T) persistence.RolePolicyRepository {
+		return NewRolePolicyRepository()
+	})
+}
+
+func TestAPITokenRepository(t *testing.T) {
+	test.TestAPITokenRepository(t, func(t *testing.T) persistence.APITokenRepository {
+		return NewAPITokenRepository()
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestUserIdentityRepository(t *testing.T) {
+	test.TestUserIdentityRepository(t, func(t *testing.T) persistence.UserIdentityRepository {
+		return NewUserIdentityRepository()
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestLoginThrottleRepository(t *testing.T) {
+	test.TestLoginThrottleRepository(t, func(t *testing.T) persistence.LoginThrottleRepository {
+		return NewLoginThrottleRepository()
+	})
+}
+
 func TestModuleRepository(t *testing.T) {
 	test.TestModuleRepository(t, func(t *testing.T) persistence.ModuleRepository {
 		return NewModuleRepository()
 	}, func(t *testing.T) persistence.CourseRepository {
-		return NewCourseRepository()
+		return NewCourseRepository(NewEnrollmentRepository())
 	}, func(t *testing.T) persistence.UserRepository {
 		return NewUserRepository()
 	})
@@ -55,7 +123,117 @@ func TestReadingRepository(t *testing.T) {
 	}, func(t *testing.T) persistence.ModuleRepository {
 		return NewModuleRepository()
 	}, func(t *testing.T) persistence.CourseRepository {
-		return NewCourseRepository()
+		return NewCourseRepository(NewEnrollmentRepository())
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestQuizRepository(t *testing.T) {
+	test.TestQuizRepository(t, func(t *testing.T) persistence.QuizRepository {
+		return NewQuizRepository()
+	}, func(t *testing.T) persistence.ModuleRepository {
+		return NewModuleRepository()
+	}, func(t *testing.T) persistence.CourseRepository {
+		return NewCourseRepository(NewEnrollmentRepository())
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestQuizAttemptRepository(t *testing.T) {
+	test.TestQuizAttemptRepository(t, func(t *testing.T) persistence.QuizAttemptRepository {
+		return NewQuizAttemptRepository()
+	}, func(t *testing.T) persistence.QuizRepository {
+		return NewQuizRepository()
+	}, func(t *testing.T) persistence.ModuleRepository {
+		return NewModuleRepository()
+	}, func(t *testing.T) persistence.CourseRepository {
+		return NewCourseRepository(NewEnrollmentRepository())
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestAssignmentRepository(t *testing.T) {
+	test.TestAssignmentRepository(t, func(t *testing.T) persistence.AssignmentRepository {
+		return NewAssignmentRepository()
+	}, func(t *testing.T) persistence.ModuleRepository {
+		return NewModuleRepository()
+	}, func(t *testing.T) persistence.CourseRepository {
+		return NewCourseRepository(NewEnrollmentRepository())
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestSubmissionRepository(t *testing.T) {
+	test.TestSubmissionRepository(t, func(t *testing.T) persistence.SubmissionRepository {
+		return NewSubmissionRepository()
+	}, func(t *testing.T) persistence.AssignmentRepository {
+		return NewAssignmentRepository()
+	}, func(t *testing.T) persistence.ModuleRepository {
+		return NewModuleRepository()
+	}, func(t *testing.T) persistence.CourseRepository {
+		return NewCourseRepository(NewEnrollmentRepository())
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestProgressRepository(t *testing.T) {
+	test.TestProgressRepository(t, func(t *testing.T) persistence.ProgressRepository {
+		return NewProgressRepository()
+	}, func(t *testing.T) persistence.ReadingRepository {
+		return NewReadingRepository()
+	}, func(t *testing.T) persistence.ModuleRepository {
+		return NewModuleRepository()
+	}, func(t *testing.T) persistence.CourseRepository {
+		return NewCourseRepository(NewEnrollmentRepository())
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestCertificateRepository(t *testing.T) {
+	test.TestCertificateRepository(t, func(t *testing.T) persistence.CertificateRepository {
+		return NewCertificateRepository()
+	}, func(t *testing.T) persistence.CourseRepository {
+		return NewCourseRepository(NewEnrollmentRepository())
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestCourseMemberRepository(t *testing.T) {
+	test.TestCourseMemberRepository(t, func(t *testing.T) persistence.CourseMemberRepository {
+		return NewCourseMemberRepository()
+	}, func(t *testing.T) persistence.CourseRepository {
+		return NewCourseRepository(NewEnrollmentRepository())
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestProposalAssignmentRepository(t *testing.T) {
+	test.TestProposalAssignmentRepository(t, func(t *testing.T) persistence.ProposalAssignmentRepository {
+		return NewProposalAssignmentRepository()
+	}, func(t *testing.T) persistence.ProposalRepository {
+		return NewProposalRepository(NewUserRepository())
+	}, func(t *testing.T) persistence.UserRepository {
+		return NewUserRepository()
+	})
+}
+
+func TestSearchRepository(t *testing.T) {
+	test.TestSearchRepository(t, func(t *testing.T, courses persistence.CourseRepository, modules persistence.ModuleRepository, readings persistence.ReadingRepository) persistence.SearchRepository {
+		return NewSearchRepository(courses, modules, readings)
+	}, func(t *testing.T) persistence.ReadingRepository {
+		return NewReadingRepository()
+	}, func(t *testing.T) persistence.ModuleRepository {
+		return NewModuleRepository()
+	}, func(t *testing.T) persistence.CourseRepository {
+		return NewCourseRepository(NewEnrollmentRepository())
 	}, func(t *testing.T) persistence.UserRepository {
 		return NewUserRepository()
 	})
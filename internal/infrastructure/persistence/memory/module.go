@@ -35,6 +35,9 @@ func (r *ModuleRepository) Create(ctx context.Context, m *domain.Module) error {
 	r.nextID++
 	m.CreatedAt = time.Now()
 	m.UpdatedAt = time.Now()
+	if m.UnlockRule == "" {
+		m.UnlockRule = domain.ModuleUnlockImmediately
+	}
 
 	r.modules[m.ID] = *m
 	return nil
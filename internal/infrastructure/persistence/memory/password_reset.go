@@ -39,6 +39,19 @@ func (r *PasswordResetRepository) CreateResetToken(ctx context.Context, userID i
 	return nil
 }
 
+func (r *PasswordResetRepository) GetResetToken(ctx context.Context, tokenHash []byte, now time.Time) (int64, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, t := range r.tokens {
+		if !t.consumed && bytes.Equal(t.tokenHash, tokenHash) && now.Before(t.expiresAt) {
+			return t.userID, true
+		}
+	}
+
+	return 0, false
+}
+
 func (r *PasswordResetRepository) ConsumeResetToken(ctx context.Context, tokenHash []byte, now time.Time) (int64, bool) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
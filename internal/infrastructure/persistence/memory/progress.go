@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.ProgressRepository = (*ProgressRepository)(nil)
+)
+
+type completionKey struct {
+	userID      int64
+	contentType domain.ContentType
+	contentID   int64
+}
+
+type ProgressRepository struct {
+	mu          sync.RWMutex
+	completions map[completionKey]domain.ContentCompletion
+}
+
+func NewProgressRepository() *ProgressRepository {
+	return &ProgressRepository{
+		completions: make(map[completionKey]domain.ContentCompletion),
+	}
+}
+
+func (r *ProgressRepository) Create(ctx context.Context, c *domain.ContentCompletion) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := completionKey{c.UserID, c.ContentType, c.ContentID}
+	if _, exists := r.completions[key]; exists {
+		return errors.ErrConflict
+	}
+
+	c.CompletedAt = time.Now()
+	r.completions[key] = *c
+
+	return nil
+}
+
+func (r *ProgressRepository) Get(ctx context.Context, userID int64, contentType domain.ContentType, contentID int64) (*domain.ContentCompletion, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	completion, ok := r.completions[completionKey{userID, contentType, contentID}]
+	if !ok {
+		return nil, false
+	}
+
+	return &completion, true
+}
+
+func (r *ProgressRepository) ListByUserAndCourse(ctx context.Context, userID, courseID int64) ([]domain.ContentCompletion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.ContentCompletion, 0)
+	for _, c := range r.completions {
+		if c.UserID == userID && c.CourseID == courseID {
+			result = append(result, c)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].CompletedAt.Before(result[j].CompletedAt)
+	})
+
+	return result, nil
+}
+
+func (r *ProgressRepository) Delete(ctx context.Context, userID int64, contentType domain.ContentType, contentID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := completionKey{userID, contentType, contentID}
+	if _, ok := r.completions[key]; !ok {
+		return errors.ErrNotFound
+	}
+
+	delete(r.completions, key)
+	return nil
+}
@@ -2,12 +2,15 @@ package memory
 
 import (
 	"context"
+	"slices"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
 )
 
 var (
@@ -15,15 +18,29 @@ var (
 )
 
 type ProposalRepository struct {
-	mu        sync.RWMutex
-	proposals map[int64]domain.Proposal
-	nextID    int64
+	mu               sync.RWMutex
+	proposals        map[int64]domain.Proposal
+	comments         map[int64][]domain.ProposalComment
+	versions         map[int64][]domain.ProposalVersion
+	attachments      map[int64][]domain.ProposalAttachment
+	users            persistence.UserRepository
+	nextID           int64
+	nextCommentID    int64
+	nextVersionID    int64
+	nextAttachmentID int64
 }
 
-func NewProposalRepository() *ProposalRepository {
+func NewProposalRepository(users persistence.UserRepository) *ProposalRepository {
 	return &ProposalRepository{
-		proposals: make(map[int64]domain.Proposal),
-		nextID:    1,
+		proposals:        make(map[int64]domain.Proposal),
+		comments:         make(map[int64][]domain.ProposalComment),
+		versions:         make(map[int64][]domain.ProposalVersion),
+		attachments:      make(map[int64][]domain.ProposalAttachment),
+		users:            users,
+		nextID:           1,
+		nextCommentID:    1,
+		nextVersionID:    1,
+		nextAttachmentID: 1,
 	}
 }
 
@@ -36,7 +53,7 @@ func (r *ProposalRepository) Create(ctx context.Context, p *domain.Proposal) err
 	p.CreatedAt = time.Now()
 	p.UpdatedAt = time.Now()
 
-	r.proposals[p.ID] = *p
+	r.proposals[p.ID] = copyProposal(*p)
 
 	return nil
 }
@@ -50,6 +67,7 @@ func (r *ProposalRepository) GetByID(ctx context.Context, id int64) (*domain.Pro
 		return nil, false
 	}
 
+	p = copyProposal(p)
 	return &p, true
 }
 
@@ -60,7 +78,7 @@ func (r *ProposalRepository) ListByAuthorID(ctx context.Context, authorID int64)
 	result := make([]domain.Proposal, 0)
 	for _, p := range r.proposals {
 		if p.AuthorID == authorID {
-			result = append(result, p)
+			result = append(result, copyProposal(p))
 		}
 	}
 	sort.SliceStable(result, func(i, j int) bool {
@@ -79,7 +97,7 @@ func (r *ProposalRepository) ListAllSubmitted(ctx context.Context) ([]domain.Pro
 			p.Status == domain.ProposalStatusApproved ||
 			p.Status == domain.ProposalStatusRejected ||
 			p.Status == domain.ProposalStatusChangesRequested {
-			result = append(result, p)
+			result = append(result, copyProposal(p))
 		}
 	}
 	sort.SliceStable(result, func(i, j int) bool {
@@ -89,6 +107,109 @@ func (r *ProposalRepository) ListAllSubmitted(ctx context.Context) ([]domain.Pro
 	return result, nil
 }
 
+func (r *ProposalRepository) ListQueue(ctx context.Context, filter *domain.ProposalFilter) ([]domain.Proposal, *domain.ProposalCursor, error) {
+	statuses := filter.Statuses
+	if len(statuses) == 0 {
+		statuses = domain.ReviewableProposalStatuses
+	}
+
+	type entry struct {
+		proposal domain.Proposal
+		cursor   *domain.ProposalCursor
+	}
+
+	entries := make([]entry, 0)
+	for _, p := range r.reviewable(ctx, filter.Author) {
+		if !slices.Contains(statuses, p.Status) {
+			continue
+		}
+		cursor := newProposalCursor(filter.Sort, filter.Ascending, &p)
+		if filter.After != nil && !proposalCursorBefore(filter.After, cursor) {
+			continue
+		}
+		entries = append(entries, entry{proposal: p, cursor: cursor})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return proposalCursorBefore(entries[i].cursor, entries[j].cursor)
+	})
+
+	var next *domain.ProposalCursor
+	if filter.Limit > 0 && len(entries) > filter.Limit {
+		entries = entries[:filter.Limit]
+		next = entries[len(entries)-1].cursor
+	}
+
+	result := make([]domain.Proposal, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, e.proposal)
+	}
+	return result, next, nil
+}
+
+func (r *ProposalRepository) CountByStatus(ctx context.Context, filter *domain.ProposalFilter) (map[domain.ProposalStatus]int, error) {
+	counts := make(map[domain.ProposalStatus]int)
+	for _, p := range r.reviewable(ctx, filter.Author) {
+		counts[p.Status]++
+	}
+	return counts, nil
+}
+
+// reviewable returns copies of all submitted proposals whose author's name or
+// email contains the search term.
+func (r *ProposalRepository) reviewable(ctx context.Context, author string) []domain.Proposal {
+	r.mu.RLock()
+	candidates := make([]domain.Proposal, 0)
+	for _, p := range r.proposals {
+		if p.WasSubmitted() {
+			candidates = append(candidates, copyProposal(p))
+		}
+	}
+	r.mu.RUnlock()
+
+	search := strings.ToLower(author)
+	if search == "" {
+		return candidates
+	}
+
+	result := make([]domain.Proposal, 0, len(candidates))
+	for _, p := range candidates {
+		u, ok := r.users.GetByID(ctx, p.AuthorID)
+		if !ok {
+			continue
+		}
+		if strings.Contains(strings.ToLower(u.Email), search) ||
+			strings.Contains(strings.ToLower(u.Name), search) {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func newProposalCursor(by domain.ProposalSort, ascending bool, p *domain.Proposal) *domain.ProposalCursor {
+	cursor := &domain.ProposalCursor{Sort: by, Ascending: ascending, ID: p.ID}
+	switch by {
+	case domain.ProposalSortSubmitted:
+		cursor.At = p.QueuedAt()
+	default:
+		cursor.At = p.UpdatedAt
+	}
+	return cursor
+}
+
+func proposalCursorBefore(a, b *domain.ProposalCursor) bool {
+	if !a.At.Equal(b.At) {
+		if a.Ascending {
+			return a.At.Before(b.At)
+		}
+		return a.At.After(b.At)
+	}
+	if a.Ascending {
+		return a.ID < b.ID
+	}
+	return a.ID > b.ID
+}
+
 func (r *ProposalRepository) Update(ctx context.Context, p *domain.Proposal) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -98,7 +219,7 @@ func (r *ProposalRepository) Update(ctx context.Context, p *domain.Proposal) err
 	}
 
 	p.UpdatedAt = time.Now()
-	r.proposals[p.ID] = *p
+	r.proposals[p.ID] = copyProposal(*p)
 
 	return nil
 }
@@ -108,5 +229,158 @@ func (r *ProposalRepository) DeleteByID(ctx context.Context, id int64) error {
 	defer r.mu.Unlock()
 
 	delete(r.proposals, id)
+	delete(r.comments, id)
+	delete(r.versions, id)
+	delete(r.attachments, id)
+	return nil
+}
+
+func (r *ProposalRepository) CreateComment(ctx context.Context, comment *domain.ProposalComment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.proposals[comment.ProposalID]; !ok {
+		return errors.ErrNotFound
+	}
+
+	comment.ID = r.nextCommentID
+	r.nextCommentID++
+	comment.CreatedAt = time.Now()
+
+	r.comments[comment.ProposalID] = append(r.comments[comment.ProposalID], *comment)
+	return nil
+}
+
+func (r *ProposalRepository) ListComments(ctx context.Context, proposalID int64) ([]domain.ProposalComment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	comments := r.comments[proposalID]
+	result := make([]domain.ProposalComment, len(comments))
+	copy(result, comments)
+	return result, nil
+}
+
+func (r *ProposalRepository) CreateVersion(ctx context.Context, version *domain.ProposalVersion) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.proposals[version.ProposalID]; !ok {
+		return errors.ErrNotFound
+	}
+
+	versions := r.versions[version.ProposalID]
+	version.ID = r.nextVersionID
+	r.nextVersionID++
+	version.Number = len(versions) + 1
+	version.SubmittedAt = time.Now()
+
+	stored := *version
+	stored.OutlineModules = copyOutlineModules(version.OutlineModules)
+	r.versions[version.ProposalID] = append(versions, stored)
 	return nil
 }
+
+func (r *ProposalRepository) GetVersion(ctx context.Context, proposalID int64, number int) (*domain.ProposalVersion, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions := r.versions[proposalID]
+	if number < 1 || number > len(versions) {
+		return nil, false
+	}
+
+	version := versions[number-1]
+	version.OutlineModules = copyOutlineModules(version.OutlineModules)
+	return &version, true
+}
+
+func (r *ProposalRepository) ListVersions(ctx context.Context, proposalID int64) ([]domain.ProposalVersion, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions := r.versions[proposalID]
+	result := make([]domain.ProposalVersion, 0, len(versions))
+	for i := len(versions) - 1; i >= 0; i-- {
+		version := versions[i]
+		version.OutlineModules = copyOutlineModules(version.OutlineModules)
+		result = append(result, version)
+	}
+
+	return result, nil
+}
+
+func (r *ProposalRepository) CreateAttachment(ctx context.Context, attachment *domain.ProposalAttachment, maxCount int, maxBytes int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.proposals[attachment.ProposalID]; !ok {
+		return errors.ErrNotFound
+	}
+
+	existing := r.attachments[attachment.ProposalID]
+	total := attachment.FileSize
+	for _, a := range existing {
+		total += a.FileSize
+	}
+	if len(existing) >= maxCount || total > maxBytes {
+		return errors.ErrAttachmentLimitReached
+	}
+
+	attachment.ID = r.nextAttachmentID
+	r.nextAttachmentID++
+	attachment.CreatedAt = time.Now()
+
+	r.attachments[attachment.ProposalID] = append(r.attachments[attachment.ProposalID], *attachment)
+	return nil
+}
+
+func (r *ProposalRepository) GetAttachment(ctx context.Context, proposalID, attachmentID int64) (*domain.ProposalAttachment, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, a := range r.attachments[proposalID] {
+		if a.ID == attachmentID {
+			return &a, true
+		}
+	}
+	return nil, false
+}
+
+func (r *ProposalRepository) ListAttachments(ctx context.Context, proposalID int64) ([]domain.ProposalAttachment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	attachments := r.attachments[proposalID]
+	result := make([]domain.ProposalAttachment, len(attachments))
+	copy(result, attachments)
+	return result, nil
+}
+
+func (r *ProposalRepository) DeleteAttachment(ctx context.Context, proposalID, attachmentID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attachments := r.attachments[proposalID]
+	for i, a := range attachments {
+		if a.ID == attachmentID {
+			r.attachments[proposalID] = append(attachments[:i:i], attachments[i+1:]...)
+			return nil
+		}
+	}
+	return errors.ErrNotFound
+}
+
+func copyProposal(p domain.Proposal) domain.Proposal {
+	p.OutlineModules = copyOutlineModules(p.OutlineModules)
+	return p
+}
+
+func copyOutlineModules(modules []domain.OutlineModule) []domain.OutlineModule {
+	copied := make([]domain.OutlineModule, len(modules))
+	for i, m := range modules {
+		m.Items = append([]string{}, m.Items...)
+		copied[i] = m
+	}
+	return copied
+}
@@ -0,0 +1,221 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.ProposalAssignmentRepository = (*ProposalAssignmentRepository)(nil)
+)
+
+type ProposalAssignmentRepository struct {
+	mu          sync.RWMutex
+	assignments map[int64]map[int64]domain.ProposalAssignment
+}
+
+func NewProposalAssignmentRepository() *ProposalAssignmentRepository {
+	return &ProposalAssignmentRepository{
+		assignments: make(map[int64]map[int64]domain.ProposalAssignment),
+	}
+}
+
+func (r *ProposalAssignmentRepository) AssignReviewer(ctx context.Context, a *domain.ProposalAssignment) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.assignments[a.ProposalID] == nil {
+		r.assignments[a.ProposalID] = make(map[int64]domain.ProposalAssignment)
+	}
+
+	if _, exists := r.assignments[a.ProposalID][a.ReviewerID]; exists {
+		return errors.ErrConflict
+	}
+
+	now := time.Now()
+	a.Vote = ""
+	a.Notes = ""
+	a.VotedAt = nil
+	a.RemindedAt = nil
+	a.AssignedAt = now
+	a.PendingSince = now
+	r.assignments[a.ProposalID][a.ReviewerID] = *a
+
+	return nil
+}
+
+func (r *ProposalAssignmentRepository) GetAssignment(ctx context.Context, proposalID, reviewerID int64) (*domain.ProposalAssignment, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	a, ok := r.assignments[proposalID][reviewerID]
+	if !ok {
+		return nil, false
+	}
+
+	return &a, true
+}
+
+func (r *ProposalAssignmentRepository) ListAssignments(ctx context.Context, proposalID int64) ([]domain.ProposalAssignment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.ProposalAssignment, 0, len(r.assignments[proposalID]))
+	for _, a := range r.assignments[proposalID] {
+		result = append(result, a)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].AssignedAt.Equal(result[j].AssignedAt) {
+			return result[i].AssignedAt.Before(result[j].AssignedAt)
+		}
+		return result[i].ReviewerID < result[j].ReviewerID
+	})
+
+	return result, nil
+}
+
+func (r *ProposalAssignmentRepository) ListAssignmentsByReviewer(ctx context.Context, reviewerID int64) ([]domain.ProposalAssignment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.ProposalAssignment, 0)
+	for _, proposalAssignments := range r.assignments {
+		if a, ok := proposalAssignments[reviewerID]; ok {
+			result = append(result, a)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].PendingSince.Equal(result[j].PendingSince) {
+			return result[i].PendingSince.Before(result[j].PendingSince)
+		}
+		return result[i].ProposalID < result[j].ProposalID
+	})
+
+	return result, nil
+}
+
+func (r *ProposalAssignmentRepository) RecordVote(ctx context.Context, proposalID, reviewerID int64, vote domain.ProposalVote, notes string, votedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.assignments[proposalID][reviewerID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	a.Vote = vote
+	a.Notes = notes
+	a.VotedAt = &votedAt
+	r.assignments[proposalID][reviewerID] = a
+
+	return nil
+}
+
+func (r *ProposalAssignmentRepository) StartRound(ctx context.Context, proposalID int64, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for reviewerID, a := range r.assignments[proposalID] {
+		a.Vote = ""
+		a.Notes = ""
+		a.VotedAt = nil
+		a.RemindedAt = nil
+		a.PendingSince = at
+		r.assignments[proposalID][reviewerID] = a
+	}
+
+	return nil
+}
+
+func (r *ProposalAssignmentRepository) RemoveAssignment(ctx context.Context, proposalID, reviewerID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	proposalAssignments, ok := r.assignments[proposalID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	if _, ok := proposalAssignments[reviewerID]; !ok {
+		return errors.ErrNotFound
+	}
+
+	delete(proposalAssignments, reviewerID)
+	if len(proposalAssignments) == 0 {
+		delete(r.assignments, proposalID)
+	}
+
+	return nil
+}
+
+func (r *ProposalAssignmentRepository) LastAssignedAt(ctx context.Context) (map[int64]time.Time, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make(map[int64]time.Time)
+	for _, proposalAssignments := range r.assignments {
+		for reviewerID, a := range proposalAssignments {
+			if a.AssignedAt.After(result[reviewerID]) {
+				result[reviewerID] = a.AssignedAt
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func (r *ProposalAssignmentRepository) ListPendingSince(ctx context.Context, before time.Time) ([]domain.ProposalAssignment, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.ProposalAssignment, 0)
+	for _, proposalAssignments := range r.assignments {
+		for _, a := range proposalAssignments {
+			if a.HasVoted() {
+				continue
+			}
+			due := a.PendingSince
+			if a.RemindedAt != nil {
+				due = *a.RemindedAt
+			}
+			if due.Before(before) {
+				result = append(result, a)
+			}
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].PendingSince.Equal(result[j].PendingSince) {
+			return result[i].PendingSince.Before(result[j].PendingSince)
+		}
+		if result[i].ProposalID != result[j].ProposalID {
+			return result[i].ProposalID < result[j].ProposalID
+		}
+		return result[i].ReviewerID < result[j].ReviewerID
+	})
+
+	return result, nil
+}
+
+func (r *ProposalAssignmentRepository) MarkReminded(ctx context.Context, proposalID, reviewerID int64, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	a, ok := r.assignments[proposalID][reviewerID]
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	a.RemindedAt = &at
+	r.assignments[proposalID][reviewerID] = a
+
+	return nil
+}
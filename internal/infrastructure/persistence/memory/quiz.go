@@ -0,0 +1,164 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.QuizRepository        = (*QuizRepository)(nil)
+	_ persistence.QuizAttemptRepository = (*QuizAttemptRepository)(nil)
+)
+
+type QuizRepository struct {
+	mu      sync.RWMutex
+	quizzes map[int64]domain.Quiz
+	nextID  int64
+}
+
+func NewQuizRepository() *QuizRepository {
+	return &QuizRepository{
+		quizzes: make(map[int64]domain.Quiz),
+		nextID:  1,
+	}
+}
+
+func (r *QuizRepository) Create(ctx context.Context, quiz *domain.Quiz) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	quiz.ID = r.nextID
+	r.nextID++
+	quiz.CreatedAt = time.Now()
+	quiz.UpdatedAt = time.Now()
+
+	r.quizzes[quiz.ID] = copyQuiz(*quiz)
+	return nil
+}
+
+func (r *QuizRepository) GetByID(ctx context.Context, id int64) (*domain.Quiz, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	quiz, ok := r.quizzes[id]
+	if !ok {
+		return nil, false
+	}
+
+	quiz = copyQuiz(quiz)
+	return &quiz, true
+}
+
+func (r *QuizRepository) Update(ctx context.Context, quiz *domain.Quiz) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.quizzes[quiz.ID]; !ok {
+		return nil
+	}
+
+	quiz.UpdatedAt = time.Now()
+	r.quizzes[quiz.ID] = copyQuiz(*quiz)
+	return nil
+}
+
+func (r *QuizRepository) ListByModuleID(ctx context.Context, moduleID int64) ([]domain.Quiz, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.Quiz, 0)
+	for _, quiz := range r.quizzes {
+		if quiz.ModuleID == moduleID {
+			result = append(result, copyQuiz(quiz))
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Order < result[j].Order
+	})
+
+	return result, nil
+}
+
+func (r *QuizRepository) DeleteByID(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.quizzes, id)
+	return nil
+}
+
+func copyQuiz(quiz domain.Quiz) domain.Quiz {
+	questions := make([]domain.QuizQuestion, len(quiz.Questions))
+	for i, q := range quiz.Questions {
+		q.Options = append([]string(nil), q.Options...)
+		q.CorrectOptions = append([]int(nil), q.CorrectOptions...)
+		q.AcceptedAnswers = append([]string(nil), q.AcceptedAnswers...)
+		questions[i] = q
+	}
+	quiz.Questions = questions
+	return quiz
+}
+
+type QuizAttemptRepository struct {
+	mu       sync.RWMutex
+	attempts map[int64]domain.QuizAttempt
+	nextID   int64
+}
+
+func NewQuizAttemptRepository() *QuizAttemptRepository {
+	return &QuizAttemptRepository{
+		attempts: make(map[int64]domain.QuizAttempt),
+		nextID:   1,
+	}
+}
+
+func (r *QuizAttemptRepository) Create(ctx context.Context, attempt *domain.QuizAttempt, maxAttempts int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous := 0
+	for _, a := range r.attempts {
+		if a.QuizID == attempt.QuizID && a.UserID == attempt.UserID {
+			previous++
+		}
+	}
+	if maxAttempts > 0 && previous >= maxAttempts {
+		return errors.ErrAttemptLimitReached
+	}
+
+	attempt.ID = r.nextID
+	attempt.Number = previous + 1
+	r.nextID++
+	attempt.SubmittedAt = time.Now()
+
+	stored := *attempt
+	stored.Answers = append([]domain.QuizAnswer(nil), attempt.Answers...)
+	r.attempts[attempt.ID] = stored
+	return nil
+}
+
+func (r *QuizAttemptRepository) ListByQuizAndUser(ctx context.Context, quizID, userID int64) ([]domain.QuizAttempt, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.QuizAttempt, 0)
+	for _, attempt := range r.attempts {
+		if attempt.QuizID == quizID && attempt.UserID == userID {
+			attempt.Answers = append([]domain.QuizAnswer(nil), attempt.Answers...)
+			result = append(result, attempt)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
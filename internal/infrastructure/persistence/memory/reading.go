@@ -8,6 +8,7 @@ import (
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
 )
 
 var (
@@ -15,15 +16,19 @@ var (
 )
 
 type ReadingRepository struct {
-	mu       sync.RWMutex
-	readings map[int64]domain.Reading
-	nextID   int64
+	mu             sync.RWMutex
+	readings       map[int64]domain.Reading
+	revisions      map[int64][]domain.ReadingRevision
+	nextID         int64
+	nextRevisionID int64
 }
 
 func NewReadingRepository() *ReadingRepository {
 	return &ReadingRepository{
-		readings: make(map[int64]domain.Reading),
-		nextID:   1,
+		readings:       make(map[int64]domain.Reading),
+		revisions:      make(map[int64][]domain.ReadingRevision),
+		nextID:         1,
+		nextRevisionID: 1,
 	}
 }
 
@@ -88,5 +93,50 @@ func (r *ReadingRepository) DeleteByID(ctx context.Context, id int64) error {
 	defer r.mu.Unlock()
 
 	delete(r.readings, id)
+	delete(r.revisions, id)
 	return nil
 }
+
+func (r *ReadingRepository) CreateRevision(ctx context.Context, revision *domain.ReadingRevision) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.readings[revision.ReadingID]; !ok {
+		return errors.ErrNotFound
+	}
+
+	revisions := r.revisions[revision.ReadingID]
+	revision.ID = r.nextRevisionID
+	r.nextRevisionID++
+	revision.Number = len(revisions) + 1
+	revision.CreatedAt = time.Now()
+
+	r.revisions[revision.ReadingID] = append(revisions, *revision)
+	return nil
+}
+
+func (r *ReadingRepository) GetRevision(ctx context.Context, readingID int64, number int) (*domain.ReadingRevision, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	revisions := r.revisions[readingID]
+	if number < 1 || number > len(revisions) {
+		return nil, false
+	}
+
+	revision := revisions[number-1]
+	return &revision, true
+}
+
+func (r *ReadingRepository) ListRevisions(ctx context.Context, readingID int64) ([]domain.ReadingRevision, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	revisions := r.revisions[readingID]
+	result := make([]domain.ReadingRevision, 0, len(revisions))
+	for i := len(revisions) - 1; i >= 0; i-- {
+		result = append(result, revisions[i])
+	}
+
+	return result, nil
+}
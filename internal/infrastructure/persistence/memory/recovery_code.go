@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var (
+	_ persistence.RecoveryCodeRepository = (*RecoveryCodeRepository)(nil)
+)
+
+type recoveryCode struct {
+	codeHash []byte
+	used     bool
+}
+
+type RecoveryCodeRepository struct {
+	mu    sync.RWMutex
+	codes map[int64][]recoveryCode
+}
+
+func NewRecoveryCodeRepository() *RecoveryCodeRepository {
+	return &RecoveryCodeRepository{
+		codes: make(map[int64][]recoveryCode),
+	}
+}
+
+func (r *RecoveryCodeRepository) ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes [][]byte) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	codes := make([]recoveryCode, 0, len(codeHashes))
+	for _, hash := range codeHashes {
+		codes = append(codes, recoveryCode{codeHash: hash})
+	}
+	r.codes[userID] = codes
+
+	return nil
+}
+
+func (r *RecoveryCodeRepository) ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash []byte, now time.Time) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	codes := r.codes[userID]
+	for i := range codes {
+		if !codes[i].used && bytes.Equal(codes[i].codeHash, codeHash) {
+			codes[i].used = true
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *RecoveryCodeRepository) CountRecoveryCodes(ctx context.Context, userID int64) (int, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	count := 0
+	for _, c := range r.codes[userID] {
+		if !c.used {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *RecoveryCodeRepository) DeleteRecoveryCodes(ctx context.Context, userID int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.codes, userID)
+	return nil
+}
@@ -0,0 +1,47 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var (
+	_ persistence.RolePolicyRepository = (*RolePolicyRepository)(nil)
+)
+
+type RolePolicyRepository struct {
+	mu       sync.RWMutex
+	policies map[domain.SystemRole]domain.RolePolicy
+}
+
+func NewRolePolicyRepository() *RolePolicyRepository {
+	return &RolePolicyRepository{
+		policies: make(map[domain.SystemRole]domain.RolePolicy),
+	}
+}
+
+func (r *RolePolicyRepository) GetRolePolicy(ctx context.Context, role domain.SystemRole) (*domain.RolePolicy, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	p, ok := r.policies[role]
+	if !ok {
+		return nil, false
+	}
+
+	return &p, true
+}
+
+func (r *RolePolicyRepository) SaveRolePolicy(ctx context.Context, p *domain.RolePolicy) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	p.UpdatedAt = time.Now()
+	r.policies[p.Role] = *p
+
+	return nil
+}
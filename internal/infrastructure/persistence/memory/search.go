@@ -0,0 +1,311 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/events"
+	"bytecourses/internal/pkg/search"
+)
+
+var (
+	_ persistence.SearchRepository = (*SearchRepository)(nil)
+)
+
+var searchEvents = []string{
+	"course.created_from_proposal",
+	"course.updated",
+	"course.published",
+	"module.created",
+	"module.updated",
+	"module.deleted",
+	"module.published",
+	"content.created",
+	"content.updated",
+	"content.deleted",
+	"content.published",
+	"content.unpublished",
+}
+
+const (
+	weightA = 1.0
+	weightB = 0.4
+	weightC = 0.2
+)
+
+type searchKey struct {
+	kind domain.SearchResultType
+	id   int64
+}
+
+type searchField struct {
+	text   string
+	weight float64
+}
+
+type searchDocument struct {
+	result domain.SearchResult
+	terms  map[string]float64
+}
+
+type SearchRepository struct {
+	mu       sync.RWMutex
+	courses  persistence.CourseRepository
+	modules  persistence.ModuleRepository
+	readings persistence.ReadingRepository
+	docs     map[searchKey]*searchDocument
+	postings map[string]map[searchKey]float64
+	built    bool
+}
+
+func NewSearchRepository(courses persistence.CourseRepository, modules persistence.ModuleRepository, readings persistence.ReadingRepository) *SearchRepository {
+	return &SearchRepository{
+		courses:  courses,
+		modules:  modules,
+		readings: readings,
+		docs:     make(map[searchKey]*searchDocument),
+		postings: make(map[string]map[searchKey]float64),
+	}
+}
+
+func (r *SearchRepository) Subscribe(bus events.EventBus) {
+	for _, name := range searchEvents {
+		bus.Subscribe(name, r.handleEvent)
+	}
+}
+
+func (r *SearchRepository) Search(ctx context.Context, query string, limit int) ([]domain.SearchResult, error) {
+	if err := r.ensureBuilt(ctx); err != nil {
+		return nil, err
+	}
+
+	terms := search.Terms(query)
+	if len(terms) == 0 {
+		return []domain.SearchResult{}, nil
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var scores map[searchKey]float64
+	for _, term := range terms {
+		postings := r.postings[term]
+		if scores == nil {
+			scores = make(map[searchKey]float64, len(postings))
+			for key, score := range postings {
+				scores[key] = score
+			}
+			continue
+		}
+		for key := range scores {
+			score, ok := postings[key]
+			if !ok {
+				delete(scores, key)
+				continue
+			}
+			scores[key] += score
+		}
+	}
+
+	results := make([]domain.SearchResult, 0, len(scores))
+	for key, score := range scores {
+		result := r.docs[key].result
+		result.Rank = score
+		results = append(results, result)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+		if results[i].Type != results[j].Type {
+			return results[i].Type < results[j].Type
+		}
+		return results[i].ID < results[j].ID
+	})
+
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
+
+func (r *SearchRepository) ensureBuilt(ctx context.Context) error {
+	r.mu.RLock()
+	built := r.built
+	r.mu.RUnlock()
+	if built {
+		return nil
+	}
+
+	courses, err := r.courses.ListAllLive(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range courses {
+		if err := r.reindexCourse(ctx, courses[i].ID); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	r.built = true
+	r.mu.Unlock()
+	return nil
+}
+
+func (r *SearchRepository) handleEvent(ctx context.Context, event domain.Event) error {
+	var courseID int64
+	switch e := event.(type) {
+	case *domain.CourseCreatedEvent:
+		courseID = e.CourseID
+	case *domain.CourseUpdatedEvent:
+		courseID = e.CourseID
+	case *domain.CoursePublishedEvent:
+		courseID = e.CourseID
+	case *domain.ModuleCreatedEvent:
+		courseID = e.CourseID
+	case *domain.ModuleUpdatedEvent:
+		courseID = e.CourseID
+	case *domain.ModuleDeletedEvent:
+		courseID = e.CourseID
+	case *domain.ModulePublishedEvent:
+		courseID = e.CourseID
+	case *domain.ContentCreatedEvent:
+		courseID = e.CourseID
+	case *domain.ContentUpdatedEvent:
+		courseID = e.CourseID
+	case *domain.ContentDeletedEvent:
+		courseID = e.CourseID
+	case *domain.ContentPublishedEvent:
+		courseID = e.CourseID
+	case *domain.ContentUnpublishedEvent:
+		courseID = e.CourseID
+	default:
+		return nil
+	}
+
+	return r.reindexCourse(ctx, courseID)
+}
+
+func (r *SearchRepository) reindexCourse(ctx context.Context, courseID int64) error {
+	var docs []*searchDocument
+
+	course, ok := r.courses.GetByID(ctx, courseID)
+	if ok && course.IsLive() {
+		docs = append(docs, newSearchDocument(domain.SearchResult{
+			Type:        domain.SearchResultCourse,
+			ID:          course.ID,
+			CourseID:    course.ID,
+			Title:       course.Title,
+			CourseTitle: course.Title,
+			Text:        course.Summary + "\n" + course.LearningObjectives,
+		}, []searchField{
+			{course.Title, weightA},
+			{course.Summary, weightB},
+			{course.LearningObjectives, weightC},
+		}))
+
+		modules, err := r.modules.ListByCourseID(ctx, courseID)
+		if err != nil {
+			return err
+		}
+		for _, module := range modules {
+			if module.Status != domain.ModuleStatusPublished {
+				continue
+			}
+			docs = append(docs, newSearchDocument(domain.SearchResult{
+				Type:        domain.SearchResultModule,
+				ID:          module.ID,
+				CourseID:    course.ID,
+				ModuleID:    module.ID,
+				Title:       module.Title,
+				CourseTitle: course.Title,
+				Text:        module.Description,
+			}, []searchField{
+				{module.Title, weightA},
+				{module.Description, weightB},
+			}))
+
+			readings, err := r.readings.ListByModuleID(ctx, module.ID)
+			if err != nil {
+				return err
+			}
+			for _, reading := range readings {
+				if reading.Status != domain.ContentStatusPublished || reading.Content == nil {
+					continue
+				}
+				docs = append(docs, newSearchDocument(domain.SearchResult{
+					Type:        domain.SearchResultReading,
+					ID:          reading.ID,
+					CourseID:    course.ID,
+					ModuleID:    module.ID,
+					Title:       reading.Title,
+					CourseTitle: course.Title,
+					Text:        *reading.Content,
+				}, []searchField{
+					{reading.Title, weightA},
+					{*reading.Content, weightB},
+				}))
+			}
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, doc := range r.docs {
+		if doc.result.CourseID == courseID {
+			r.remove(key)
+		}
+	}
+	for _, doc := range docs {
+		r.add(doc)
+	}
+
+	return nil
+}
+
+func newSearchDocument(result domain.SearchResult, fields []searchField) *searchDocument {
+	doc := &searchDocument{
+		result: result,
+		terms:  make(map[string]float64),
+	}
+	for _, field := range fields {
+		for _, term := range search.Terms(field.text) {
+			doc.terms[term] += field.weight
+		}
+	}
+	return doc
+}
+
+func (r *SearchRepository) add(doc *searchDocument) {
+	key := searchKey{kind: doc.result.Type, id: doc.result.ID}
+	r.docs[key] = doc
+	for term, score := range doc.terms {
+		postings, ok := r.postings[term]
+		if !ok {
+			postings = make(map[searchKey]float64)
+			r.postings[term] = postings
+		}
+		postings[key] = score
+	}
+}
+
+func (r *SearchRepository) remove(key searchKey) {
+	doc, ok := r.docs[key]
+	if !ok {
+		return
+	}
+	for term := range doc.terms {
+		delete(r.postings[term], key)
+		if len(r.postings[term]) == 0 {
+			delete(r.postings, term)
+		}
+	}
+	delete(r.docs, key)
+}
@@ -0,0 +1,100 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var _ persistence.SubmissionRepository = (*SubmissionRepository)(nil)
+
+type SubmissionRepository struct {
+	mu          sync.RWMutex
+	submissions map[int64]domain.Submission
+	nextID      int64
+}
+
+func NewSubmissionRepository() *SubmissionRepository {
+	return &SubmissionRepository{
+		submissions: make(map[int64]domain.Submission),
+		nextID:      1,
+	}
+}
+
+func (r *SubmissionRepository) Create(ctx context.Context, submission *domain.Submission) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	submission.ID = r.nextID
+	r.nextID++
+	submission.SubmittedAt = time.Now()
+	submission.UpdatedAt = time.Now()
+
+	r.submissions[submission.ID] = *submission
+	return nil
+}
+
+func (r *SubmissionRepository) GetByID(ctx context.Context, id int64) (*domain.Submission, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	submission, ok := r.submissions[id]
+	if !ok {
+		return nil, false
+	}
+
+	return &submission, true
+}
+
+func (r *SubmissionRepository) Update(ctx context.Context, submission *domain.Submission) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.submissions[submission.ID]; !ok {
+		return nil
+	}
+
+	submission.UpdatedAt = time.Now()
+	r.submissions[submission.ID] = *submission
+	return nil
+}
+
+func (r *SubmissionRepository) ListByAssignmentID(ctx context.Context, assignmentID int64) ([]domain.Submission, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.Submission, 0)
+	for _, submission := range r.submissions {
+		if submission.AssignmentID == assignmentID {
+			result = append(result, submission)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+func (r *SubmissionRepository) ListByAssignmentAndUser(ctx context.Context, assignmentID, userID int64) ([]domain.Submission, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]domain.Submission, 0)
+	for _, submission := range r.submissions {
+		if submission.AssignmentID == assignmentID && submission.UserID == userID {
+			result = append(result, submission)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
@@ -2,6 +2,8 @@ package memory
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -107,3 +109,37 @@ func (r *UserRepository) DeleteByID(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+func (r *UserRepository) List(ctx context.Context, filter *domain.UserFilter) ([]domain.User, *domain.UserCursor, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	search := strings.ToLower(filter.Search)
+	result := make([]domain.User, 0)
+	for _, u := range r.users {
+		if filter.Role != "" && u.Role != filter.Role {
+			continue
+		}
+		if filter.After != nil && u.ID >= filter.After.ID {
+			continue
+		}
+		if search != "" &&
+			!strings.Contains(strings.ToLower(u.Email), search) &&
+			!strings.Contains(strings.ToLower(u.Name), search) {
+			continue
+		}
+		result = append(result, u)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ID > result[j].ID
+	})
+
+	var next *domain.UserCursor
+	if filter.Limit > 0 && len(result) > filter.Limit {
+		result = result[:filter.Limit]
+		next = &domain.UserCursor{ID: result[len(result)-1].ID}
+	}
+
+	return result, next, nil
+}
@@ -0,0 +1,62 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.UserIdentityRepository = (*UserIdentityRepository)(nil)
+)
+
+type identityKey struct {
+	issuer  string
+	subject string
+}
+
+type UserIdentityRepository struct {
+	mu         sync.RWMutex
+	identities map[identityKey]domain.UserIdentity
+	nextID     int64
+}
+
+func NewUserIdentityRepository() *UserIdentityRepository {
+	return &UserIdentityRepository{
+		identities: make(map[identityKey]domain.UserIdentity),
+		nextID:     1,
+	}
+}
+
+func (r *UserIdentityRepository) CreateIdentity(ctx context.Context, identity *domain.UserIdentity) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := identityKey{issuer: identity.Issuer, subject: identity.Subject}
+	if _, exists := r.identities[key]; exists {
+		return errors.ErrConflict
+	}
+
+	identity.ID = r.nextID
+	r.nextID++
+	identity.CreatedAt = time.Now()
+	r.identities[key] = *identity
+
+	return nil
+}
+
+func (r *UserIdentityRepository) GetIdentity(ctx context.Context, issuer, subject string) (*domain.UserIdentity, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	identity, ok := r.identities[identityKey{issuer: issuer, subject: subject}]
+	if !ok {
+		return nil, false
+	}
+
+	return &identity, true
+}
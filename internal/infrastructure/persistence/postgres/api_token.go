@@ -0,0 +1,163 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var _ persistence.APITokenRepository = (*APITokenRepository)(nil)
+
+type APITokenRepository struct {
+	db *sql.DB
+}
+
+func NewAPITokenRepository(db *DB) *APITokenRepository {
+	return &APITokenRepository{db: db.DB()}
+}
+
+func (r *APITokenRepository) CreateAPIToken(ctx context.Context, t *domain.APIToken) error {
+	scopes, err := json.Marshal(t.Scopes)
+	if err != nil {
+		return err
+	}
+
+	createdAt := time.Now().UTC()
+	if err := r.db.QueryRowContext(ctx, `
+		INSERT INTO api_tokens (user_id, name, prefix, token_hash, scopes, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`,
+		t.UserID,
+		t.Name,
+		t.Prefix,
+		t.TokenHash,
+		scopes,
+		t.ExpiresAt.UTC(),
+		createdAt,
+	).Scan(&t.ID); err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return errors.ErrConflict
+		}
+		return err
+	}
+
+	t.CreatedAt = createdAt
+	return nil
+}
+
+const apiTokenColumns = `
+	id, user_id, name, prefix, token_hash, scopes, expires_at, last_used_at, created_at
+`
+
+func scanAPIToken(row rowScanner) (*domain.APIToken, error) {
+	var t domain.APIToken
+	var scopes []byte
+
+	if err := row.Scan(
+		&t.ID,
+		&t.UserID,
+		&t.Name,
+		&t.Prefix,
+		&t.TokenHash,
+		&scopes,
+		&t.ExpiresAt,
+		&t.LastUsedAt,
+		&t.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(scopes, &t.Scopes); err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (r *APITokenRepository) GetAPITokenByHash(ctx context.Context, tokenHash []byte) (*domain.APIToken, bool) {
+	t, err := scanAPIToken(r.db.QueryRowContext(ctx, `
+		SELECT `+apiTokenColumns+`
+		FROM api_tokens
+		WHERE token_hash = $1
+	`, tokenHash))
+	if err != nil {
+		return nil, false
+	}
+
+	return t, true
+}
+
+func (r *APITokenRepository) ListAPITokensByUserID(ctx context.Context, userID int64) ([]domain.APIToken, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+apiTokenColumns+`
+		FROM api_tokens
+		WHERE user_id = $1
+		ORDER BY id
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]domain.APIToken, 0)
+	for rows.Next() {
+		t, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, *t)
+	}
+
+	return tokens, rows.Err()
+}
+
+func (r *APITokenRepository) TouchAPIToken(ctx context.Context, id int64, usedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE api_tokens
+		SET last_used_at = $2
+		WHERE id = $1
+	`, id, usedAt.UTC())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *APITokenRepository) DeleteAPIToken(ctx context.Context, userID int64, id int64) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM api_tokens
+		WHERE id = $1 AND user_id = $2
+	`, id, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
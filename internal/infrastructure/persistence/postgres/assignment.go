@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var _ persistence.AssignmentRepository = (*AssignmentRepository)(nil)
+
+type AssignmentRepository struct {
+	db *sql.DB
+}
+
+func NewAssignmentRepository(db *DB) *AssignmentRepository {
+	return &AssignmentRepository{db: db.DB()}
+}
+
+func (r *AssignmentRepository) Create(ctx context.Context, assignment *domain.Assignment) error {
+	now := time.Now().UTC()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var contentItemID int64
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO content (
+			module_id, content_type, title, order_index, status,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`,
+		assignment.ModuleID,
+		string(assignment.Type()),
+		assignment.Title,
+		assignment.Order,
+		string(assignment.Status),
+		now,
+		now,
+	).Scan(&contentItemID); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO assignments (
+			content_item_id, instructions, due_at, max_points
+		)
+		VALUES ($1, $2, $3, $4)
+	`,
+		contentItemID,
+		assignment.Instructions,
+		assignment.DueAt,
+		assignment.MaxPoints,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	assignment.ID = contentItemID
+	assignment.CreatedAt = now
+	assignment.UpdatedAt = now
+	return nil
+}
+
+func (r *AssignmentRepository) GetByID(ctx context.Context, id int64) (*domain.Assignment, bool) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT ci.id, ci.module_id, ci.content_type, ci.title, ci.order_index,
+		       ci.status, ci.created_at, ci.updated_at,
+		       a.instructions, a.due_at, a.max_points
+		FROM content ci
+		INNER JOIN assignments a ON ci.id = a.content_item_id
+		WHERE ci.id = $1
+	`, id)
+
+	assignment, err := scanAssignment(row)
+	if err != nil {
+		return nil, false
+	}
+
+	return assignment, true
+}
+
+func (r *AssignmentRepository) Update(ctx context.Context, assignment *domain.Assignment) error {
+	assignment.UpdatedAt = time.Now().UTC()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE content
+		SET title = $2,
+		    order_index = $3,
+		    status = $4,
+		    updated_at = $5
+		WHERE id = $1
+	`,
+		assignment.ID,
+		assignment.Title,
+		assignment.Order,
+		string(assignment.Status),
+		assignment.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE assignments
+		SET instructions = $2,
+		    due_at = $3,
+		    max_points = $4
+		WHERE content_item_id = $1
+	`,
+		assignment.ID,
+		assignment.Instructions,
+		assignment.DueAt,
+		assignment.MaxPoints,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *AssignmentRepository) ListByModuleID(ctx context.Context, moduleID int64) ([]domain.Assignment, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT ci.id, ci.module_id, ci.content_type, ci.title, ci.order_index,
+		       ci.status, ci.created_at, ci.updated_at,
+		       a.instructions, a.due_at, a.max_points
+		FROM content ci
+		INNER JOIN assignments a ON ci.id = a.content_item_id
+		WHERE ci.module_id = $1
+		ORDER BY ci.order_index ASC
+	`, moduleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignments := make([]domain.Assignment, 0)
+	for rows.Next() {
+		assignment, err := scanAssignment(rows)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, *assignment)
+	}
+
+	return assignments, rows.Err()
+}
+
+func (r *AssignmentRepository) DeleteByID(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM content WHERE id = $1`, id)
+	return err
+}
+
+func scanAssignment(row rowScanner) (*domain.Assignment, error) {
+	var assignment domain.Assignment
+	var status string
+	var contentType string
+	var dueAt sql.NullTime
+
+	if err := row.Scan(
+		&assignment.ID,
+		&assignment.ModuleID,
+		&contentType,
+		&assignment.Title,
+		&assignment.Order,
+		&status,
+		&assignment.CreatedAt,
+		&assignment.UpdatedAt,
+		&assignment.Instructions,
+		&dueAt,
+		&assignment.MaxPoints,
+	); err != nil {
+		return nil, err
+	}
+
+	if contentType != string(domain.ContentTypeAssignment) {
+		return nil, sql.ErrNoRows
+	}
+
+	if dueAt.Valid {
+		assignment.DueAt = &dueAt.Time
+	}
+
+	assignment.Status = domain.ContentStatus(status)
+	return &assignment, nil
+}
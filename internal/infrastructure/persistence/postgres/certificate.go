@@ -0,0 +1,124 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.CertificateRepository = (*CertificateRepository)(nil)
+)
+
+type CertificateRepository struct {
+	db *sql.DB
+}
+
+func NewCertificateRepository(db *DB) *CertificateRepository {
+	return &CertificateRepository{
+		db: db.DB(),
+	}
+}
+
+func (r *CertificateRepository) Create(ctx context.Context, c *domain.Certificate) error {
+	issuedAt := time.Now().UTC()
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO certificates (
+			code, user_id, course_id, learner_name, course_title, instructor_name, issued_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, issued_at
+	`,
+		c.Code,
+		c.UserID,
+		c.CourseID,
+		c.LearnerName,
+		c.CourseTitle,
+		c.InstructorName,
+		issuedAt,
+	).Scan(&c.ID, &c.IssuedAt)
+
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return errors.ErrConflict
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *CertificateRepository) GetByCode(ctx context.Context, code string) (*domain.Certificate, bool) {
+	return scanCertificate(r.db.QueryRowContext(ctx, `
+		SELECT id, code, user_id, course_id, learner_name, course_title, instructor_name, issued_at
+		FROM certificates
+		WHERE code = $1
+	`, code))
+}
+
+func (r *CertificateRepository) GetByUserAndCourse(ctx context.Context, userID, courseID int64) (*domain.Certificate, bool) {
+	return scanCertificate(r.db.QueryRowContext(ctx, `
+		SELECT id, code, user_id, course_id, learner_name, course_title, instructor_name, issued_at
+		FROM certificates
+		WHERE user_id = $1 AND course_id = $2
+	`, userID, courseID))
+}
+
+func (r *CertificateRepository) ListByUser(ctx context.Context, userID int64) ([]domain.Certificate, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, code, user_id, course_id, learner_name, course_title, instructor_name, issued_at
+		FROM certificates
+		WHERE user_id = $1
+		ORDER BY issued_at DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	certificates := make([]domain.Certificate, 0)
+	for rows.Next() {
+		var c domain.Certificate
+		if err := rows.Scan(
+			&c.ID,
+			&c.Code,
+			&c.UserID,
+			&c.CourseID,
+			&c.LearnerName,
+			&c.CourseTitle,
+			&c.InstructorName,
+			&c.IssuedAt,
+		); err != nil {
+			return nil, err
+		}
+		certificates = append(certificates, c)
+	}
+
+	return certificates, rows.Err()
+}
+
+func scanCertificate(row *sql.Row) (*domain.Certificate, bool) {
+	var c domain.Certificate
+
+	if err := row.Scan(
+		&c.ID,
+		&c.Code,
+		&c.UserID,
+		&c.CourseID,
+		&c.LearnerName,
+		&c.CourseTitle,
+		&c.InstructorName,
+		&c.IssuedAt,
+	); err != nil {
+		return nil, false
+	}
+
+	return &c, true
+}
@@ -5,6 +5,8 @@ import (
 	"bytecourses/internal/infrastructure/persistence"
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"time"
 )
 
@@ -121,6 +123,134 @@ func (r *CourseRepository) ListAllLive(ctx context.Context) ([]domain.Course, er
 	return courses, rows.Err()
 }
 
+var courseListOrders = map[domain.CourseSort]struct {
+	after   string
+	orderBy string
+}{
+	domain.CourseSortNewest: {
+		after:   "(c.created_at, c.id) < ($5::timestamptz, $4)",
+		orderBy: "c.created_at DESC, c.id DESC",
+	},
+	domain.CourseSortMostEnrolled: {
+		after:   "(ec.enrollment_count, c.id) < ($5::bigint, $4)",
+		orderBy: "ec.enrollment_count DESC, c.id DESC",
+	},
+	domain.CourseSortTitle: {
+		after:   `(lower(c.title) COLLATE "C", c.id) > ($5::text COLLATE "C", $4)`,
+		orderBy: `lower(c.title) COLLATE "C", c.id`,
+	},
+}
+
+func (r *CourseRepository) ListLive(ctx context.Context, filter *domain.CourseFilter) ([]domain.Course, *domain.CourseCursor, error) {
+	by := filter.Sort
+	order, ok := courseListOrders[by]
+	if !ok {
+		by = domain.CourseSortNewest
+		order = courseListOrders[by]
+	}
+
+	var cursorID int64
+	var cursorValue any
+	if filter.After != nil {
+		cursorID = filter.After.ID
+		switch by {
+		case domain.CourseSortMostEnrolled:
+			cursorValue = filter.After.Enrollments
+		case domain.CourseSortTitle:
+			cursorValue = filter.After.Title
+		default:
+			cursorValue = filter.After.CreatedAt
+		}
+	}
+
+	var limit any
+	if filter.Limit > 0 {
+		limit = filter.Limit + 1
+	}
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT c.id, c.title, c.summary, c.target_audience, c.learning_objectives,
+		       c.assumed_prerequisites, c.instructor_id, c.proposal_id, c.status,
+		       c.created_at, c.updated_at, ec.enrollment_count
+		FROM courses c
+		CROSS JOIN LATERAL (
+			SELECT COUNT(*) AS enrollment_count
+			FROM enrollments e
+			WHERE e.course_id = c.id
+		) ec
+		WHERE c.status = 'published'
+		  AND ($1 = 0 OR c.instructor_id = $1)
+		  AND ($2 = 0 OR EXISTS (
+		      SELECT 1 FROM enrollments e
+		      WHERE e.course_id = c.id AND e.user_id = $2
+		  ))
+		  AND (NOT $3 OR %s)
+		ORDER BY %s
+		LIMIT $6
+	`, order.after, order.orderBy),
+		filter.InstructorID,
+		filter.EnrolledUserID,
+		filter.After != nil,
+		cursorID,
+		cursorValue,
+		limit,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	courses := make([]domain.Course, 0)
+	counts := make([]int, 0)
+	for rows.Next() {
+		var c domain.Course
+		var status string
+		var enrollments int
+
+		if err := rows.Scan(
+			&c.ID,
+			&c.Title,
+			&c.Summary,
+			&c.TargetAudience,
+			&c.LearningObjectives,
+			&c.AssumedPrerequisites,
+			&c.InstructorID,
+			&c.ProposalID,
+			&status,
+			&c.CreatedAt,
+			&c.UpdatedAt,
+			&enrollments,
+		); err != nil {
+			return nil, nil, err
+		}
+
+		c.Status = domain.CourseStatus(status)
+		courses = append(courses, c)
+		counts = append(counts, enrollments)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if filter.Limit <= 0 || len(courses) <= filter.Limit {
+		return courses, nil, nil
+	}
+
+	courses = courses[:filter.Limit]
+	last := courses[len(courses)-1]
+	next := &domain.CourseCursor{Sort: by, ID: last.ID}
+	switch by {
+	case domain.CourseSortMostEnrolled:
+		next.Enrollments = counts[len(courses)-1]
+	case domain.CourseSortTitle:
+		next.Title = strings.ToLower(last.Title)
+	default:
+		next.CreatedAt = last.CreatedAt
+	}
+
+	return courses, next, nil
+}
+
 func (r *CourseRepository) Update(ctx context.Context, c *domain.Course) error {
 	c.UpdatedAt = time.Now().UTC()
 
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.CourseMemberRepository = (*CourseMemberRepository)(nil)
+)
+
+type CourseMemberRepository struct {
+	db *sql.DB
+}
+
+func NewCourseMemberRepository(db *DB) *CourseMemberRepository {
+	return &CourseMemberRepository{
+		db: db.DB(),
+	}
+}
+
+func (r *CourseMemberRepository) AddMember(ctx context.Context, m *domain.CourseMember) error {
+	createdAt := time.Now().UTC()
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO course_members (course_id, user_id, role, created_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING created_at
+	`, m.CourseID, m.UserID, m.Role, createdAt).Scan(&m.CreatedAt)
+
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return errors.ErrConflict
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *CourseMemberRepository) GetMember(ctx context.Context, courseID, userID int64) (*domain.CourseMember, bool) {
+	var m domain.CourseMember
+
+	err := r.db.QueryRowContext(ctx, `
+		SELECT course_id, user_id, role, created_at
+		FROM course_members
+		WHERE course_id = $1 AND user_id = $2
+	`, courseID, userID).Scan(
+		&m.CourseID,
+		&m.UserID,
+		&m.Role,
+		&m.CreatedAt,
+	)
+
+	if err != nil {
+		return nil, false
+	}
+
+	return &m, true
+}
+
+func (r *CourseMemberRepository) ListMembers(ctx context.Context, courseID int64) ([]domain.CourseMember, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT course_id, user_id, role, created_at
+		FROM course_members
+		WHERE course_id = $1
+		ORDER BY created_at ASC, user_id ASC
+	`, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCourseMembers(rows)
+}
+
+func (r *CourseMemberRepository) ListMembershipsByUser(ctx context.Context, userID int64) ([]domain.CourseMember, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT course_id, user_id, role, created_at
+		FROM course_members
+		WHERE user_id = $1
+		ORDER BY created_at DESC, course_id DESC
+	`, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCourseMembers(rows)
+}
+
+func (r *CourseMemberRepository) UpdateMemberRole(ctx context.Context, courseID, userID int64, role domain.CourseRole) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE course_members
+		SET role = $3
+		WHERE course_id = $1 AND user_id = $2
+	`, courseID, userID, role)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *CourseMemberRepository) RemoveMember(ctx context.Context, courseID, userID int64) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM course_members
+		WHERE course_id = $1 AND user_id = $2
+	`, courseID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func scanCourseMembers(rows *sql.Rows) ([]domain.CourseMember, error) {
+	members := make([]domain.CourseMember, 0)
+	for rows.Next() {
+		var m domain.CourseMember
+		if err := rows.Scan(
+			&m.CourseID,
+			&m.UserID,
+			&m.Role,
+			&m.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+
+	return members, rows.Err()
+}
@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var _ persistence.EmailChangeRepository = (*EmailChangeRepository)(nil)
+
+type EmailChangeRepository struct {
+	db *sql.DB
+}
+
+func NewEmailChangeRepository(db *DB) *EmailChangeRepository {
+	return &EmailChangeRepository{db: db.DB()}
+}
+
+func (r *EmailChangeRepository) CreateEmailChangeToken(ctx context.Context, userID int64, newEmail string, tokenHash []byte, expiresAt time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM email_change_tokens
+		WHERE user_id = $1
+	`, userID); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO email_change_tokens (user_id, new_email, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4)
+	`, userID, newEmail, tokenHash, expiresAt); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *EmailChangeRepository) ConsumeEmailChangeToken(ctx context.Context, tokenHash []byte, now time.Time) (int64, string, bool) {
+	var userID int64
+	var newEmail string
+
+	err := r.db.QueryRowContext(ctx, `
+		DELETE FROM email_change_tokens
+		WHERE token_hash = $1
+		  AND expires_at > $2
+		RETURNING user_id, new_email
+	`, tokenHash, now).Scan(&userID, &newEmail)
+
+	if err != nil {
+		return 0, "", false
+	}
+
+	return userID, newEmail, true
+}
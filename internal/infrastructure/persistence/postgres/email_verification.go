@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var _ persistence.EmailVerificationRepository = (*EmailVerificationRepository)(nil)
+
+type EmailVerificationRepository struct {
+	db *sql.DB
+}
+
+func NewEmailVerificationRepository(db *DB) *EmailVerificationRepository {
+	return &EmailVerificationRepository{db: db.DB()}
+}
+
+func (r *EmailVerificationRepository) CreateVerificationToken(ctx context.Context, userID int64, tokenHash []byte, expiresAt time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO email_verification_tokens (user_id, token_hash, expires_at)
+		VALUES ($1, $2, $3)
+	`, userID, tokenHash, expiresAt)
+	return err
+}
+
+func (r *EmailVerificationRepository) ConsumeVerificationToken(ctx context.Context, tokenHash []byte, now time.Time) (int64, bool) {
+	var userID int64
+
+	err := r.db.QueryRowContext(ctx, `
+		DELETE FROM email_verification_tokens
+		WHERE token_hash = $1
+		  AND expires_at > $2
+		RETURNING user_id
+	`, tokenHash, now).Scan(&userID)
+
+	if err != nil {
+		return 0, false
+	}
+
+	return userID, true
+}
@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var _ persistence.LoginThrottleRepository = (*LoginThrottleRepository)(nil)
+
+type LoginThrottleRepository struct {
+	db *sql.DB
+}
+
+func NewLoginThrottleRepository(db *DB) *LoginThrottleRepository {
+	return &LoginThrottleRepository{db: db.DB()}
+}
+
+func scanLoginThrottle(row rowScanner) (*domain.LoginThrottle, error) {
+	var t domain.LoginThrottle
+	if err := row.Scan(
+		&t.Key,
+		&t.Failures,
+		&t.LastFailureAt,
+		&t.LockedUntil,
+	); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *LoginThrottleRepository) GetThrottle(ctx context.Context, key string) (*domain.LoginThrottle, bool) {
+	t, err := scanLoginThrottle(r.db.QueryRowContext(ctx, `
+		SELECT key, failures, last_failure_at, locked_until
+		FROM login_throttles
+		WHERE key = $1
+	`, key))
+	if err != nil {
+		return nil, false
+	}
+
+	return t, true
+}
+
+func (r *LoginThrottleRepository) RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (*domain.LoginThrottle, error) {
+	return scanLoginThrottle(r.db.QueryRowContext(ctx, `
+		INSERT INTO login_throttles (key, failures, last_failure_at)
+		VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE
+		SET failures = CASE
+		        WHEN login_throttles.last_failure_at >= $3 THEN login_throttles.failures + 1
+		        ELSE 1
+		    END,
+		    locked_until = CASE
+		        WHEN login_throttles.last_failure_at >= $3 THEN login_throttles.locked_until
+		        ELSE NULL
+		    END,
+		    last_failure_at = EXCLUDED.last_failure_at
+		RETURNING key, failures, last_failure_at, locked_until
+	`, key, now.UTC(), now.Add(-window).UTC()))
+}
+
+func (r *LoginThrottleRepository) LockThrottle(ctx context.Context, key string, until time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE login_throttles
+		SET locked_until = $2
+		WHERE key = $1
+	`, key, until.UTC())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *LoginThrottleRepository) ResetThrottle(ctx context.Context, key string) error {
+	_, err := r.db.ExecContext(ctx, `
+		DELETE FROM login_throttles
+		WHERE key = $1
+	`, key)
+	return err
+}
@@ -21,12 +21,17 @@ func NewModuleRepository(db *DB) *ModuleRepository {
 func (r *ModuleRepository) Create(ctx context.Context, m *domain.Module) error {
 	now := time.Now().UTC()
 
+	if m.UnlockRule == "" {
+		m.UnlockRule = domain.ModuleUnlockImmediately
+	}
+
 	if err := r.db.QueryRowContext(ctx, `
 		INSERT INTO modules (
 			course_id, title, description, order_index, status,
+			unlock_rule, unlock_days, unlock_at,
 			created_at, updated_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 		RETURNING id
 	`,
 		m.CourseID,
@@ -34,6 +39,9 @@ func (r *ModuleRepository) Create(ctx context.Context, m *domain.Module) error {
 		m.Description,
 		m.Order,
 		string(m.Status),
+		string(m.UnlockRule),
+		m.UnlockDays,
+		m.UnlockAt,
 		now,
 		now,
 	).Scan(&m.ID); err != nil {
@@ -47,10 +55,11 @@ func (r *ModuleRepository) Create(ctx context.Context, m *domain.Module) error {
 
 func (r *ModuleRepository) GetByID(ctx context.Context, id int64) (*domain.Module, bool) {
 	var m domain.Module
-	var status string
+	var status, unlockRule string
 
 	if err := r.db.QueryRowContext(ctx, `
 		SELECT id, course_id, title, description, order_index, status,
+		       unlock_rule, unlock_days, unlock_at,
 		       created_at, updated_at
 		FROM modules
 		WHERE id = $1
@@ -61,6 +70,9 @@ func (r *ModuleRepository) GetByID(ctx context.Context, id int64) (*domain.Modul
 		&m.Description,
 		&m.Order,
 		&status,
+		&unlockRule,
+		&m.UnlockDays,
+		&m.UnlockAt,
 		&m.CreatedAt,
 		&m.UpdatedAt,
 	); err != nil {
@@ -68,6 +80,7 @@ func (r *ModuleRepository) GetByID(ctx context.Context, id int64) (*domain.Modul
 	}
 
 	m.Status = domain.ModuleStatus(status)
+	m.UnlockRule = domain.ModuleUnlockRule(unlockRule)
 	return &m, true
 }
 
@@ -80,7 +93,10 @@ func (r *ModuleRepository) Update(ctx context.Context, m *domain.Module) error {
 		    description = $3,
 		    order_index = $4,
 		    status = $5,
-		    updated_at = $6
+		    unlock_rule = $6,
+		    unlock_days = $7,
+		    unlock_at = $8,
+		    updated_at = $9
 		WHERE id = $1
 	`,
 		m.ID,
@@ -88,6 +104,9 @@ func (r *ModuleRepository) Update(ctx context.Context, m *domain.Module) error {
 		m.Description,
 		m.Order,
 		string(m.Status),
+		string(m.UnlockRule),
+		m.UnlockDays,
+		m.UnlockAt,
 		m.UpdatedAt,
 	)
 	return err
@@ -96,6 +115,7 @@ func (r *ModuleRepository) Update(ctx context.Context, m *domain.Module) error {
 func (r *ModuleRepository) ListByCourseID(ctx context.Context, courseID int64) ([]domain.Module, error) {
 	rows, err := r.db.QueryContext(ctx, `
 		SELECT id, course_id, title, description, order_index, status,
+		       unlock_rule, unlock_days, unlock_at,
 		       created_at, updated_at
 		FROM modules
 		WHERE course_id = $1
@@ -119,7 +139,7 @@ func scanModules(rows *sql.Rows) ([]domain.Module, error) {
 
 	for rows.Next() {
 		var m domain.Module
-		var status string
+		var status, unlockRule string
 
 		if err := rows.Scan(
 			&m.ID,
@@ -128,6 +148,9 @@ func scanModules(rows *sql.Rows) ([]domain.Module, error) {
 			&m.Description,
 			&m.Order,
 			&status,
+			&unlockRule,
+			&m.UnlockDays,
+			&m.UnlockAt,
 			&m.CreatedAt,
 			&m.UpdatedAt,
 		); err != nil {
@@ -135,6 +158,7 @@ func scanModules(rows *sql.Rows) ([]domain.Module, error) {
 		}
 
 		m.Status = domain.ModuleStatus(status)
+		m.UnlockRule = domain.ModuleUnlockRule(unlockRule)
 		modules = append(modules, m)
 	}
 
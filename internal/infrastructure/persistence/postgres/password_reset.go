@@ -22,6 +22,24 @@ func (r *PasswordResetRepository) CreateResetToken(ctx context.Context, userID i
 	return err
 }
 
+func (r *PasswordResetRepository) GetResetToken(ctx context.Context, tokenHash []byte, now time.Time) (int64, bool) {
+	var userID int64
+
+	err := r.db.QueryRowContext(ctx, `
+		SELECT user_id
+		FROM password_reset_tokens
+		WHERE token_hash = $1
+		  AND expires_at > $2
+		  AND used_at IS NULL
+	`, tokenHash, now).Scan(&userID)
+
+	if err != nil {
+		return 0, false
+	}
+
+	return userID, true
+}
+
 func (r *PasswordResetRepository) ConsumeResetToken(ctx context.Context, tokenHash []byte, now time.Time) (int64, bool) {
 	var userID int64
 
@@ -5,7 +5,9 @@ import (
 	"os"
 	"sync"
 	"testing"
+	"time"
 
+	"bytecourses/internal/infrastructure/auth"
 	"bytecourses/internal/infrastructure/persistence"
 	"bytecourses/internal/infrastructure/persistence/test"
 )
@@ -33,6 +35,13 @@ func TestProposalRepository(t *testing.T) {
 	})
 }
 
+func TestProposalQueue(t *testing.T) {
+	test.TestProposalQueue(t, func(t *testing.T) (persistence.ProposalRepository, persistence.UserRepository) {
+		db := getOrOpenTestDB(t)
+		return NewProposalRepository(db), NewUserRepository(db)
+	})
+}
+
 func TestCourseRepository(t *testing.T) {
 	test.TestCourseRepository(t, func(t *testing.T) persistence.CourseRepository {
 		db := getOrOpenTestDB(t)
@@ -46,6 +55,16 @@ func TestCourseRepository(t *testing.T) {
 	})
 }
 
+func TestCourseListing(t *testing.T) {
+	test.TestCourseListing(t, func(t *testing.T) (persistence.CourseRepository, persistence.EnrollmentRepository) {
+		db := getOrOpenTestDB(t)
+		return NewCourseRepository(db), NewEnrollmentRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
 func TestPasswordResetRepository(t *testing.T) {
 	test.TestPasswordResetRepository(t, func(t *testing.T) persistence.PasswordResetRepository {
 		db := getOrOpenTestDB(t)
@@ -56,6 +75,70 @@ func TestPasswordResetRepository(t *testing.T) {
 	})
 }
 
+func TestEmailVerificationRepository(t *testing.T) {
+	test.TestEmailVerificationRepository(t, func(t *testing.T) persistence.EmailVerificationRepository {
+		db := getOrOpenTestDB(t)
+		return NewEmailVerificationRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestEmailChangeRepository(t *testing.T) {
+	test.TestEmailChangeRepository(t, func(t *testing.T) persistence.EmailChangeRepository {
+		db := getOrOpenTestDB(t)
+		return NewEmailChangeRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestRecoveryCodeRepository(t *testing.T) {
+	test.TestRecoveryCodeRepository(t, func(t *testing.T) persistence.RecoveryCodeRepository {
+		db := getOrOpenTestDB(t)
+		return NewRecoveryCodeRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestRolePolicyRepository(t *testing.T) {
+	test.TestRolePolicyRepository(t, func(t *testing.T) persistence.RolePolicyRepository {
+		db := getOrOpenTestDB(t)
+		return NewRolePolicyRepository(db)
+	})
+}
+
+func TestAPITokenRepository(t *testing.T) {
+	test.TestAPITokenRepository(t, func(t *testing.T) persistence.APITokenRepository {
+		db := getOrOpenTestDB(t)
+		return NewAPITokenRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestUserIdentityRepository(t *testing.T) {
+	test.TestUserIdentityRepository(t, func(t *testing.T) persistence.UserIdentityRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserIdentityRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestLoginThrottleRepository(t *testing.T) {
+	test.TestLoginThrottleRepository(t, func(t *testing.T) persistence.LoginThrottleRepository {
+		db := getOrOpenTestDB(t)
+		return NewLoginThrottleRepository(db)
+	})
+}
+
 func TestModuleRepository(t *testing.T) {
 	test.TestModuleRepository(t, func(t *testing.T) persistence.ModuleRepository {
 		db := getOrOpenTestDB(t)
@@ -85,6 +168,165 @@ func TestReadingRepository(t *testing.T) {
 	})
 }
 
+func TestQuizRepository(t *testing.T) {
+	test.TestQuizRepository(t, func(t *testing.T) persistence.QuizRepository {
+		db := getOrOpenTestDB(t)
+		return NewQuizRepository(db)
+	}, func(t *testing.T) persistence.ModuleRepository {
+		db := getOrOpenTestDB(t)
+		return NewModuleRepository(db)
+	}, func(t *testing.T) persistence.CourseRepository {
+		db := getOrOpenTestDB(t)
+		return NewCourseRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestQuizAttemptRepository(t *testing.T) {
+	test.TestQuizAttemptRepository(t, func(t *testing.T) persistence.QuizAttemptRepository {
+		db := getOrOpenTestDB(t)
+		return NewQuizAttemptRepository(db)
+	}, func(t *testing.T) persistence.QuizRepository {
+		db := getOrOpenTestDB(t)
+		return NewQuizRepository(db)
+	}, func(t *testing.T) persistence.ModuleRepository {
+		db := getOrOpenTestDB(t)
+		return NewModuleRepository(db)
+	}, func(t *testing.T) persistence.CourseRepository {
+		db := getOrOpenTestDB(t)
+		return NewCourseRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestAssignmentRepository(t *testing.T) {
+	test.TestAssignmentRepository(t, func(t *testing.T) persistence.AssignmentRepository {
+		db := getOrOpenTestDB(t)
+		return NewAssignmentRepository(db)
+	}, func(t *testing.T) persistence.ModuleRepository {
+		db := getOrOpenTestDB(t)
+		return NewModuleRepository(db)
+	}, func(t *testing.T) persistence.CourseRepository {
+		db := getOrOpenTestDB(t)
+		return NewCourseRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestSubmissionRepository(t *testing.T) {
+	test.TestSubmissionRepository(t, func(t *testing.T) persistence.SubmissionRepository {
+		db := getOrOpenTestDB(t)
+		return NewSubmissionRepository(db)
+	}, func(t *testing.T) persistence.AssignmentRepository {
+		db := getOrOpenTestDB(t)
+		return NewAssignmentRepository(db)
+	}, func(t *testing.T) persistence.ModuleRepository {
+		db := getOrOpenTestDB(t)
+		return NewModuleRepository(db)
+	}, func(t *testing.T) persistence.CourseRepository {
+		db := getOrOpenTestDB(t)
+		return NewCourseRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestProgressRepository(t *testing.T) {
+	test.TestProgressRepository(t, func(t *testing.T) persistence.ProgressRepository {
+		db := getOrOpenTestDB(t)
+		return NewProgressRepository(db)
+	}, func(t *testing.T) persistence.ReadingRepository {
+		db := getOrOpenTestDB(t)
+		return NewReadingRepository(db)
+	}, func(t *testing.T) persistence.ModuleRepository {
+		db := getOrOpenTestDB(t)
+		return NewModuleRepository(db)
+	}, func(t *testing.T) persistence.CourseRepository {
+		db := getOrOpenTestDB(t)
+		return NewCourseRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestCertificateRepository(t *testing.T) {
+	test.TestCertificateRepository(t, func(t *testing.T) persistence.CertificateRepository {
+		db := getOrOpenTestDB(t)
+		return NewCertificateRepository(db)
+	}, func(t *testing.T) persistence.CourseRepository {
+		db := getOrOpenTestDB(t)
+		return NewCourseRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestCourseMemberRepository(t *testing.T) {
+	test.TestCourseMemberRepository(t, func(t *testing.T) persistence.CourseMemberRepository {
+		db := getOrOpenTestDB(t)
+		return NewCourseMemberRepository(db)
+	}, func(t *testing.T) persistence.CourseRepository {
+		db := getOrOpenTestDB(t)
+		return NewCourseRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestProposalAssignmentRepository(t *testing.T) {
+	test.TestProposalAssignmentRepository(t, func(t *testing.T) persistence.ProposalAssignmentRepository {
+		db := getOrOpenTestDB(t)
+		return NewProposalAssignmentRepository(db)
+	}, func(t *testing.T) persistence.ProposalRepository {
+		db := getOrOpenTestDB(t)
+		return NewProposalRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestSearchRepository(t *testing.T) {
+	test.TestSearchRepository(t, func(t *testing.T, courses persistence.CourseRepository, modules persistence.ModuleRepository, readings persistence.ReadingRepository) persistence.SearchRepository {
+		db := getOrOpenTestDB(t)
+		return NewSearchRepository(db)
+	}, func(t *testing.T) persistence.ReadingRepository {
+		db := getOrOpenTestDB(t)
+		return NewReadingRepository(db)
+	}, func(t *testing.T) persistence.ModuleRepository {
+		db := getOrOpenTestDB(t)
+		return NewModuleRepository(db)
+	}, func(t *testing.T) persistence.CourseRepository {
+		db := getOrOpenTestDB(t)
+		return NewCourseRepository(db)
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
+func TestSessionStore(t *testing.T) {
+	test.TestSessionStore(t, func(t *testing.T, ttl time.Duration) auth.SessionStore {
+		db := getOrOpenTestDB(t)
+		store := NewSessionStore(db, ttl)
+		t.Cleanup(store.Close)
+		return store
+	}, func(t *testing.T) persistence.UserRepository {
+		db := getOrOpenTestDB(t)
+		return NewUserRepository(db)
+	})
+}
+
 func getOrOpenTestDB(t *testing.T) *DB {
 	t.Helper()
 
@@ -146,11 +388,31 @@ func resetTestDB(t *testing.T, db *DB) {
 	t.Helper()
 
 	_, err := db.db.ExecContext(context.Background(), `
+		TRUNCATE TABLE certificates RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE content_completions RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE submissions RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE assignments RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE quiz_attempts RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE quizzes RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE reading_revisions RESTART IDENTITY CASCADE;
 		TRUNCATE TABLE readings RESTART IDENTITY CASCADE;
 		TRUNCATE TABLE content RESTART IDENTITY CASCADE;
 		TRUNCATE TABLE modules RESTART IDENTITY CASCADE;
 		TRUNCATE TABLE password_reset_tokens RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE email_verification_tokens RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE email_change_tokens RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE recovery_codes RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE role_policies RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE login_throttles RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE api_tokens RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE user_identities RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE sessions RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE enrollments RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE course_members RESTART IDENTITY CASCADE;
 		TRUNCATE TABLE courses RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE proposal_assignments RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE proposal_comments RESTART IDENTITY CASCADE;
+		TRUNCATE TABLE proposal_versions RESTART IDENTITY CASCADE;
 		TRUNCATE TABLE proposals RESTART IDENTITY CASCADE;
 		TRUNCATE TABLE users RESTART IDENTITY CASCADE;
 	`)
@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.ProgressRepository = (*ProgressRepository)(nil)
+)
+
+type ProgressRepository struct {
+	db *sql.DB
+}
+
+func NewProgressRepository(db *DB) *ProgressRepository {
+	return &ProgressRepository{
+		db: db.DB(),
+	}
+}
+
+func (r *ProgressRepository) Create(ctx context.Context, c *domain.ContentCompletion) error {
+	completedAt := time.Now().UTC()
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO content_completions (
+			user_id, course_id, module_id, content_id, content_type, completed_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING completed_at
+	`,
+		c.UserID,
+		c.CourseID,
+		c.ModuleID,
+		c.ContentID,
+		string(c.ContentType),
+		completedAt,
+	).Scan(&c.CompletedAt)
+
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return errors.ErrConflict
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (r *ProgressRepository) Get(ctx context.Context, userID int64, contentType domain.ContentType, contentID int64) (*domain.ContentCompletion, bool) {
+	var c domain.ContentCompletion
+	var ct string
+
+	err := r.db.QueryRowContext(ctx, `
+		SELECT user_id, course_id, module_id, content_id, content_type, completed_at
+		FROM content_completions
+		WHERE user_id = $1 AND content_type = $2 AND content_id = $3
+	`, userID, string(contentType), contentID).Scan(
+		&c.UserID,
+		&c.CourseID,
+		&c.ModuleID,
+		&c.ContentID,
+		&ct,
+		&c.CompletedAt,
+	)
+
+	if err != nil {
+		return nil, false
+	}
+
+	c.ContentType = domain.ContentType(ct)
+	return &c, true
+}
+
+func (r *ProgressRepository) ListByUserAndCourse(ctx context.Context, userID, courseID int64) ([]domain.ContentCompletion, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT user_id, course_id, module_id, content_id, content_type, completed_at
+		FROM content_completions
+		WHERE user_id = $1 AND course_id = $2
+		ORDER BY completed_at ASC
+	`, userID, courseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	completions := make([]domain.ContentCompletion, 0)
+	for rows.Next() {
+		var c domain.ContentCompletion
+		var ct string
+		if err := rows.Scan(
+			&c.UserID,
+			&c.CourseID,
+			&c.ModuleID,
+			&c.ContentID,
+			&ct,
+			&c.CompletedAt,
+		); err != nil {
+			return nil, err
+		}
+		c.ContentType = domain.ContentType(ct)
+		completions = append(completions, c)
+	}
+
+	return completions, rows.Err()
+}
+
+func (r *ProgressRepository) Delete(ctx context.Context, userID int64, contentType domain.ContentType, contentID int64) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM content_completions
+		WHERE user_id = $1 AND content_type = $2 AND content_id = $3
+	`, userID, string(contentType), contentID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
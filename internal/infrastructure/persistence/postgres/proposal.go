@@ -3,10 +3,13 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
 )
 
 var (
@@ -26,13 +29,18 @@ func NewProposalRepository(db *DB) *ProposalRepository {
 func (r *ProposalRepository) Create(ctx context.Context, p *domain.Proposal) error {
 	now := time.Now().UTC()
 
+	outlineModules, err := json.Marshal(outlineModulesOrEmpty(p.OutlineModules))
+	if err != nil {
+		return err
+	}
+
 	if err := r.db.QueryRowContext(ctx, `
 		INSERT INTO proposals (
 			title, summary, qualifications, target_audience,
-			learning_objectives, outline, assumed_prerequisites,
-			author_id, status, created_at, updated_at
+			learning_objectives, outline, outline_modules, assumed_prerequisites,
+			author_id, status, created_at, updated_at, submitted_at
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		RETURNING id
 	`,
 		p.Title,
@@ -41,11 +49,13 @@ func (r *ProposalRepository) Create(ctx context.Context, p *domain.Proposal) err
 		p.TargetAudience,
 		p.LearningObjectives,
 		p.Outline,
+		outlineModules,
 		p.AssumedPrerequisites,
 		p.AuthorID,
 		string(p.Status),
 		now,
 		now,
+		p.SubmittedAt,
 	).Scan(&p.ID); err != nil {
 		return err
 	}
@@ -58,11 +68,13 @@ func (r *ProposalRepository) Create(ctx context.Context, p *domain.Proposal) err
 func (r *ProposalRepository) GetByID(ctx context.Context, id int64) (*domain.Proposal, bool) {
 	var p domain.Proposal
 	var status string
+	var outlineModules []byte
 
 	if err := r.db.QueryRowContext(ctx, `
 		SELECT id, title, summary, qualifications, target_audience,
-		       learning_objectives, outline, assumed_prerequisites,
-		       author_id, reviewer_id, review_notes, status, created_at, updated_at
+		       learning_objectives, outline, outline_modules, assumed_prerequisites,
+		       author_id, reviewer_id, review_notes, status, created_at, updated_at,
+		       submitted_at
 		FROM proposals
 		WHERE id = $1
 	`, id).Scan(
@@ -73,6 +85,7 @@ func (r *ProposalRepository) GetByID(ctx context.Context, id int64) (*domain.Pro
 		&p.TargetAudience,
 		&p.LearningObjectives,
 		&p.Outline,
+		&outlineModules,
 		&p.AssumedPrerequisites,
 		&p.AuthorID,
 		&p.ReviewerID,
@@ -80,9 +93,13 @@ func (r *ProposalRepository) GetByID(ctx context.Context, id int64) (*domain.Pro
 		&status,
 		&p.CreatedAt,
 		&p.UpdatedAt,
+		&p.SubmittedAt,
 	); err != nil {
 		return nil, false
 	}
+	if err := json.Unmarshal(outlineModules, &p.OutlineModules); err != nil {
+		return nil, false
+	}
 
 	p.Status = domain.ProposalStatus(status)
 	return &p, true
@@ -91,8 +108,9 @@ func (r *ProposalRepository) GetByID(ctx context.Context, id int64) (*domain.Pro
 func (r *ProposalRepository) ListByAuthorID(ctx context.Context, authorID int64) ([]domain.Proposal, error) {
 	rows, err := r.db.QueryContext(ctx, `
 		SELECT id, title, summary, qualifications, target_audience,
-		       learning_objectives, outline, assumed_prerequisites,
-		       author_id, reviewer_id, review_notes, status, created_at, updated_at
+		       learning_objectives, outline, outline_modules, assumed_prerequisites,
+		       author_id, reviewer_id, review_notes, status, created_at, updated_at,
+		       submitted_at
 		FROM proposals
 		WHERE author_id = $1
 		ORDER BY updated_at DESC
@@ -108,8 +126,9 @@ func (r *ProposalRepository) ListByAuthorID(ctx context.Context, authorID int64)
 func (r *ProposalRepository) ListAllSubmitted(ctx context.Context) ([]domain.Proposal, error) {
 	rows, err := r.db.QueryContext(ctx, `
 		SELECT id, title, summary, qualifications, target_audience,
-		       learning_objectives, outline, assumed_prerequisites,
-		       author_id, reviewer_id, review_notes, status, created_at, updated_at
+		       learning_objectives, outline, outline_modules, assumed_prerequisites,
+		       author_id, reviewer_id, review_notes, status, created_at, updated_at,
+		       submitted_at
 		FROM proposals
 		WHERE status IN ('submitted', 'approved', 'rejected', 'changes_requested')
 		ORDER BY updated_at DESC
@@ -122,10 +141,138 @@ func (r *ProposalRepository) ListAllSubmitted(ctx context.Context) ([]domain.Pro
 	return scanProposals(rows)
 }
 
+var proposalQueueKeys = map[domain.ProposalSort]string{
+	domain.ProposalSortUpdated:   "p.updated_at",
+	domain.ProposalSortSubmitted: "COALESCE(p.submitted_at, p.created_at)",
+}
+
+func (r *ProposalRepository) ListQueue(ctx context.Context, filter *domain.ProposalFilter) ([]domain.Proposal, *domain.ProposalCursor, error) {
+	by := filter.Sort
+	key, ok := proposalQueueKeys[by]
+	if !ok {
+		by = domain.ProposalSortUpdated
+		key = proposalQueueKeys[by]
+	}
+
+	after := fmt.Sprintf("(%s, p.id) < ($5::timestamptz, $4)", key)
+	orderBy := fmt.Sprintf("%s DESC, p.id DESC", key)
+	if filter.Ascending {
+		after = fmt.Sprintf("(%s, p.id) > ($5::timestamptz, $4)", key)
+		orderBy = fmt.Sprintf("%s, p.id", key)
+	}
+
+	var cursorID int64
+	var cursorAt time.Time
+	if filter.After != nil {
+		cursorID = filter.After.ID
+		cursorAt = filter.After.At
+	}
+
+	var limit any
+	if filter.Limit > 0 {
+		limit = filter.Limit + 1
+	}
+
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT p.id, p.title, p.summary, p.qualifications, p.target_audience,
+		       p.learning_objectives, p.outline, p.outline_modules, p.assumed_prerequisites,
+		       p.author_id, p.reviewer_id, p.review_notes, p.status, p.created_at, p.updated_at,
+		       p.submitted_at
+		FROM proposals p
+		JOIN users u ON u.id = p.author_id
+		WHERE p.status::text = ANY($1::text[])
+		  AND ($2 = '' OR u.email ILIKE $2 OR u.name ILIKE $2)
+		  AND (NOT $3 OR %s)
+		ORDER BY %s
+		LIMIT $6
+	`, after, orderBy),
+		proposalStatusStrings(filter.Statuses),
+		authorPattern(filter.Author),
+		filter.After != nil,
+		cursorID,
+		cursorAt,
+		limit,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	proposals, err := scanProposals(rows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if filter.Limit <= 0 || len(proposals) <= filter.Limit {
+		return proposals, nil, nil
+	}
+
+	proposals = proposals[:filter.Limit]
+	last := proposals[len(proposals)-1]
+	next := &domain.ProposalCursor{Sort: by, Ascending: filter.Ascending, ID: last.ID, At: last.UpdatedAt}
+	if by == domain.ProposalSortSubmitted {
+		next.At = last.QueuedAt()
+	}
+
+	return proposals, next, nil
+}
+
+func (r *ProposalRepository) CountByStatus(ctx context.Context, filter *domain.ProposalFilter) (map[domain.ProposalStatus]int, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT p.status, COUNT(*)
+		FROM proposals p
+		JOIN users u ON u.id = p.author_id
+		WHERE p.status::text = ANY($1::text[])
+		  AND ($2 = '' OR u.email ILIKE $2 OR u.name ILIKE $2)
+		GROUP BY p.status
+	`, proposalStatusStrings(nil), authorPattern(filter.Author))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[domain.ProposalStatus]int)
+	for rows.Next() {
+		var status string
+		var count int
+		if err := rows.Scan(&status, &count); err != nil {
+			return nil, err
+		}
+		counts[domain.ProposalStatus(status)] = count
+	}
+
+	return counts, rows.Err()
+}
+
+// proposalStatusStrings converts statuses for an ANY($n::text[]) match,
+// defaulting to every reviewable status.
+func proposalStatusStrings(statuses []domain.ProposalStatus) []string {
+	if len(statuses) == 0 {
+		statuses = domain.ReviewableProposalStatuses
+	}
+	result := make([]string, len(statuses))
+	for i, s := range statuses {
+		result[i] = string(s)
+	}
+	return result
+}
+
+func authorPattern(author string) string {
+	if author == "" {
+		return ""
+	}
+	return "%" + likeEscaper.Replace(author) + "%"
+}
+
 func (r *ProposalRepository) Update(ctx context.Context, p *domain.Proposal) error {
 	p.UpdatedAt = time.Now().UTC()
 
-	_, err := r.db.ExecContext(ctx, `
+	outlineModules, err := json.Marshal(outlineModulesOrEmpty(p.OutlineModules))
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, `
 		UPDATE proposals
 		SET title = $2,
 		    summary = $3,
@@ -133,11 +280,13 @@ func (r *ProposalRepository) Update(ctx context.Context, p *domain.Proposal) err
 		    target_audience = $5,
 		    learning_objectives = $6,
 		    outline = $7,
-		    assumed_prerequisites = $8,
-		    reviewer_id = $9,
-		    review_notes = $10,
-		    status = $11,
-		    updated_at = $12
+		    outline_modules = $8,
+		    assumed_prerequisites = $9,
+		    reviewer_id = $10,
+		    review_notes = $11,
+		    status = $12,
+		    updated_at = $13,
+		    submitted_at = $14
 		WHERE id = $1
 	`,
 		p.ID,
@@ -147,11 +296,13 @@ func (r *ProposalRepository) Update(ctx context.Context, p *domain.Proposal) err
 		p.TargetAudience,
 		p.LearningObjectives,
 		p.Outline,
+		outlineModules,
 		p.AssumedPrerequisites,
 		p.ReviewerID,
 		p.ReviewNotes,
 		string(p.Status),
 		p.UpdatedAt,
+		p.SubmittedAt,
 	)
 	return err
 }
@@ -167,6 +318,7 @@ func scanProposals(rows *sql.Rows) ([]domain.Proposal, error) {
 	for rows.Next() {
 		var p domain.Proposal
 		var status string
+		var outlineModules []byte
 
 		if err := rows.Scan(
 			&p.ID,
@@ -176,6 +328,7 @@ func scanProposals(rows *sql.Rows) ([]domain.Proposal, error) {
 			&p.TargetAudience,
 			&p.LearningObjectives,
 			&p.Outline,
+			&outlineModules,
 			&p.AssumedPrerequisites,
 			&p.AuthorID,
 			&p.ReviewerID,
@@ -183,9 +336,13 @@ func scanProposals(rows *sql.Rows) ([]domain.Proposal, error) {
 			&status,
 			&p.CreatedAt,
 			&p.UpdatedAt,
+			&p.SubmittedAt,
 		); err != nil {
 			return nil, err
 		}
+		if err := json.Unmarshal(outlineModules, &p.OutlineModules); err != nil {
+			return nil, err
+		}
 
 		p.Status = domain.ProposalStatus(status)
 		proposals = append(proposals, p)
@@ -193,3 +350,305 @@ func scanProposals(rows *sql.Rows) ([]domain.Proposal, error) {
 
 	return proposals, rows.Err()
 }
+
+func (r *ProposalRepository) CreateComment(ctx context.Context, comment *domain.ProposalComment) error {
+	var authorID sql.NullInt64
+	if comment.AuthorID != 0 {
+		authorID.Int64 = comment.AuthorID
+		authorID.Valid = true
+	}
+
+	var vote sql.NullString
+	if comment.Vote != "" {
+		vote.String = string(comment.Vote)
+		vote.Valid = true
+	}
+
+	return r.db.QueryRowContext(ctx, `
+		INSERT INTO proposal_comments (proposal_id, author_id, kind, body, vote, status, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`,
+		comment.ProposalID,
+		authorID,
+		string(comment.Kind),
+		comment.Body,
+		vote,
+		string(comment.Status),
+		time.Now().UTC(),
+	).Scan(&comment.ID, &comment.CreatedAt)
+}
+
+func (r *ProposalRepository) ListComments(ctx context.Context, proposalID int64) ([]domain.ProposalComment, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, proposal_id, author_id, kind, body, vote, status, created_at
+		FROM proposal_comments
+		WHERE proposal_id = $1
+		ORDER BY created_at ASC, id ASC
+	`, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	comments := make([]domain.ProposalComment, 0)
+	for rows.Next() {
+		var c domain.ProposalComment
+		var authorID sql.NullInt64
+		var vote sql.NullString
+		var kind, status string
+
+		if err := rows.Scan(
+			&c.ID,
+			&c.ProposalID,
+			&authorID,
+			&kind,
+			&c.Body,
+			&vote,
+			&status,
+			&c.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		c.AuthorID = authorID.Int64
+		c.Kind = domain.ProposalCommentKind(kind)
+		c.Vote = domain.ProposalVote(vote.String)
+		c.Status = domain.ProposalStatus(status)
+		comments = append(comments, c)
+	}
+
+	return comments, rows.Err()
+}
+
+const proposalVersionColumns = `
+	id, proposal_id, number, title, summary, qualifications, target_audience,
+	learning_objectives, outline, outline_modules, assumed_prerequisites, submitted_at
+`
+
+func (r *ProposalRepository) CreateVersion(ctx context.Context, version *domain.ProposalVersion) error {
+	outlineModules, err := json.Marshal(outlineModulesOrEmpty(version.OutlineModules))
+	if err != nil {
+		return err
+	}
+
+	return r.db.QueryRowContext(ctx, `
+		INSERT INTO proposal_versions (
+			proposal_id, number, title, summary, qualifications, target_audience,
+			learning_objectives, outline, outline_modules, assumed_prerequisites, submitted_at
+		)
+		SELECT $1, COALESCE(MAX(number), 0) + 1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+		FROM proposal_versions
+		WHERE proposal_id = $1
+		RETURNING id, number, submitted_at
+	`,
+		version.ProposalID,
+		version.Title,
+		version.Summary,
+		version.Qualifications,
+		version.TargetAudience,
+		version.LearningObjectives,
+		version.Outline,
+		outlineModules,
+		version.AssumedPrerequisites,
+		time.Now().UTC(),
+	).Scan(&version.ID, &version.Number, &version.SubmittedAt)
+}
+
+func (r *ProposalRepository) GetVersion(ctx context.Context, proposalID int64, number int) (*domain.ProposalVersion, bool) {
+	version, err := scanProposalVersion(r.db.QueryRowContext(ctx, `
+		SELECT `+proposalVersionColumns+`
+		FROM proposal_versions
+		WHERE proposal_id = $1 AND number = $2
+	`, proposalID, number))
+	if err != nil {
+		return nil, false
+	}
+
+	return version, true
+}
+
+func (r *ProposalRepository) ListVersions(ctx context.Context, proposalID int64) ([]domain.ProposalVersion, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+proposalVersionColumns+`
+		FROM proposal_versions
+		WHERE proposal_id = $1
+		ORDER BY number DESC
+	`, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := make([]domain.ProposalVersion, 0)
+	for rows.Next() {
+		version, err := scanProposalVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, *version)
+	}
+
+	return versions, rows.Err()
+}
+
+func scanProposalVersion(row rowScanner) (*domain.ProposalVersion, error) {
+	var v domain.ProposalVersion
+	var outlineModules []byte
+	if err := row.Scan(
+		&v.ID,
+		&v.ProposalID,
+		&v.Number,
+		&v.Title,
+		&v.Summary,
+		&v.Qualifications,
+		&v.TargetAudience,
+		&v.LearningObjectives,
+		&v.Outline,
+		&outlineModules,
+		&v.AssumedPrerequisites,
+		&v.SubmittedAt,
+	); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(outlineModules, &v.OutlineModules); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+const proposalAttachmentColumns = `
+	id, proposal_id, file_name, file_size, mime_type, storage_path, created_at
+`
+
+func (r *ProposalRepository) CreateAttachment(ctx context.Context, attachment *domain.ProposalAttachment, maxCount int, maxBytes int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Locking the proposal serializes uploads to it, so the limits below are
+	// checked against every attachment committed before this one.
+	var proposalID int64
+	if err := tx.QueryRowContext(ctx, `
+		SELECT id FROM proposals WHERE id = $1 FOR UPDATE
+	`, attachment.ProposalID).Scan(&proposalID); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.ErrNotFound
+		}
+		return err
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO proposal_attachments (proposal_id, file_name, file_size, mime_type, storage_path, created_at)
+		SELECT $1, $2, $3::bigint, $4, $5, $6
+		FROM (
+			SELECT COUNT(*) AS count, COALESCE(SUM(file_size), 0) AS total
+			FROM proposal_attachments
+			WHERE proposal_id = $1
+		) existing
+		WHERE existing.count < $7 AND existing.total + $3::bigint <= $8
+		RETURNING id, created_at
+	`,
+		attachment.ProposalID,
+		attachment.FileName,
+		attachment.FileSize,
+		attachment.MimeType,
+		attachment.StoragePath,
+		time.Now().UTC(),
+		maxCount,
+		maxBytes,
+	).Scan(&attachment.ID, &attachment.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.ErrAttachmentLimitReached
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *ProposalRepository) GetAttachment(ctx context.Context, proposalID, attachmentID int64) (*domain.ProposalAttachment, bool) {
+	attachment, err := scanProposalAttachment(r.db.QueryRowContext(ctx, `
+		SELECT `+proposalAttachmentColumns+`
+		FROM proposal_attachments
+		WHERE proposal_id = $1 AND id = $2
+	`, proposalID, attachmentID))
+	if err != nil {
+		return nil, false
+	}
+
+	return attachment, true
+}
+
+func (r *ProposalRepository) ListAttachments(ctx context.Context, proposalID int64) ([]domain.ProposalAttachment, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+proposalAttachmentColumns+`
+		FROM proposal_attachments
+		WHERE proposal_id = $1
+		ORDER BY created_at ASC, id ASC
+	`, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attachments := make([]domain.ProposalAttachment, 0)
+	for rows.Next() {
+		attachment, err := scanProposalAttachment(rows)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, *attachment)
+	}
+
+	return attachments, rows.Err()
+}
+
+func (r *ProposalRepository) DeleteAttachment(ctx context.Context, proposalID, attachmentID int64) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM proposal_attachments
+		WHERE proposal_id = $1 AND id = $2
+	`, proposalID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func scanProposalAttachment(row rowScanner) (*domain.ProposalAttachment, error) {
+	var a domain.ProposalAttachment
+	if err := row.Scan(
+		&a.ID,
+		&a.ProposalID,
+		&a.FileName,
+		&a.FileSize,
+		&a.MimeType,
+		&a.StoragePath,
+		&a.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	return &a, nil
+}
+
+func outlineModulesOrEmpty(modules []domain.OutlineModule) []domain.OutlineModule {
+	if modules == nil {
+		return make([]domain.OutlineModule, 0)
+	}
+	return modules
+}
@@ -0,0 +1,247 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.ProposalAssignmentRepository = (*ProposalAssignmentRepository)(nil)
+)
+
+type ProposalAssignmentRepository struct {
+	db *sql.DB
+}
+
+func NewProposalAssignmentRepository(db *DB) *ProposalAssignmentRepository {
+	return &ProposalAssignmentRepository{
+		db: db.DB(),
+	}
+}
+
+const proposalAssignmentColumns = `
+	proposal_id, reviewer_id, assigned_by, vote, notes,
+	assigned_at, voted_at, pending_since, reminded_at
+`
+
+func (r *ProposalAssignmentRepository) AssignReviewer(ctx context.Context, a *domain.ProposalAssignment) error {
+	now := time.Now().UTC()
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO proposal_assignments (proposal_id, reviewer_id, assigned_by, assigned_at, pending_since)
+		VALUES ($1, $2, $3, $4, $4)
+		RETURNING assigned_at
+	`, a.ProposalID, a.ReviewerID, a.AssignedBy, now).Scan(&a.AssignedAt)
+	if err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return errors.ErrConflict
+		}
+		return err
+	}
+
+	a.Vote = ""
+	a.Notes = ""
+	a.VotedAt = nil
+	a.RemindedAt = nil
+	a.PendingSince = a.AssignedAt
+	return nil
+}
+
+func (r *ProposalAssignmentRepository) GetAssignment(ctx context.Context, proposalID, reviewerID int64) (*domain.ProposalAssignment, bool) {
+	a, err := scanProposalAssignment(r.db.QueryRowContext(ctx, `
+		SELECT `+proposalAssignmentColumns+`
+		FROM proposal_assignments
+		WHERE proposal_id = $1 AND reviewer_id = $2
+	`, proposalID, reviewerID))
+	if err != nil {
+		return nil, false
+	}
+
+	return a, true
+}
+
+func (r *ProposalAssignmentRepository) ListAssignments(ctx context.Context, proposalID int64) ([]domain.ProposalAssignment, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+proposalAssignmentColumns+`
+		FROM proposal_assignments
+		WHERE proposal_id = $1
+		ORDER BY assigned_at ASC, reviewer_id ASC
+	`, proposalID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanProposalAssignments(rows)
+}
+
+func (r *ProposalAssignmentRepository) ListAssignmentsByReviewer(ctx context.Context, reviewerID int64) ([]domain.ProposalAssignment, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+proposalAssignmentColumns+`
+		FROM proposal_assignments
+		WHERE reviewer_id = $1
+		ORDER BY pending_since ASC, proposal_id ASC
+	`, reviewerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanProposalAssignments(rows)
+}
+
+func (r *ProposalAssignmentRepository) RecordVote(ctx context.Context, proposalID, reviewerID int64, vote domain.ProposalVote, notes string, votedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE proposal_assignments
+		SET vote = $3, notes = $4, voted_at = $5
+		WHERE proposal_id = $1 AND reviewer_id = $2
+	`, proposalID, reviewerID, string(vote), notes, votedAt.UTC())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *ProposalAssignmentRepository) StartRound(ctx context.Context, proposalID int64, at time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE proposal_assignments
+		SET vote = NULL, notes = '', voted_at = NULL, reminded_at = NULL, pending_since = $2
+		WHERE proposal_id = $1
+	`, proposalID, at.UTC())
+	return err
+}
+
+func (r *ProposalAssignmentRepository) RemoveAssignment(ctx context.Context, proposalID, reviewerID int64) error {
+	result, err := r.db.ExecContext(ctx, `
+		DELETE FROM proposal_assignments
+		WHERE proposal_id = $1 AND reviewer_id = $2
+	`, proposalID, reviewerID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *ProposalAssignmentRepository) LastAssignedAt(ctx context.Context) (map[int64]time.Time, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT reviewer_id, MAX(assigned_at)
+		FROM proposal_assignments
+		GROUP BY reviewer_id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := make(map[int64]time.Time)
+	for rows.Next() {
+		var reviewerID int64
+		var assignedAt time.Time
+		if err := rows.Scan(&reviewerID, &assignedAt); err != nil {
+			return nil, err
+		}
+		result[reviewerID] = assignedAt
+	}
+
+	return result, rows.Err()
+}
+
+func (r *ProposalAssignmentRepository) ListPendingSince(ctx context.Context, before time.Time) ([]domain.ProposalAssignment, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+proposalAssignmentColumns+`
+		FROM proposal_assignments
+		WHERE vote IS NULL
+		  AND COALESCE(reminded_at, pending_since) < $1
+		ORDER BY pending_since ASC, proposal_id ASC, reviewer_id ASC
+	`, before.UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanProposalAssignments(rows)
+}
+
+func (r *ProposalAssignmentRepository) MarkReminded(ctx context.Context, proposalID, reviewerID int64, at time.Time) error {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE proposal_assignments
+		SET reminded_at = $3
+		WHERE proposal_id = $1 AND reviewer_id = $2
+	`, proposalID, reviewerID, at.UTC())
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func scanProposalAssignments(rows *sql.Rows) ([]domain.ProposalAssignment, error) {
+	assignments := make([]domain.ProposalAssignment, 0)
+	for rows.Next() {
+		a, err := scanProposalAssignment(rows)
+		if err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, *a)
+	}
+
+	return assignments, rows.Err()
+}
+
+func scanProposalAssignment(row rowScanner) (*domain.ProposalAssignment, error) {
+	var a domain.ProposalAssignment
+	var vote sql.NullString
+
+	if err := row.Scan(
+		&a.ProposalID,
+		&a.ReviewerID,
+		&a.AssignedBy,
+		&vote,
+		&a.Notes,
+		&a.AssignedAt,
+		&a.VotedAt,
+		&a.PendingSince,
+		&a.RemindedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	a.Vote = domain.ProposalVote(vote.String)
+	return &a, nil
+}
@@ -0,0 +1,328 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ persistence.QuizRepository        = (*QuizRepository)(nil)
+	_ persistence.QuizAttemptRepository = (*QuizAttemptRepository)(nil)
+)
+
+type QuizRepository struct {
+	db *sql.DB
+}
+
+func NewQuizRepository(db *DB) *QuizRepository {
+	return &QuizRepository{db: db.DB()}
+}
+
+func (r *QuizRepository) Create(ctx context.Context, quiz *domain.Quiz) error {
+	now := time.Now().UTC()
+
+	questions, err := json.Marshal(quizQuestions(quiz))
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var contentItemID int64
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO content (
+			module_id, content_type, title, order_index, status,
+			created_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`,
+		quiz.ModuleID,
+		string(quiz.Type()),
+		quiz.Title,
+		quiz.Order,
+		string(quiz.Status),
+		now,
+		now,
+	).Scan(&contentItemID); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO quizzes (
+			content_item_id, questions, max_attempts, passing_score
+		)
+		VALUES ($1, $2, $3, $4)
+	`,
+		contentItemID,
+		questions,
+		quiz.MaxAttempts,
+		quiz.PassingScore,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+
+	quiz.ID = contentItemID
+	quiz.CreatedAt = now
+	quiz.UpdatedAt = now
+	return nil
+}
+
+func (r *QuizRepository) GetByID(ctx context.Context, id int64) (*domain.Quiz, bool) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT ci.id, ci.module_id, ci.content_type, ci.title, ci.order_index,
+		       ci.status, ci.created_at, ci.updated_at,
+		       q.questions, q.max_attempts, q.passing_score
+		FROM content ci
+		INNER JOIN quizzes q ON ci.id = q.content_item_id
+		WHERE ci.id = $1
+	`, id)
+
+	quiz, err := scanQuiz(row)
+	if err != nil {
+		return nil, false
+	}
+
+	return quiz, true
+}
+
+func (r *QuizRepository) Update(ctx context.Context, quiz *domain.Quiz) error {
+	quiz.UpdatedAt = time.Now().UTC()
+
+	questions, err := json.Marshal(quizQuestions(quiz))
+	if err != nil {
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE content
+		SET title = $2,
+		    order_index = $3,
+		    status = $4,
+		    updated_at = $5
+		WHERE id = $1
+	`,
+		quiz.ID,
+		quiz.Title,
+		quiz.Order,
+		string(quiz.Status),
+		quiz.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE quizzes
+		SET questions = $2,
+		    max_attempts = $3,
+		    passing_score = $4
+		WHERE content_item_id = $1
+	`,
+		quiz.ID,
+		questions,
+		quiz.MaxAttempts,
+		quiz.PassingScore,
+	)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (r *QuizRepository) ListByModuleID(ctx context.Context, moduleID int64) ([]domain.Quiz, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT ci.id, ci.module_id, ci.content_type, ci.title, ci.order_index,
+		       ci.status, ci.created_at, ci.updated_at,
+		       q.questions, q.max_attempts, q.passing_score
+		FROM content ci
+		INNER JOIN quizzes q ON ci.id = q.content_item_id
+		WHERE ci.module_id = $1
+		ORDER BY ci.order_index ASC
+	`, moduleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	quizzes := make([]domain.Quiz, 0)
+	for rows.Next() {
+		quiz, err := scanQuiz(rows)
+		if err != nil {
+			return nil, err
+		}
+		quizzes = append(quizzes, *quiz)
+	}
+
+	return quizzes, rows.Err()
+}
+
+func (r *QuizRepository) DeleteByID(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `DELETE FROM content WHERE id = $1`, id)
+	return err
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanQuiz(row rowScanner) (*domain.Quiz, error) {
+	var quiz domain.Quiz
+	var status string
+	var contentType string
+	var questions []byte
+
+	if err := row.Scan(
+		&quiz.ID,
+		&quiz.ModuleID,
+		&contentType,
+		&quiz.Title,
+		&quiz.Order,
+		&status,
+		&quiz.CreatedAt,
+		&quiz.UpdatedAt,
+		&questions,
+		&quiz.MaxAttempts,
+		&quiz.PassingScore,
+	); err != nil {
+		return nil, err
+	}
+
+	if contentType != string(domain.ContentTypeQuiz) {
+		return nil, sql.ErrNoRows
+	}
+
+	if err := json.Unmarshal(questions, &quiz.Questions); err != nil {
+		return nil, err
+	}
+
+	quiz.Status = domain.ContentStatus(status)
+	return &quiz, nil
+}
+
+func quizQuestions(quiz *domain.Quiz) []domain.QuizQuestion {
+	if quiz.Questions == nil {
+		return make([]domain.QuizQuestion, 0)
+	}
+	return quiz.Questions
+}
+
+type QuizAttemptRepository struct {
+	db *sql.DB
+}
+
+func NewQuizAttemptRepository(db *DB) *QuizAttemptRepository {
+	return &QuizAttemptRepository{db: db.DB()}
+}
+
+func (r *QuizAttemptRepository) Create(ctx context.Context, attempt *domain.QuizAttempt, maxAttempts int) error {
+	submittedAt := time.Now().UTC()
+
+	answers, err := json.Marshal(attempt.Answers)
+	if err != nil {
+		return err
+	}
+
+	// Concurrent attempts compute the same next number, so the unique index
+	// on (quiz_id, user_id, number) lets only one of them past the limit.
+	err = r.db.QueryRowContext(ctx, `
+		INSERT INTO quiz_attempts (
+			quiz_id, user_id, number, answers, score, max_score, passed, submitted_at
+		)
+		SELECT $1, $2, next.number, $3, $4, $5, $6, $7
+		FROM (
+			SELECT COALESCE(MAX(number), 0) + 1 AS number
+			FROM quiz_attempts
+			WHERE quiz_id = $1 AND user_id = $2
+		) next
+		WHERE $8 <= 0 OR next.number <= $8
+		RETURNING id, number
+	`,
+		attempt.QuizID,
+		attempt.UserID,
+		answers,
+		attempt.Score,
+		attempt.MaxScore,
+		attempt.Passed,
+		submittedAt,
+		maxAttempts,
+	).Scan(&attempt.ID, &attempt.Number)
+
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errors.ErrAttemptLimitReached
+		}
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return errors.ErrConflict
+		}
+		return err
+	}
+
+	attempt.SubmittedAt = submittedAt
+	return nil
+}
+
+func (r *QuizAttemptRepository) ListByQuizAndUser(ctx context.Context, quizID, userID int64) ([]domain.QuizAttempt, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, quiz_id, user_id, number, answers, score, max_score, passed, submitted_at
+		FROM quiz_attempts
+		WHERE quiz_id = $1 AND user_id = $2
+		ORDER BY id ASC
+	`, quizID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	attempts := make([]domain.QuizAttempt, 0)
+	for rows.Next() {
+		var attempt domain.QuizAttempt
+		var answers []byte
+
+		if err := rows.Scan(
+			&attempt.ID,
+			&attempt.QuizID,
+			&attempt.UserID,
+			&attempt.Number,
+			&answers,
+			&attempt.Score,
+			&attempt.MaxScore,
+			&attempt.Passed,
+			&attempt.SubmittedAt,
+		); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal(answers, &attempt.Answers); err != nil {
+			return nil, err
+		}
+
+		attempts = append(attempts, attempt)
+	}
+
+	return attempts, rows.Err()
+}
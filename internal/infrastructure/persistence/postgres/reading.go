@@ -232,3 +232,106 @@ func scanReadings(rows *sql.Rows) ([]domain.Reading, error) {
 
 	return readings, rows.Err()
 }
+
+func (r *ReadingRepository) CreateRevision(ctx context.Context, revision *domain.ReadingRevision) error {
+	var authorID sql.NullInt64
+	if revision.AuthorID != 0 {
+		authorID.Int64 = revision.AuthorID
+		authorID.Valid = true
+	}
+
+	var restoredFrom sql.NullInt64
+	if revision.RestoredFrom != nil {
+		restoredFrom.Int64 = int64(*revision.RestoredFrom)
+		restoredFrom.Valid = true
+	}
+
+	return r.db.QueryRowContext(ctx, `
+		INSERT INTO reading_revisions (
+			reading_id, number, author_id, format, content, restored_from, created_at
+		)
+		SELECT $1, COALESCE(MAX(number), 0) + 1, $2, $3, $4, $5, $6
+		FROM reading_revisions
+		WHERE reading_id = $1
+		RETURNING id, number, created_at
+	`,
+		revision.ReadingID,
+		authorID,
+		string(revision.Format),
+		revision.Content,
+		restoredFrom,
+		time.Now().UTC(),
+	).Scan(&revision.ID, &revision.Number, &revision.CreatedAt)
+}
+
+func (r *ReadingRepository) GetRevision(ctx context.Context, readingID int64, number int) (*domain.ReadingRevision, bool) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT id, reading_id, number, author_id, format, content, restored_from, created_at
+		FROM reading_revisions
+		WHERE reading_id = $1 AND number = $2
+	`, readingID, number)
+
+	revision, err := scanReadingRevision(row)
+	if err != nil {
+		return nil, false
+	}
+
+	return revision, true
+}
+
+func (r *ReadingRepository) ListRevisions(ctx context.Context, readingID int64) ([]domain.ReadingRevision, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, reading_id, number, author_id, format, content, restored_from, created_at
+		FROM reading_revisions
+		WHERE reading_id = $1
+		ORDER BY number DESC
+	`, readingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanReadingRevisions(rows)
+}
+
+func scanReadingRevisions(rows *sql.Rows) ([]domain.ReadingRevision, error) {
+	revisions := make([]domain.ReadingRevision, 0)
+	for rows.Next() {
+		revision, err := scanReadingRevision(rows)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, *revision)
+	}
+
+	return revisions, rows.Err()
+}
+
+func scanReadingRevision(row rowScanner) (*domain.ReadingRevision, error) {
+	var revision domain.ReadingRevision
+	var authorID sql.NullInt64
+	var format string
+	var restoredFrom sql.NullInt64
+
+	if err := row.Scan(
+		&revision.ID,
+		&revision.ReadingID,
+		&revision.Number,
+		&authorID,
+		&format,
+		&revision.Content,
+		&restoredFrom,
+		&revision.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	revision.AuthorID = authorID.Int64
+	revision.Format = domain.ReadingFormat(format)
+	if restoredFrom.Valid {
+		number := int(restoredFrom.Int64)
+		revision.RestoredFrom = &number
+	}
+
+	return &revision, nil
+}
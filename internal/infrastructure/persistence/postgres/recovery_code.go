@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var _ persistence.RecoveryCodeRepository = (*RecoveryCodeRepository)(nil)
+
+type RecoveryCodeRepository struct {
+	db *sql.DB
+}
+
+func NewRecoveryCodeRepository(db *DB) *RecoveryCodeRepository {
+	return &RecoveryCodeRepository{db: db.DB()}
+}
+
+func (r *RecoveryCodeRepository) ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes [][]byte) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM recovery_codes
+		WHERE user_id = $1
+	`, userID); err != nil {
+		return err
+	}
+
+	for _, hash := range codeHashes {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO recovery_codes (user_id, code_hash)
+			VALUES ($1, $2)
+		`, userID, hash); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *RecoveryCodeRepository) ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash []byte, now time.Time) bool {
+	result, err := r.db.ExecContext(ctx, `
+		UPDATE recovery_codes
+		SET used_at = $3
+		WHERE user_id = $1
+		  AND code_hash = $2
+		  AND used_at IS NULL
+	`, userID, codeHash, now)
+	if err != nil {
+		return false
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	return err == nil && rowsAffected == 1
+}
+
+func (r *RecoveryCodeRepository) CountRecoveryCodes(ctx context.Context, userID int64) (int, error) {
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM recovery_codes
+		WHERE user_id = $1
+		  AND used_at IS NULL
+	`, userID).Scan(&count)
+	return count, err
+}
+
+func (r *RecoveryCodeRepository) DeleteRecoveryCodes(ctx context.Context, userID int64) error {
+	_, err := r.db.ExecContext(ctx, `
+		DELETE FROM recovery_codes
+		WHERE user_id = $1
+	`, userID)
+	return err
+}
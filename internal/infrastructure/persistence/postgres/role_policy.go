@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var _ persistence.RolePolicyRepository = (*RolePolicyRepository)(nil)
+
+type RolePolicyRepository struct {
+	db *sql.DB
+}
+
+func NewRolePolicyRepository(db *DB) *RolePolicyRepository {
+	return &RolePolicyRepository{db: db.DB()}
+}
+
+func (r *RolePolicyRepository) GetRolePolicy(ctx context.Context, role domain.SystemRole) (*domain.RolePolicy, bool) {
+	var p domain.RolePolicy
+	var roleName string
+
+	if err := r.db.QueryRowContext(ctx, `
+		SELECT role, require_two_factor, updated_at
+		FROM role_policies
+		WHERE role = $1
+	`, string(role)).Scan(
+		&roleName,
+		&p.RequireTwoFactor,
+		&p.UpdatedAt,
+	); err != nil {
+		return nil, false
+	}
+
+	p.Role = domain.SystemRole(roleName)
+	return &p, true
+}
+
+func (r *RolePolicyRepository) SaveRolePolicy(ctx context.Context, p *domain.RolePolicy) error {
+	p.UpdatedAt = time.Now().UTC()
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO role_policies (role, require_two_factor, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (role) DO UPDATE
+		SET require_two_factor = EXCLUDED.require_two_factor,
+		    updated_at = EXCLUDED.updated_at
+	`, string(p.Role), p.RequireTwoFactor, p.UpdatedAt)
+	return err
+}
@@ -0,0 +1,92 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var _ persistence.SearchRepository = (*SearchRepository)(nil)
+
+type SearchRepository struct {
+	db *sql.DB
+}
+
+func NewSearchRepository(db *DB) *SearchRepository {
+	return &SearchRepository{db: db.DB()}
+}
+
+func (r *SearchRepository) Search(ctx context.Context, query string, limit int) ([]domain.SearchResult, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		WITH q AS (
+			SELECT websearch_to_tsquery('english', $1) AS query
+		)
+		SELECT 'course', c.id, c.id, 0, c.title, c.title,
+		       concat_ws(E'\n', c.summary, c.learning_objectives),
+		       ts_rank(c.search_vector, q.query) AS rank
+		FROM courses c
+		CROSS JOIN q
+		WHERE c.status = 'published'
+		  AND c.search_vector @@ q.query
+
+		UNION ALL
+
+		SELECT 'module', m.id, c.id, m.id, m.title, c.title,
+		       m.description,
+		       ts_rank(m.search_vector, q.query) AS rank
+		FROM modules m
+		INNER JOIN courses c ON c.id = m.course_id
+		CROSS JOIN q
+		WHERE c.status = 'published'
+		  AND m.status = 'published'
+		  AND m.search_vector @@ q.query
+
+		UNION ALL
+
+		SELECT 'reading', ci.id, c.id, m.id, ci.title, c.title,
+		       COALESCE(rd.content, ''),
+		       ts_rank(ci.search_vector || rd.search_vector, q.query) AS rank
+		FROM readings rd
+		INNER JOIN content ci ON ci.id = rd.content_item_id
+		INNER JOIN modules m ON m.id = ci.module_id
+		INNER JOIN courses c ON c.id = m.course_id
+		CROSS JOIN q
+		WHERE c.status = 'published'
+		  AND m.status = 'published'
+		  AND ci.status = 'published'
+		  AND (ci.search_vector @@ q.query OR rd.search_vector @@ q.query)
+
+		ORDER BY rank DESC
+		LIMIT $2
+	`, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	results := make([]domain.SearchResult, 0)
+	for rows.Next() {
+		var result domain.SearchResult
+		var kind string
+
+		if err := rows.Scan(
+			&kind,
+			&result.ID,
+			&result.CourseID,
+			&result.ModuleID,
+			&result.Title,
+			&result.CourseTitle,
+			&result.Text,
+			&result.Rank,
+		); err != nil {
+			return nil, err
+		}
+
+		result.Type = domain.SearchResultType(kind)
+		results = append(results, result)
+	}
+
+	return results, rows.Err()
+}
@@ -0,0 +1,284 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"log/slog"
+	"time"
+
+	"bytecourses/internal/infrastructure/auth"
+	"bytecourses/internal/pkg/errors"
+)
+
+var _ auth.SessionStore = (*SessionStore)(nil)
+
+const sessionSweepInterval = 5 * time.Minute
+
+const (
+	sessionKindUser       = "user"
+	sessionKindPending2FA = "pending_2fa"
+)
+
+type SessionStore struct {
+	db   *sql.DB
+	ttl  time.Duration
+	kind string
+	stop chan struct{}
+}
+
+func NewSessionStore(db *DB, ttl time.Duration) *SessionStore {
+	return newSessionStore(db, ttl, sessionKindUser)
+}
+
+// NewPendingSessionStore stores half-authenticated logins that are waiting
+// for a second factor. They share the sessions table but are never accepted
+// as regular sessions.
+func NewPendingSessionStore(db *DB, ttl time.Duration) *SessionStore {
+	return newSessionStore(db, ttl, sessionKindPending2FA)
+}
+
+func newSessionStore(db *DB, ttl time.Duration, kind string) *SessionStore {
+	store := &SessionStore{
+		db:   db.DB(),
+		ttl:  ttl,
+		kind: kind,
+		stop: make(chan struct{}),
+	}
+	go store.sweep()
+	return store
+}
+
+func (s *SessionStore) Create(userID int64) (string, error) {
+	sessionID, err := auth.GenerateToken()
+	if err != nil {
+		return "", err
+	}
+	idHash := auth.HashToken(sessionID)
+	now := time.Now().UTC()
+
+	if _, err := s.db.ExecContext(context.Background(), `
+		INSERT INTO sessions (id_hash, user_id, kind, created_at, last_seen_at, expires_at)
+		VALUES ($1, $2, $3, $4, $4, $5)
+	`, idHash[:], userID, s.kind, now, now.Add(s.ttl)); err != nil {
+		return "", err
+	}
+
+	return sessionID, nil
+}
+
+func (s *SessionStore) Get(sessionID string) (int64, bool) {
+	idHash := auth.HashToken(sessionID)
+
+	var userID int64
+	if err := s.db.QueryRowContext(context.Background(), `
+		SELECT user_id
+		FROM sessions
+		WHERE id_hash = $1
+		  AND kind = $2
+		  AND expires_at > $3
+	`, idHash[:], s.kind, time.Now().UTC()).Scan(&userID); err != nil {
+		return 0, false
+	}
+
+	return userID, true
+}
+
+func (s *SessionStore) Delete(sessionID string) error {
+	idHash := auth.HashToken(sessionID)
+
+	_, err := s.db.ExecContext(context.Background(), `
+		DELETE FROM sessions
+		WHERE id_hash = $1
+		  AND kind = $2
+	`, idHash[:], s.kind)
+	return err
+}
+
+func (s *SessionStore) DeleteByUserID(userID int64) error {
+	_, err := s.db.ExecContext(context.Background(), `
+		DELETE FROM sessions
+		WHERE user_id = $1
+		  AND kind = $2
+	`, userID, s.kind)
+	return err
+}
+
+func (s *SessionStore) SetCSRFToken(sessionID, token string) error {
+	idHash := auth.HashToken(sessionID)
+
+	_, err := s.db.ExecContext(context.Background(), `
+		UPDATE sessions
+		SET csrf_token = $2
+		WHERE id_hash = $1
+		  AND kind = $3
+		  AND expires_at > $4
+	`, idHash[:], token, s.kind, time.Now().UTC())
+	return err
+}
+
+func (s *SessionStore) GetCSRFToken(sessionID string) (string, bool) {
+	idHash := auth.HashToken(sessionID)
+
+	var token string
+	if err := s.db.QueryRowContext(context.Background(), `
+		SELECT csrf_token
+		FROM sessions
+		WHERE id_hash = $1
+		  AND kind = $2
+		  AND expires_at > $3
+	`, idHash[:], s.kind, time.Now().UTC()).Scan(&token); err != nil {
+		return "", false
+	}
+
+	if token == "" {
+		return "", false
+	}
+
+	return token, true
+}
+
+func (s *SessionStore) DeleteCSRFToken(sessionID string) error {
+	idHash := auth.HashToken(sessionID)
+
+	_, err := s.db.ExecContext(context.Background(), `
+		UPDATE sessions
+		SET csrf_token = ''
+		WHERE id_hash = $1
+		  AND kind = $2
+	`, idHash[:], s.kind)
+	return err
+}
+
+func (s *SessionStore) SetClientInfo(sessionID, ip, userAgent string) error {
+	idHash := auth.HashToken(sessionID)
+
+	_, err := s.db.ExecContext(context.Background(), `
+		UPDATE sessions
+		SET ip = $2, user_agent = $3
+		WHERE id_hash = $1
+		  AND kind = $4
+	`, idHash[:], ip, userAgent, s.kind)
+	return err
+}
+
+func (s *SessionStore) Touch(sessionID string, now time.Time) error {
+	idHash := auth.HashToken(sessionID)
+	now = now.UTC()
+
+	_, err := s.db.ExecContext(context.Background(), `
+		UPDATE sessions
+		SET last_seen_at = $2
+		WHERE id_hash = $1
+		  AND kind = $3
+		  AND last_seen_at <= $4
+	`, idHash[:], now, s.kind, now.Add(-auth.SessionTouchInterval))
+	return err
+}
+
+func (s *SessionStore) ListByUserID(userID int64) ([]auth.SessionInfo, error) {
+	rows, err := s.db.QueryContext(context.Background(), `
+		SELECT id_hash, ip, user_agent, created_at, last_seen_at, expires_at
+		FROM sessions
+		WHERE user_id = $1
+		  AND kind = $2
+		  AND expires_at > $3
+		ORDER BY last_seen_at DESC
+	`, userID, s.kind, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := make([]auth.SessionInfo, 0)
+	for rows.Next() {
+		var info auth.SessionInfo
+		var idHash []byte
+		if err := rows.Scan(
+			&idHash,
+			&info.IP,
+			&info.UserAgent,
+			&info.CreatedAt,
+			&info.LastSeenAt,
+			&info.ExpiresAt,
+		); err != nil {
+			return nil, err
+		}
+		info.ID = hex.EncodeToString(idHash)
+		sessions = append(sessions, info)
+	}
+
+	return sessions, rows.Err()
+}
+
+func (s *SessionStore) DeleteByPublicID(userID int64, publicID string) error {
+	idHash, err := hex.DecodeString(publicID)
+	if err != nil {
+		return errors.ErrNotFound
+	}
+
+	result, err := s.db.ExecContext(context.Background(), `
+		DELETE FROM sessions
+		WHERE id_hash = $1
+		  AND user_id = $2
+		  AND kind = $3
+	`, idHash, userID, s.kind)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return errors.ErrNotFound
+	}
+
+	return nil
+}
+
+func (s *SessionStore) DeleteOthersByUserID(userID int64, keepSessionID string) error {
+	keepHash := auth.HashToken(keepSessionID)
+
+	_, err := s.db.ExecContext(context.Background(), `
+		DELETE FROM sessions
+		WHERE user_id = $1
+		  AND kind = $2
+		  AND id_hash <> $3
+	`, userID, s.kind, keepHash[:])
+	return err
+}
+
+func (s *SessionStore) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result, err := s.db.ExecContext(ctx, `
+		DELETE FROM sessions
+		WHERE kind = $1
+		  AND expires_at <= $2
+	`, s.kind, now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (s *SessionStore) Close() {
+	close(s.stop)
+}
+
+func (s *SessionStore) sweep() {
+	ticker := time.NewTicker(sessionSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			if _, err := s.DeleteExpired(context.Background(), time.Now().UTC()); err != nil {
+				slog.Error("failed to delete expired sessions", "error", err)
+			}
+		}
+	}
+}
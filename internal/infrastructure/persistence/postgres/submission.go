@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+var _ persistence.SubmissionRepository = (*SubmissionRepository)(nil)
+
+type SubmissionRepository struct {
+	db *sql.DB
+}
+
+func NewSubmissionRepository(db *DB) *SubmissionRepository {
+	return &SubmissionRepository{db: db.DB()}
+}
+
+func (r *SubmissionRepository) Create(ctx context.Context, submission *domain.Submission) error {
+	now := time.Now().UTC()
+
+	if err := r.db.QueryRowContext(ctx, `
+		INSERT INTO submissions (
+			assignment_id, user_id, text, file_name, file_size, mime_type,
+			storage_path, late, status, grade, feedback, graded_by, graded_at,
+			submitted_at, updated_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
+		RETURNING id
+	`,
+		submission.AssignmentID,
+		submission.UserID,
+		submission.Text,
+		submission.FileName,
+		submission.FileSize,
+		submission.MimeType,
+		submission.StoragePath,
+		submission.Late,
+		string(submission.Status),
+		submission.Grade,
+		submission.Feedback,
+		submission.GradedBy,
+		submission.GradedAt,
+		now,
+		now,
+	).Scan(&submission.ID); err != nil {
+		return err
+	}
+
+	submission.SubmittedAt = now
+	submission.UpdatedAt = now
+	return nil
+}
+
+func (r *SubmissionRepository) GetByID(ctx context.Context, id int64) (*domain.Submission, bool) {
+	row := r.db.QueryRowContext(ctx, `
+		SELECT id, assignment_id, user_id, text, file_name, file_size, mime_type,
+		       storage_path, late, status, grade, feedback, graded_by, graded_at,
+		       submitted_at, updated_at
+		FROM submissions
+		WHERE id = $1
+	`, id)
+
+	submission, err := scanSubmission(row)
+	if err != nil {
+		return nil, false
+	}
+
+	return submission, true
+}
+
+func (r *SubmissionRepository) Update(ctx context.Context, submission *domain.Submission) error {
+	submission.UpdatedAt = time.Now().UTC()
+
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE submissions
+		SET status = $2,
+		    grade = $3,
+		    feedback = $4,
+		    graded_by = $5,
+		    graded_at = $6,
+		    updated_at = $7
+		WHERE id = $1
+	`,
+		submission.ID,
+		string(submission.Status),
+		submission.Grade,
+		submission.Feedback,
+		submission.GradedBy,
+		submission.GradedAt,
+		submission.UpdatedAt,
+	)
+	return err
+}
+
+func (r *SubmissionRepository) ListByAssignmentID(ctx context.Context, assignmentID int64) ([]domain.Submission, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, assignment_id, user_id, text, file_name, file_size, mime_type,
+		       storage_path, late, status, grade, feedback, graded_by, graded_at,
+		       submitted_at, updated_at
+		FROM submissions
+		WHERE assignment_id = $1
+		ORDER BY id ASC
+	`, assignmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSubmissions(rows)
+}
+
+func (r *SubmissionRepository) ListByAssignmentAndUser(ctx context.Context, assignmentID, userID int64) ([]domain.Submission, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, assignment_id, user_id, text, file_name, file_size, mime_type,
+		       storage_path, late, status, grade, feedback, graded_by, graded_at,
+		       submitted_at, updated_at
+		FROM submissions
+		WHERE assignment_id = $1 AND user_id = $2
+		ORDER BY id ASC
+	`, assignmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanSubmissions(rows)
+}
+
+func scanSubmissions(rows *sql.Rows) ([]domain.Submission, error) {
+	submissions := make([]domain.Submission, 0)
+	for rows.Next() {
+		submission, err := scanSubmission(rows)
+		if err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, *submission)
+	}
+
+	return submissions, rows.Err()
+}
+
+func scanSubmission(row rowScanner) (*domain.Submission, error) {
+	var submission domain.Submission
+	var status string
+	var grade sql.NullInt64
+	var gradedBy sql.NullInt64
+	var gradedAt sql.NullTime
+
+	if err := row.Scan(
+		&submission.ID,
+		&submission.AssignmentID,
+		&submission.UserID,
+		&submission.Text,
+		&submission.FileName,
+		&submission.FileSize,
+		&submission.MimeType,
+		&submission.StoragePath,
+		&submission.Late,
+		&status,
+		&grade,
+		&submission.Feedback,
+		&gradedBy,
+		&gradedAt,
+		&submission.SubmittedAt,
+		&submission.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if grade.Valid {
+		g := int(grade.Int64)
+		submission.Grade = &g
+	}
+	if gradedBy.Valid {
+		submission.GradedBy = &gradedBy.Int64
+	}
+	if gradedAt.Valid {
+		submission.GradedAt = &gradedAt.Time
+	}
+
+	submission.Status = domain.SubmissionStatus(status)
+	return &submission, nil
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5/pgconn"
@@ -34,14 +35,16 @@ func (r *UserRepository) Create(ctx context.Context, u *domain.User) error {
 	}
 
 	if err := r.db.QueryRowContext(ctx, `
-		INSERT INTO users (name, email, password_hash, role, created_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO users (name, email, password_hash, role, verified_at, suspended_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING id
 	`,
 		u.Name,
 		u.Email,
 		u.PasswordHash,
 		string(role),
+		u.VerifiedAt,
+		u.SuspendedAt,
 		createdAt,
 	).Scan(&u.ID); err != nil {
 		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
@@ -54,50 +57,60 @@ func (r *UserRepository) Create(ctx context.Context, u *domain.User) error {
 	return nil
 }
 
-func (r *UserRepository) GetByID(ctx context.Context, id int64) (*domain.User, bool) {
+const userColumns = `
+	id, name, email, password_hash, role,
+	totp_secret, totp_enabled, totp_last_step, verified_at, suspended_at,
+	created_at
+`
+
+func scanUser(row rowScanner) (*domain.User, error) {
 	var u domain.User
 	var role string
 
-	if err := r.db.QueryRowContext(ctx, `
-		SELECT id, name, email, password_hash, role, created_at
-		FROM users
-		WHERE id = $1
-	`, id).Scan(
+	if err := row.Scan(
 		&u.ID,
 		&u.Name,
 		&u.Email,
 		&u.PasswordHash,
 		&role,
+		&u.TOTPSecret,
+		&u.TOTPEnabled,
+		&u.TOTPLastStep,
+		&u.VerifiedAt,
+		&u.SuspendedAt,
 		&u.CreatedAt,
 	); err != nil {
-		return nil, false
+		return nil, err
 	}
 
 	u.Role = domain.SystemRole(role)
-	return &u, true
+	return &u, nil
 }
 
-func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, bool) {
-	var u domain.User
-	var role string
+func (r *UserRepository) GetByID(ctx context.Context, id int64) (*domain.User, bool) {
+	u, err := scanUser(r.db.QueryRowContext(ctx, `
+		SELECT `+userColumns+`
+		FROM users
+		WHERE id = $1
+	`, id))
+	if err != nil {
+		return nil, false
+	}
 
-	if err := r.db.QueryRowContext(ctx, `
-		SELECT id, name, email, password_hash, role, created_at
+	return u, true
+}
+
+func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, bool) {
+	u, err := scanUser(r.db.QueryRowContext(ctx, `
+		SELECT `+userColumns+`
 		FROM users
 		WHERE email = $1
-	`, email).Scan(
-		&u.ID,
-		&u.Name,
-		&u.Email,
-		&u.PasswordHash,
-		&role,
-		&u.CreatedAt,
-	); err != nil {
+	`, email))
+	if err != nil {
 		return nil, false
 	}
 
-	u.Role = domain.SystemRole(role)
-	return &u, true
+	return u, true
 }
 
 func (r *UserRepository) Update(ctx context.Context, u *domain.User) error {
@@ -106,7 +119,12 @@ func (r *UserRepository) Update(ctx context.Context, u *domain.User) error {
 		SET name = $2,
 		    email = $3,
 		    password_hash = $4,
-		    role = $5
+		    role = $5,
+		    totp_secret = $6,
+		    totp_enabled = $7,
+		    totp_last_step = $8,
+		    verified_at = $9,
+		    suspended_at = $10
 		WHERE id = $1
 	`,
 		u.ID,
@@ -114,6 +132,11 @@ func (r *UserRepository) Update(ctx context.Context, u *domain.User) error {
 		u.Email,
 		u.PasswordHash,
 		string(u.Role),
+		u.TOTPSecret,
+		u.TOTPEnabled,
+		u.TOTPLastStep,
+		u.VerifiedAt,
+		u.SuspendedAt,
 	)
 	if err != nil {
 		return err
@@ -151,3 +174,54 @@ func (r *UserRepository) DeleteByID(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (r *UserRepository) List(ctx context.Context, filter *domain.UserFilter) ([]domain.User, *domain.UserCursor, error) {
+	var afterID int64
+	if filter.After != nil {
+		afterID = filter.After.ID
+	}
+	var pattern string
+	if filter.Search != "" {
+		pattern = "%" + likeEscaper.Replace(filter.Search) + "%"
+	}
+	var limit sql.NullInt64
+	if filter.Limit > 0 {
+		limit = sql.NullInt64{Int64: int64(filter.Limit) + 1, Valid: true}
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+userColumns+`
+		FROM users
+		WHERE ($1 = '' OR role::text = $1)
+		  AND ($2 = 0 OR id < $2)
+		  AND ($3 = '' OR email ILIKE $3 OR name ILIKE $3)
+		ORDER BY id DESC
+		LIMIT $4
+	`, string(filter.Role), afterID, pattern, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0)
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+		users = append(users, *u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	var next *domain.UserCursor
+	if filter.Limit > 0 && len(users) > filter.Limit {
+		users = users[:filter.Limit]
+		next = &domain.UserCursor{ID: users[len(users)-1].ID}
+	}
+
+	return users, next, nil
+}
@@ -0,0 +1,66 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+var _ persistence.UserIdentityRepository = (*UserIdentityRepository)(nil)
+
+type UserIdentityRepository struct {
+	db *sql.DB
+}
+
+func NewUserIdentityRepository(db *DB) *UserIdentityRepository {
+	return &UserIdentityRepository{db: db.DB()}
+}
+
+func (r *UserIdentityRepository) CreateIdentity(ctx context.Context, identity *domain.UserIdentity) error {
+	createdAt := time.Now().UTC()
+	if err := r.db.QueryRowContext(ctx, `
+		INSERT INTO user_identities (user_id, issuer, subject, email, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
+	`,
+		identity.UserID,
+		identity.Issuer,
+		identity.Subject,
+		identity.Email,
+		createdAt,
+	).Scan(&identity.ID); err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			return errors.ErrConflict
+		}
+		return err
+	}
+
+	identity.CreatedAt = createdAt
+	return nil
+}
+
+func (r *UserIdentityRepository) GetIdentity(ctx context.Context, issuer, subject string) (*domain.UserIdentity, bool) {
+	var identity domain.UserIdentity
+	if err := r.db.QueryRowContext(ctx, `
+		SELECT id, user_id, issuer, subject, email, created_at
+		FROM user_identities
+		WHERE issuer = $1 AND subject = $2
+	`, issuer, subject).Scan(
+		&identity.ID,
+		&identity.UserID,
+		&identity.Issuer,
+		&identity.Subject,
+		&identity.Email,
+		&identity.CreatedAt,
+	); err != nil {
+		return nil, false
+	}
+
+	return &identity, true
+}
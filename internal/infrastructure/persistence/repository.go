@@ -17,26 +17,109 @@ type UserRepository interface {
 	Repository[domain.User]
 	GetByEmail(context.Context, string) (*domain.User, bool)
 	DeleteByID(context.Context, int64) error
+	List(ctx context.Context, filter *domain.UserFilter) ([]domain.User, *domain.UserCursor, error)
 }
 
 type ProposalRepository interface {
 	Repository[domain.Proposal]
 	ListByAuthorID(context.Context, int64) ([]domain.Proposal, error)
 	ListAllSubmitted(context.Context) ([]domain.Proposal, error)
+	ListQueue(ctx context.Context, filter *domain.ProposalFilter) ([]domain.Proposal, *domain.ProposalCursor, error)
+	// CountByStatus counts reviewable proposals by status, applying only the
+	// filter's author search.
+	CountByStatus(ctx context.Context, filter *domain.ProposalFilter) (map[domain.ProposalStatus]int, error)
 	DeleteByID(context.Context, int64) error
+	CreateComment(ctx context.Context, comment *domain.ProposalComment) error
+	ListComments(ctx context.Context, proposalID int64) ([]domain.ProposalComment, error)
+	CreateVersion(ctx context.Context, version *domain.ProposalVersion) error
+	GetVersion(ctx context.Context, proposalID int64, number int) (*domain.ProposalVersion, bool)
+	ListVersions(ctx context.Context, proposalID int64) ([]domain.ProposalVersion, error)
+	// CreateAttachment returns ErrAttachmentLimitReached instead when the
+	// proposal already holds maxCount attachments or the new one would take
+	// their combined size past maxBytes.
+	CreateAttachment(ctx context.Context, attachment *domain.ProposalAttachment, maxCount int, maxBytes int64) error
+	GetAttachment(ctx context.Context, proposalID, attachmentID int64) (*domain.ProposalAttachment, bool)
+	ListAttachments(ctx context.Context, proposalID int64) ([]domain.ProposalAttachment, error)
+	DeleteAttachment(ctx context.Context, proposalID, attachmentID int64) error
+}
+
+type ProposalAssignmentRepository interface {
+	AssignReviewer(ctx context.Context, assignment *domain.ProposalAssignment) error
+	GetAssignment(ctx context.Context, proposalID, reviewerID int64) (*domain.ProposalAssignment, bool)
+	ListAssignments(ctx context.Context, proposalID int64) ([]domain.ProposalAssignment, error)
+	ListAssignmentsByReviewer(ctx context.Context, reviewerID int64) ([]domain.ProposalAssignment, error)
+	RecordVote(ctx context.Context, proposalID, reviewerID int64, vote domain.ProposalVote, notes string, votedAt time.Time) error
+	StartRound(ctx context.Context, proposalID int64, at time.Time) error
+	RemoveAssignment(ctx context.Context, proposalID, reviewerID int64) error
+	LastAssignedAt(ctx context.Context) (map[int64]time.Time, error)
+	ListPendingSince(ctx context.Context, before time.Time) ([]domain.ProposalAssignment, error)
+	MarkReminded(ctx context.Context, proposalID, reviewerID int64, at time.Time) error
 }
 
 type CourseRepository interface {
 	Repository[domain.Course]
 	ListAllLive(ctx context.Context) ([]domain.Course, error)
+	ListLive(ctx context.Context, filter *domain.CourseFilter) ([]domain.Course, *domain.CourseCursor, error)
 	GetByProposalID(ctx context.Context, proposalID int64) (*domain.Course, bool)
 }
 
+type CourseMemberRepository interface {
+	AddMember(ctx context.Context, member *domain.CourseMember) error
+	GetMember(ctx context.Context, courseID, userID int64) (*domain.CourseMember, bool)
+	ListMembers(ctx context.Context, courseID int64) ([]domain.CourseMember, error)
+	ListMembershipsByUser(ctx context.Context, userID int64) ([]domain.CourseMember, error)
+	UpdateMemberRole(ctx context.Context, courseID, userID int64, role domain.CourseRole) error
+	RemoveMember(ctx context.Context, courseID, userID int64) error
+}
+
 type PasswordResetRepository interface {
 	CreateResetToken(ctx context.Context, userID int64, tokenHash []byte, expiresAt time.Time) error
+	GetResetToken(ctx context.Context, tokenHash []byte, now time.Time) (userID int64, ok bool)
 	ConsumeResetToken(ctx context.Context, tokenHash []byte, now time.Time) (userID int64, ok bool)
 }
 
+type EmailVerificationRepository interface {
+	CreateVerificationToken(ctx context.Context, userID int64, tokenHash []byte, expiresAt time.Time) error
+	ConsumeVerificationToken(ctx context.Context, tokenHash []byte, now time.Time) (userID int64, ok bool)
+}
+
+type EmailChangeRepository interface {
+	CreateEmailChangeToken(ctx context.Context, userID int64, newEmail string, tokenHash []byte, expiresAt time.Time) error
+	ConsumeEmailChangeToken(ctx context.Context, tokenHash []byte, now time.Time) (userID int64, newEmail string, ok bool)
+}
+
+type RecoveryCodeRepository interface {
+	ReplaceRecoveryCodes(ctx context.Context, userID int64, codeHashes [][]byte) error
+	ConsumeRecoveryCode(ctx context.Context, userID int64, codeHash []byte, now time.Time) bool
+	CountRecoveryCodes(ctx context.Context, userID int64) (int, error)
+	DeleteRecoveryCodes(ctx context.Context, userID int64) error
+}
+
+type APITokenRepository interface {
+	CreateAPIToken(ctx context.Context, token *domain.APIToken) error
+	GetAPITokenByHash(ctx context.Context, tokenHash []byte) (*domain.APIToken, bool)
+	ListAPITokensByUserID(ctx context.Context, userID int64) ([]domain.APIToken, error)
+	TouchAPIToken(ctx context.Context, id int64, usedAt time.Time) error
+	DeleteAPIToken(ctx context.Context, userID int64, id int64) error
+}
+
+type UserIdentityRepository interface {
+	CreateIdentity(ctx context.Context, identity *domain.UserIdentity) error
+	GetIdentity(ctx context.Context, issuer, subject string) (*domain.UserIdentity, bool)
+}
+
+type LoginThrottleRepository interface {
+	GetThrottle(ctx context.Context, key string) (*domain.LoginThrottle, bool)
+	RecordFailure(ctx context.Context, key string, now time.Time, window time.Duration) (*domain.LoginThrottle, error)
+	LockThrottle(ctx context.Context, key string, until time.Time) error
+	ResetThrottle(ctx context.Context, key string) error
+}
+
+type RolePolicyRepository interface {
+	GetRolePolicy(ctx context.Context, role domain.SystemRole) (*domain.RolePolicy, bool)
+	SaveRolePolicy(ctx context.Context, policy *domain.RolePolicy) error
+}
+
 type ModuleRepository interface {
 	Repository[domain.Module]
 	ListByCourseID(ctx context.Context, courseID int64) ([]domain.Module, error)
@@ -47,6 +130,9 @@ type ReadingRepository interface {
 	Repository[domain.Reading]
 	ListByModuleID(ctx context.Context, moduleID int64) ([]domain.Reading, error)
 	DeleteByID(ctx context.Context, id int64) error
+	CreateRevision(ctx context.Context, revision *domain.ReadingRevision) error
+	GetRevision(ctx context.Context, readingID int64, number int) (*domain.ReadingRevision, bool)
+	ListRevisions(ctx context.Context, readingID int64) ([]domain.ReadingRevision, error)
 }
 
 type FileRepository interface {
@@ -55,6 +141,32 @@ type FileRepository interface {
 	DeleteByID(ctx context.Context, id int64) error
 }
 
+type QuizRepository interface {
+	Repository[domain.Quiz]
+	ListByModuleID(ctx context.Context, moduleID int64) ([]domain.Quiz, error)
+	DeleteByID(ctx context.Context, id int64) error
+}
+
+type QuizAttemptRepository interface {
+	// Create numbers the attempt after the user's previous attempts on the
+	// quiz. It returns ErrAttemptLimitReached instead when maxAttempts is
+	// positive and the user has already used them all.
+	Create(ctx context.Context, attempt *domain.QuizAttempt, maxAttempts int) error
+	ListByQuizAndUser(ctx context.Context, quizID, userID int64) ([]domain.QuizAttempt, error)
+}
+
+type AssignmentRepository interface {
+	Repository[domain.Assignment]
+	ListByModuleID(ctx context.Context, moduleID int64) ([]domain.Assignment, error)
+	DeleteByID(ctx context.Context, id int64) error
+}
+
+type SubmissionRepository interface {
+	Repository[domain.Submission]
+	ListByAssignmentID(ctx context.Context, assignmentID int64) ([]domain.Submission, error)
+	ListByAssignmentAndUser(ctx context.Context, assignmentID, userID int64) ([]domain.Submission, error)
+}
+
 type EnrollmentRepository interface {
 	Create(ctx context.Context, enrollment *domain.Enrollment) error
 	GetByUserAndCourse(ctx context.Context, userID, courseID int64) (*domain.Enrollment, bool)
@@ -63,6 +175,24 @@ type EnrollmentRepository interface {
 	Delete(ctx context.Context, userID, courseID int64) error
 }
 
+type ProgressRepository interface {
+	Create(ctx context.Context, completion *domain.ContentCompletion) error
+	Get(ctx context.Context, userID int64, contentType domain.ContentType, contentID int64) (*domain.ContentCompletion, bool)
+	ListByUserAndCourse(ctx context.Context, userID, courseID int64) ([]domain.ContentCompletion, error)
+	Delete(ctx context.Context, userID int64, contentType domain.ContentType, contentID int64) error
+}
+
+type CertificateRepository interface {
+	Create(ctx context.Context, certificate *domain.Certificate) error
+	GetByCode(ctx context.Context, code string) (*domain.Certificate, bool)
+	GetByUserAndCourse(ctx context.Context, userID, courseID int64) (*domain.Certificate, bool)
+	ListByUser(ctx context.Context, userID int64) ([]domain.Certificate, error)
+}
+
+type SearchRepository interface {
+	Search(ctx context.Context, query string, limit int) ([]domain.SearchResult, error)
+}
+
 type DB interface {
 	Ping(context.Context) error
 	Close() error
@@ -0,0 +1,175 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+type NewAPITokenRepository func(t *testing.T) persistence.APITokenRepository
+
+func TestAPITokenRepository(t *testing.T, newAPITokenRepo NewAPITokenRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.APITokenRepository, *domain.User) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		tokens := newAPITokenRepo(t)
+
+		u := domain.User{
+			Email:        "user@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		return tokens, &u
+	}
+
+	newToken := func(userID int64, name string, hash string) domain.APIToken {
+		return domain.APIToken{
+			UserID:    userID,
+			Name:      name,
+			Prefix:    "bc_abcd",
+			TokenHash: []byte(hash),
+			Scopes:    []domain.TokenScope{domain.TokenScopeContentWrite, domain.TokenScopeProposalsRead},
+			ExpiresAt: time.Now().Add(24 * time.Hour).Truncate(time.Second),
+		}
+	}
+
+	t.Run("CreateAndGetByHash", func(t *testing.T) {
+		ctx := context.Background()
+		tokens, u := setup(t)
+
+		tok := newToken(u.ID, "ci", "token-hash")
+		if err := tokens.CreateAPIToken(ctx, &tok); err != nil {
+			t.Fatalf("tokens.CreateAPIToken failed: %v", err)
+		}
+		if tok.ID == 0 {
+			t.Fatalf("tokens.CreateAPIToken: expected ID to be set")
+		}
+		if tok.CreatedAt.IsZero() {
+			t.Fatalf("tokens.CreateAPIToken: expected CreatedAt to be set")
+		}
+
+		got, ok := tokens.GetAPITokenByHash(ctx, []byte("token-hash"))
+		if !ok {
+			t.Fatalf("tokens.GetAPITokenByHash failed")
+		}
+		if got.ID != tok.ID || got.UserID != u.ID || got.Name != "ci" {
+			t.Fatalf("tokens.GetAPITokenByHash: unexpected token %+v", got)
+		}
+		if len(got.Scopes) != 2 || got.Scopes[0] != domain.TokenScopeContentWrite {
+			t.Fatalf("tokens.GetAPITokenByHash: unexpected scopes %v", got.Scopes)
+		}
+		if !got.ExpiresAt.Equal(tok.ExpiresAt) {
+			t.Fatalf("tokens.GetAPITokenByHash: expected ExpiresAt %v, got %v", tok.ExpiresAt, got.ExpiresAt)
+		}
+		if got.LastUsedAt != nil {
+			t.Fatalf("tokens.GetAPITokenByHash: expected LastUsedAt to be nil")
+		}
+
+		if _, ok := tokens.GetAPITokenByHash(ctx, []byte("other-hash")); ok {
+			t.Fatalf("tokens.GetAPITokenByHash: expected no token for unknown hash")
+		}
+	})
+
+	t.Run("CreateDuplicateHash", func(t *testing.T) {
+		ctx := context.Background()
+		tokens, u := setup(t)
+
+		first := newToken(u.ID, "first", "token-hash")
+		if err := tokens.CreateAPIToken(ctx, &first); err != nil {
+			t.Fatalf("tokens.CreateAPIToken failed: %v", err)
+		}
+
+		second := newToken(u.ID, "second", "token-hash")
+		if err := tokens.CreateAPIToken(ctx, &second); err == nil {
+			t.Fatalf("tokens.CreateAPIToken: expected error for duplicate hash")
+		}
+	})
+
+	t.Run("ListAPITokensByUserID", func(t *testing.T) {
+		ctx := context.Background()
+		tokens, u := setup(t)
+
+		for _, name := range []string{"first", "second"} {
+			tok := newToken(u.ID, name, name+"-hash")
+			if err := tokens.CreateAPIToken(ctx, &tok); err != nil {
+				t.Fatalf("tokens.CreateAPIToken failed: %v", err)
+			}
+		}
+
+		list, err := tokens.ListAPITokensByUserID(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("tokens.ListAPITokensByUserID failed: %v", err)
+		}
+		if len(list) != 2 {
+			t.Fatalf("tokens.ListAPITokensByUserID: expected 2 tokens, got %d", len(list))
+		}
+		if list[0].Name != "first" || list[1].Name != "second" {
+			t.Fatalf("tokens.ListAPITokensByUserID: expected tokens in creation order")
+		}
+
+		list, err = tokens.ListAPITokensByUserID(ctx, u.ID+1)
+		if err != nil {
+			t.Fatalf("tokens.ListAPITokensByUserID failed: %v", err)
+		}
+		if len(list) != 0 {
+			t.Fatalf("tokens.ListAPITokensByUserID: expected no tokens for another user")
+		}
+	})
+
+	t.Run("TouchAPIToken", func(t *testing.T) {
+		ctx := context.Background()
+		tokens, u := setup(t)
+
+		tok := newToken(u.ID, "ci", "token-hash")
+		if err := tokens.CreateAPIToken(ctx, &tok); err != nil {
+			t.Fatalf("tokens.CreateAPIToken failed: %v", err)
+		}
+
+		if err := tokens.TouchAPIToken(ctx, tok.ID, time.Now()); err != nil {
+			t.Fatalf("tokens.TouchAPIToken failed: %v", err)
+		}
+
+		got, ok := tokens.GetAPITokenByHash(ctx, []byte("token-hash"))
+		if !ok {
+			t.Fatalf("tokens.GetAPITokenByHash failed")
+		}
+		if got.LastUsedAt == nil {
+			t.Fatalf("tokens.TouchAPIToken: expected LastUsedAt to be set")
+		}
+
+		if err := tokens.TouchAPIToken(ctx, tok.ID+100, time.Now()); err == nil {
+			t.Fatalf("tokens.TouchAPIToken: expected error for unknown token")
+		}
+	})
+
+	t.Run("DeleteAPIToken", func(t *testing.T) {
+		ctx := context.Background()
+		tokens, u := setup(t)
+
+		tok := newToken(u.ID, "ci", "token-hash")
+		if err := tokens.CreateAPIToken(ctx, &tok); err != nil {
+			t.Fatalf("tokens.CreateAPIToken failed: %v", err)
+		}
+
+		if err := tokens.DeleteAPIToken(ctx, u.ID+1, tok.ID); err == nil {
+			t.Fatalf("tokens.DeleteAPIToken: expected error when deleting another user's token")
+		}
+		if err := tokens.DeleteAPIToken(ctx, u.ID, tok.ID); err != nil {
+			t.Fatalf("tokens.DeleteAPIToken failed: %v", err)
+		}
+		if _, ok := tokens.GetAPITokenByHash(ctx, []byte("token-hash")); ok {
+			t.Fatalf("tokens.DeleteAPIToken: expected token to be removed")
+		}
+		if err := tokens.DeleteAPIToken(ctx, u.ID, tok.ID); err == nil {
+			t.Fatalf("tokens.DeleteAPIToken: expected error for deleted token")
+		}
+	})
+}
@@ -0,0 +1,377 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+type NewAssignmentRepository func(t *testing.T) persistence.AssignmentRepository
+type NewSubmissionRepository func(t *testing.T) persistence.SubmissionRepository
+
+func TestAssignmentRepository(t *testing.T, newAssignmentRepo NewAssignmentRepository, newModuleRepo NewModuleRepository, newCourseRepo NewCourseRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.AssignmentRepository, *domain.Module) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		courses := newCourseRepo(t)
+		modules := newModuleRepo(t)
+		assignments := newAssignmentRepo(t)
+
+		u := domain.User{
+			Email:        "instructor@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		c := domain.Course{
+			Title:        "Test Course",
+			Summary:      "A test course",
+			InstructorID: u.ID,
+			Status:       domain.CourseStatusDraft,
+		}
+		if err := courses.Create(ctx, &c); err != nil {
+			t.Fatalf("courses.Create failed: %v", err)
+		}
+
+		m := domain.Module{
+			CourseID:    c.ID,
+			Title:       "Test Module",
+			Description: "A test module",
+			Order:       1,
+			Status:      domain.ModuleStatusDraft,
+		}
+		if err := modules.Create(ctx, &m); err != nil {
+			t.Fatalf("modules.Create failed: %v", err)
+		}
+
+		return assignments, &m
+	}
+
+	newAssignment := func(moduleID int64, title string, order int) domain.Assignment {
+		dueAt := time.Now().Add(7 * 24 * time.Hour).UTC().Truncate(time.Second)
+		return domain.Assignment{
+			BaseContentItem: domain.BaseContentItem{
+				ModuleID: moduleID,
+				Title:    title,
+				Order:    order,
+				Status:   domain.ContentStatusDraft,
+			},
+			Instructions: "# Build a CLI\n\nWrite a small command-line tool.",
+			DueAt:        &dueAt,
+			MaxPoints:    100,
+		}
+	}
+
+	t.Run("CreateAndGetByID", func(t *testing.T) {
+		ctx := context.Background()
+		assignments, m := setup(t)
+
+		a := newAssignment(m.ID, "Test Assignment", 1)
+		if err := assignments.Create(ctx, &a); err != nil {
+			t.Fatalf("assignments.Create failed: %v", err)
+		}
+		if a.ID == 0 {
+			t.Fatalf("assignments.Create: ID not set")
+		}
+		if a.CreatedAt.IsZero() || a.UpdatedAt.IsZero() {
+			t.Fatalf("assignments.Create: timestamps not set")
+		}
+
+		v, ok := assignments.GetByID(ctx, a.ID)
+		if !ok {
+			t.Fatalf("assignments.GetByID failed")
+		}
+		if v.Title != a.Title || v.ModuleID != a.ModuleID || v.Instructions != a.Instructions {
+			t.Fatalf("assignments.GetByID: assignments differ")
+		}
+		if v.DueAt == nil || !v.DueAt.Equal(*a.DueAt) {
+			t.Fatalf("assignments.GetByID: due date not retrieved correctly")
+		}
+		if v.MaxPoints != 100 {
+			t.Fatalf("assignments.GetByID: max points not retrieved correctly")
+		}
+	})
+
+	t.Run("GetByIDNotFound", func(t *testing.T) {
+		ctx := context.Background()
+		assignments := newAssignmentRepo(t)
+
+		_, ok := assignments.GetByID(ctx, -1)
+		if ok {
+			t.Fatalf("assignments.GetByID: should return false for non-existent assignment")
+		}
+	})
+
+	t.Run("ListByModuleID", func(t *testing.T) {
+		ctx := context.Background()
+		assignments, m := setup(t)
+
+		a1 := newAssignment(m.ID, "Assignment 1", 2)
+		if err := assignments.Create(ctx, &a1); err != nil {
+			t.Fatalf("assignments.Create failed: %v", err)
+		}
+		a2 := newAssignment(m.ID, "Assignment 2", 1)
+		if err := assignments.Create(ctx, &a2); err != nil {
+			t.Fatalf("assignments.Create failed: %v", err)
+		}
+
+		list, err := assignments.ListByModuleID(ctx, m.ID)
+		if err != nil {
+			t.Fatalf("assignments.ListByModuleID failed: %v", err)
+		}
+		if len(list) != 2 {
+			t.Fatalf("assignments.ListByModuleID: expected 2 assignments, got %d", len(list))
+		}
+		if list[0].ID != a2.ID || list[1].ID != a1.ID {
+			t.Fatalf("assignments.ListByModuleID: assignments not in correct order")
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		ctx := context.Background()
+		assignments, m := setup(t)
+
+		a := newAssignment(m.ID, "Original Title", 1)
+		if err := assignments.Create(ctx, &a); err != nil {
+			t.Fatalf("assignments.Create failed: %v", err)
+		}
+
+		v, ok := assignments.GetByID(ctx, a.ID)
+		if !ok {
+			t.Fatalf("assignments.GetByID failed")
+		}
+
+		v.Title = "Updated Title"
+		v.Status = domain.ContentStatusPublished
+		v.DueAt = nil
+		v.MaxPoints = 50
+		if err := assignments.Update(ctx, v); err != nil {
+			t.Fatalf("assignments.Update failed: %v", err)
+		}
+
+		w, ok := assignments.GetByID(ctx, a.ID)
+		if !ok {
+			t.Fatalf("assignments.GetByID failed")
+		}
+		if w.Title != "Updated Title" || w.Status != domain.ContentStatusPublished {
+			t.Fatalf("assignments.Update: fields not updated")
+		}
+		if w.DueAt != nil {
+			t.Fatalf("assignments.Update: due date not cleared")
+		}
+		if w.MaxPoints != 50 {
+			t.Fatalf("assignments.Update: max points not updated")
+		}
+	})
+
+	t.Run("DeleteByID", func(t *testing.T) {
+		ctx := context.Background()
+		assignments, m := setup(t)
+
+		a := newAssignment(m.ID, "Test Assignment", 1)
+		if err := assignments.Create(ctx, &a); err != nil {
+			t.Fatalf("assignments.Create failed: %v", err)
+		}
+
+		if err := assignments.DeleteByID(ctx, a.ID); err != nil {
+			t.Fatalf("assignments.DeleteByID failed: %v", err)
+		}
+
+		_, ok := assignments.GetByID(ctx, a.ID)
+		if ok {
+			t.Fatalf("assignments.DeleteByID: assignment still exists after deletion")
+		}
+	})
+}
+
+func TestSubmissionRepository(t *testing.T, newSubmissionRepo NewSubmissionRepository, newAssignmentRepo NewAssignmentRepository, newModuleRepo NewModuleRepository, newCourseRepo NewCourseRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.SubmissionRepository, *domain.Assignment, *domain.User, *domain.User) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		courses := newCourseRepo(t)
+		modules := newModuleRepo(t)
+		assignments := newAssignmentRepo(t)
+		submissions := newSubmissionRepo(t)
+
+		instructor := domain.User{
+			Email:        "instructor@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &instructor); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		learner := domain.User{
+			Email:        "learner@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &learner); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		c := domain.Course{
+			Title:        "Test Course",
+			Summary:      "A test course",
+			InstructorID: instructor.ID,
+			Status:       domain.CourseStatusDraft,
+		}
+		if err := courses.Create(ctx, &c); err != nil {
+			t.Fatalf("courses.Create failed: %v", err)
+		}
+
+		m := domain.Module{
+			CourseID: c.ID,
+			Title:    "Test Module",
+			Order:    1,
+			Status:   domain.ModuleStatusDraft,
+		}
+		if err := modules.Create(ctx, &m); err != nil {
+			t.Fatalf("modules.Create failed: %v", err)
+		}
+
+		a := domain.Assignment{
+			BaseContentItem: domain.BaseContentItem{
+				ModuleID: m.ID,
+				Title:    "Test Assignment",
+				Order:    1,
+				Status:   domain.ContentStatusPublished,
+			},
+			Instructions: "Do the thing.",
+			MaxPoints:    10,
+		}
+		if err := assignments.Create(ctx, &a); err != nil {
+			t.Fatalf("assignments.Create failed: %v", err)
+		}
+
+		return submissions, &a, &instructor, &learner
+	}
+
+	t.Run("CreateAndGetByID", func(t *testing.T) {
+		ctx := context.Background()
+		submissions, a, _, learner := setup(t)
+
+		s := domain.Submission{
+			AssignmentID: a.ID,
+			UserID:       learner.ID,
+			Text:         "My answer",
+			FileName:     "answer.pdf",
+			FileSize:     1024,
+			MimeType:     "application/pdf",
+			StoragePath:  "submissions/1/answer.pdf",
+			Late:         true,
+			Status:       domain.SubmissionStatusSubmitted,
+		}
+		if err := submissions.Create(ctx, &s); err != nil {
+			t.Fatalf("submissions.Create failed: %v", err)
+		}
+		if s.ID == 0 {
+			t.Fatalf("submissions.Create: ID not set")
+		}
+		if s.SubmittedAt.IsZero() {
+			t.Fatalf("submissions.Create: SubmittedAt not set")
+		}
+
+		v, ok := submissions.GetByID(ctx, s.ID)
+		if !ok {
+			t.Fatalf("submissions.GetByID failed")
+		}
+		if v.Text != s.Text || v.StoragePath != s.StoragePath || !v.Late {
+			t.Fatalf("submissions.GetByID: submissions differ")
+		}
+		if v.Grade != nil || v.GradedBy != nil || v.GradedAt != nil {
+			t.Fatalf("submissions.GetByID: ungraded submission has grade fields set")
+		}
+	})
+
+	t.Run("GetByIDNotFound", func(t *testing.T) {
+		ctx := context.Background()
+		submissions := newSubmissionRepo(t)
+
+		_, ok := submissions.GetByID(ctx, -1)
+		if ok {
+			t.Fatalf("submissions.GetByID: should return false for non-existent submission")
+		}
+	})
+
+	t.Run("UpdateGrade", func(t *testing.T) {
+		ctx := context.Background()
+		submissions, a, instructor, learner := setup(t)
+
+		s := domain.Submission{
+			AssignmentID: a.ID,
+			UserID:       learner.ID,
+			Text:         "My answer",
+			Status:       domain.SubmissionStatusSubmitted,
+		}
+		if err := submissions.Create(ctx, &s); err != nil {
+			t.Fatalf("submissions.Create failed: %v", err)
+		}
+
+		grade := 8
+		gradedAt := time.Now().UTC().Truncate(time.Second)
+		s.Grade = &grade
+		s.Feedback = "Nice work"
+		s.GradedBy = &instructor.ID
+		s.GradedAt = &gradedAt
+		s.Status = domain.SubmissionStatusGraded
+		if err := submissions.Update(ctx, &s); err != nil {
+			t.Fatalf("submissions.Update failed: %v", err)
+		}
+
+		v, ok := submissions.GetByID(ctx, s.ID)
+		if !ok {
+			t.Fatalf("submissions.GetByID failed")
+		}
+		if !v.IsGraded() || v.Grade == nil || *v.Grade != 8 || v.Feedback != "Nice work" {
+			t.Fatalf("submissions.Update: grade not updated")
+		}
+		if v.GradedBy == nil || *v.GradedBy != instructor.ID || v.GradedAt == nil {
+			t.Fatalf("submissions.Update: grader not recorded")
+		}
+	})
+
+	t.Run("ListByAssignment", func(t *testing.T) {
+		ctx := context.Background()
+		submissions, a, instructor, learner := setup(t)
+
+		for _, userID := range []int64{learner.ID, instructor.ID, learner.ID} {
+			s := domain.Submission{
+				AssignmentID: a.ID,
+				UserID:       userID,
+				Text:         "An answer",
+				Status:       domain.SubmissionStatusSubmitted,
+			}
+			if err := submissions.Create(ctx, &s); err != nil {
+				t.Fatalf("submissions.Create failed: %v", err)
+			}
+		}
+
+		all, err := submissions.ListByAssignmentID(ctx, a.ID)
+		if err != nil {
+			t.Fatalf("submissions.ListByAssignmentID failed: %v", err)
+		}
+		if len(all) != 3 {
+			t.Fatalf("submissions.ListByAssignmentID: expected 3 submissions, got %d", len(all))
+		}
+		if all[0].ID > all[1].ID || all[1].ID > all[2].ID {
+			t.Fatalf("submissions.ListByAssignmentID: submissions not in submission order")
+		}
+
+		mine, err := submissions.ListByAssignmentAndUser(ctx, a.ID, learner.ID)
+		if err != nil {
+			t.Fatalf("submissions.ListByAssignmentAndUser failed: %v", err)
+		}
+		if len(mine) != 2 {
+			t.Fatalf("submissions.ListByAssignmentAndUser: expected 2 submissions, got %d", len(mine))
+		}
+	})
+}
@@ -0,0 +1,158 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+type NewCertificateRepository func(t *testing.T) persistence.CertificateRepository
+
+func TestCertificateRepository(t *testing.T, newCertificateRepo NewCertificateRepository, newCourseRepo NewCourseRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.CertificateRepository, *domain.User, *domain.Course) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		courses := newCourseRepo(t)
+		certificates := newCertificateRepo(t)
+
+		instructor := domain.User{
+			Email:        "instructor@example.com",
+			Name:         "Instructor",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &instructor); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		learner := domain.User{
+			Email:        "learner@example.com",
+			Name:         "Learner",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &learner); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		c := domain.Course{
+			Title:        "Test Course",
+			Summary:      "A test course",
+			InstructorID: instructor.ID,
+			Status:       domain.CourseStatusPublished,
+		}
+		if err := courses.Create(ctx, &c); err != nil {
+			t.Fatalf("courses.Create failed: %v", err)
+		}
+
+		return certificates, &learner, &c
+	}
+
+	newCertificate := func(code string, learner *domain.User, course *domain.Course) domain.Certificate {
+		return domain.Certificate{
+			Code:           code,
+			UserID:         learner.ID,
+			CourseID:       course.ID,
+			LearnerName:    learner.Name,
+			CourseTitle:    course.Title,
+			InstructorName: "Instructor",
+		}
+	}
+
+	t.Run("CreateAndGetByCode", func(t *testing.T) {
+		ctx := context.Background()
+		certificates, learner, course := setup(t)
+
+		c := newCertificate("AAAA-BBBB-CCCC-DDDD", learner, course)
+		if err := certificates.Create(ctx, &c); err != nil {
+			t.Fatalf("certificates.Create failed: %v", err)
+		}
+		if c.ID == 0 {
+			t.Fatalf("certificates.Create: ID not set")
+		}
+		if c.IssuedAt.IsZero() {
+			t.Fatalf("certificates.Create: IssuedAt not set")
+		}
+
+		got, ok := certificates.GetByCode(ctx, c.Code)
+		if !ok {
+			t.Fatalf("certificates.GetByCode: expected certificate")
+		}
+		if got.ID != c.ID {
+			t.Fatalf("certificates.GetByCode: ID mismatch: got %d, want %d", got.ID, c.ID)
+		}
+		if got.LearnerName != "Learner" || got.CourseTitle != "Test Course" || got.InstructorName != "Instructor" {
+			t.Fatalf("certificates.GetByCode: snapshot fields not stored correctly: %+v", got)
+		}
+
+		if _, ok := certificates.GetByCode(ctx, "ZZZZ-ZZZZ-ZZZZ-ZZZZ"); ok {
+			t.Fatalf("certificates.GetByCode: expected no certificate for unknown code")
+		}
+	})
+
+	t.Run("GetByUserAndCourse", func(t *testing.T) {
+		ctx := context.Background()
+		certificates, learner, course := setup(t)
+
+		if _, ok := certificates.GetByUserAndCourse(ctx, learner.ID, course.ID); ok {
+			t.Fatalf("certificates.GetByUserAndCourse: expected no certificate before issue")
+		}
+
+		c := newCertificate("AAAA-BBBB-CCCC-DDDD", learner, course)
+		if err := certificates.Create(ctx, &c); err != nil {
+			t.Fatalf("certificates.Create failed: %v", err)
+		}
+
+		got, ok := certificates.GetByUserAndCourse(ctx, learner.ID, course.ID)
+		if !ok {
+			t.Fatalf("certificates.GetByUserAndCourse: expected certificate")
+		}
+		if got.Code != c.Code {
+			t.Fatalf("certificates.GetByUserAndCourse: code mismatch: got %q, want %q", got.Code, c.Code)
+		}
+	})
+
+	t.Run("CreateDuplicate", func(t *testing.T) {
+		ctx := context.Background()
+		certificates, learner, course := setup(t)
+
+		c := newCertificate("AAAA-BBBB-CCCC-DDDD", learner, course)
+		if err := certificates.Create(ctx, &c); err != nil {
+			t.Fatalf("certificates.Create failed: %v", err)
+		}
+
+		dup := newCertificate("EEEE-FFFF-GGGG-HHHH", learner, course)
+		if err := certificates.Create(ctx, &dup); err != errors.ErrConflict {
+			t.Fatalf("certificates.Create duplicate: expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("ListByUser", func(t *testing.T) {
+		ctx := context.Background()
+		certificates, learner, course := setup(t)
+
+		list, err := certificates.ListByUser(ctx, learner.ID)
+		if err != nil {
+			t.Fatalf("certificates.ListByUser failed: %v", err)
+		}
+		if len(list) != 0 {
+			t.Fatalf("certificates.ListByUser: expected 0 certificates, got %d", len(list))
+		}
+
+		c := newCertificate("AAAA-BBBB-CCCC-DDDD", learner, course)
+		if err := certificates.Create(ctx, &c); err != nil {
+			t.Fatalf("certificates.Create failed: %v", err)
+		}
+
+		list, err = certificates.ListByUser(ctx, learner.ID)
+		if err != nil {
+			t.Fatalf("certificates.ListByUser failed: %v", err)
+		}
+		if len(list) != 1 {
+			t.Fatalf("certificates.ListByUser: expected 1 certificate, got %d", len(list))
+		}
+	})
+}
@@ -465,3 +465,187 @@ func TestCourseRepository(t *testing.T, newCourseRepo NewCourseRepository, newUs
 		}
 	})
 }
+
+type NewCourseListingRepositories func(t *testing.T) (persistence.CourseRepository, persistence.EnrollmentRepository)
+
+func TestCourseListing(t *testing.T, newRepos NewCourseListingRepositories, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.CourseRepository, map[string]int64) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		courses, enrollments := newRepos(t)
+
+		ids := make(map[string]int64)
+		for _, email := range []string{"first@example.com", "second@example.com", "student@example.com"} {
+			u := domain.User{
+				Email:        email,
+				PasswordHash: make([]byte, 20),
+			}
+			if err := users.Create(ctx, &u); err != nil {
+				t.Fatalf("users.Create failed: %v", err)
+			}
+			ids[email] = u.ID
+		}
+
+		for _, c := range []domain.Course{
+			{Title: "Beta", InstructorID: ids["first@example.com"], Status: domain.CourseStatusPublished},
+			{Title: "alpha", InstructorID: ids["first@example.com"], Status: domain.CourseStatusPublished},
+			{Title: "Gamma", InstructorID: ids["second@example.com"], Status: domain.CourseStatusPublished},
+			{Title: "Draft", InstructorID: ids["first@example.com"], Status: domain.CourseStatusDraft},
+		} {
+			if err := courses.Create(ctx, &c); err != nil {
+				t.Fatalf("courses.Create failed: %v", err)
+			}
+			ids[c.Title] = c.ID
+		}
+
+		for _, e := range []domain.Enrollment{
+			{UserID: ids["student@example.com"], CourseID: ids["Gamma"]},
+			{UserID: ids["student@example.com"], CourseID: ids["Beta"]},
+			{UserID: ids["first@example.com"], CourseID: ids["Gamma"]},
+		} {
+			if err := enrollments.Create(ctx, &e); err != nil {
+				t.Fatalf("enrollments.Create failed: %v", err)
+			}
+		}
+
+		return courses, ids
+	}
+
+	titles := func(list []domain.Course) []string {
+		result := make([]string, 0, len(list))
+		for _, c := range list {
+			result = append(result, c.Title)
+		}
+		return result
+	}
+
+	expectTitles := func(t *testing.T, list []domain.Course, want ...string) {
+		t.Helper()
+		got := titles(list)
+		if len(got) != len(want) {
+			t.Fatalf("courses.ListLive: expected %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("courses.ListLive: expected %v, got %v", want, got)
+			}
+		}
+	}
+
+	t.Run("SortNewestPaginated", func(t *testing.T) {
+		ctx := context.Background()
+		courses, _ := setup(t)
+
+		list, next, err := courses.ListLive(ctx, &domain.CourseFilter{
+			Sort:  domain.CourseSortNewest,
+			Limit: 2,
+		})
+		if err != nil {
+			t.Fatalf("courses.ListLive failed: %v", err)
+		}
+		expectTitles(t, list, "Gamma", "alpha")
+		if next == nil {
+			t.Fatalf("courses.ListLive: expected next cursor")
+		}
+
+		list, next, err = courses.ListLive(ctx, &domain.CourseFilter{
+			Sort:  domain.CourseSortNewest,
+			After: next,
+			Limit: 2,
+		})
+		if err != nil {
+			t.Fatalf("courses.ListLive failed: %v", err)
+		}
+		expectTitles(t, list, "Beta")
+		if next != nil {
+			t.Fatalf("courses.ListLive: expected no next cursor on last page")
+		}
+	})
+
+	t.Run("SortTitle", func(t *testing.T) {
+		ctx := context.Background()
+		courses, _ := setup(t)
+
+		list, next, err := courses.ListLive(ctx, &domain.CourseFilter{
+			Sort:  domain.CourseSortTitle,
+			Limit: 1,
+		})
+		if err != nil {
+			t.Fatalf("courses.ListLive failed: %v", err)
+		}
+		expectTitles(t, list, "alpha")
+
+		list, _, err = courses.ListLive(ctx, &domain.CourseFilter{
+			Sort:  domain.CourseSortTitle,
+			After: next,
+		})
+		if err != nil {
+			t.Fatalf("courses.ListLive failed: %v", err)
+		}
+		expectTitles(t, list, "Beta", "Gamma")
+	})
+
+	t.Run("SortMostEnrolled", func(t *testing.T) {
+		ctx := context.Background()
+		courses, _ := setup(t)
+
+		list, next, err := courses.ListLive(ctx, &domain.CourseFilter{
+			Sort:  domain.CourseSortMostEnrolled,
+			Limit: 2,
+		})
+		if err != nil {
+			t.Fatalf("courses.ListLive failed: %v", err)
+		}
+		expectTitles(t, list, "Gamma", "Beta")
+
+		list, _, err = courses.ListLive(ctx, &domain.CourseFilter{
+			Sort:  domain.CourseSortMostEnrolled,
+			After: next,
+			Limit: 2,
+		})
+		if err != nil {
+			t.Fatalf("courses.ListLive failed: %v", err)
+		}
+		expectTitles(t, list, "alpha")
+	})
+
+	t.Run("FilterInstructor", func(t *testing.T) {
+		ctx := context.Background()
+		courses, ids := setup(t)
+
+		list, _, err := courses.ListLive(ctx, &domain.CourseFilter{
+			Sort:         domain.CourseSortTitle,
+			InstructorID: ids["first@example.com"],
+		})
+		if err != nil {
+			t.Fatalf("courses.ListLive failed: %v", err)
+		}
+		expectTitles(t, list, "alpha", "Beta")
+	})
+
+	t.Run("FilterEnrolledUser", func(t *testing.T) {
+		ctx := context.Background()
+		courses, ids := setup(t)
+
+		list, _, err := courses.ListLive(ctx, &domain.CourseFilter{
+			Sort:           domain.CourseSortTitle,
+			EnrolledUserID: ids["student@example.com"],
+		})
+		if err != nil {
+			t.Fatalf("courses.ListLive failed: %v", err)
+		}
+		expectTitles(t, list, "Beta", "Gamma")
+
+		list, _, err = courses.ListLive(ctx, &domain.CourseFilter{
+			EnrolledUserID: ids["second@example.com"],
+		})
+		if err != nil {
+			t.Fatalf("courses.ListLive failed: %v", err)
+		}
+		if len(list) != 0 {
+			t.Fatalf("courses.ListLive: expected no courses, got %d", len(list))
+		}
+	})
+}
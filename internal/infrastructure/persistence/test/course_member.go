@@ -0,0 +1,182 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+type NewCourseMemberRepository func(t *testing.T) persistence.CourseMemberRepository
+
+func TestCourseMemberRepository(t *testing.T, newCourseMemberRepo NewCourseMemberRepository, newCourseRepo NewCourseRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.CourseMemberRepository, *domain.User, *domain.User, *domain.Course) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		courses := newCourseRepo(t)
+		members := newCourseMemberRepo(t)
+
+		owner := domain.User{
+			Email:        "owner@example.com",
+			Name:         "Owner",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &owner); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		colleague := domain.User{
+			Email:        "colleague@example.com",
+			Name:         "Colleague",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &colleague); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		c := domain.Course{
+			Title:        "Test Course",
+			Summary:      "A test course",
+			InstructorID: owner.ID,
+			Status:       domain.CourseStatusDraft,
+		}
+		if err := courses.Create(ctx, &c); err != nil {
+			t.Fatalf("courses.Create failed: %v", err)
+		}
+
+		return members, &owner, &colleague, &c
+	}
+
+	t.Run("AddAndGetMember", func(t *testing.T) {
+		ctx := context.Background()
+		members, _, colleague, course := setup(t)
+
+		if _, ok := members.GetMember(ctx, course.ID, colleague.ID); ok {
+			t.Fatalf("members.GetMember: expected no member before add")
+		}
+
+		m := domain.CourseMember{
+			CourseID: course.ID,
+			UserID:   colleague.ID,
+			Role:     domain.CourseRoleCoInstructor,
+		}
+		if err := members.AddMember(ctx, &m); err != nil {
+			t.Fatalf("members.AddMember failed: %v", err)
+		}
+		if m.CreatedAt.IsZero() {
+			t.Fatalf("members.AddMember: CreatedAt not set")
+		}
+
+		got, ok := members.GetMember(ctx, course.ID, colleague.ID)
+		if !ok {
+			t.Fatalf("members.GetMember: expected member")
+		}
+		if got.Role != domain.CourseRoleCoInstructor {
+			t.Fatalf("members.GetMember: role mismatch: got %q, want %q", got.Role, domain.CourseRoleCoInstructor)
+		}
+	})
+
+	t.Run("AddDuplicate", func(t *testing.T) {
+		ctx := context.Background()
+		members, _, colleague, course := setup(t)
+
+		m := domain.CourseMember{CourseID: course.ID, UserID: colleague.ID, Role: domain.CourseRoleTA}
+		if err := members.AddMember(ctx, &m); err != nil {
+			t.Fatalf("members.AddMember failed: %v", err)
+		}
+
+		dup := domain.CourseMember{CourseID: course.ID, UserID: colleague.ID, Role: domain.CourseRoleCoInstructor}
+		if err := members.AddMember(ctx, &dup); err != errors.ErrConflict {
+			t.Fatalf("members.AddMember duplicate: expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("ListMembers", func(t *testing.T) {
+		ctx := context.Background()
+		members, owner, colleague, course := setup(t)
+
+		list, err := members.ListMembers(ctx, course.ID)
+		if err != nil {
+			t.Fatalf("members.ListMembers failed: %v", err)
+		}
+		if len(list) != 0 {
+			t.Fatalf("members.ListMembers: expected 0 members, got %d", len(list))
+		}
+
+		for _, m := range []domain.CourseMember{
+			{CourseID: course.ID, UserID: owner.ID, Role: domain.CourseRoleOwner},
+			{CourseID: course.ID, UserID: colleague.ID, Role: domain.CourseRoleTA},
+		} {
+			if err := members.AddMember(ctx, &m); err != nil {
+				t.Fatalf("members.AddMember failed: %v", err)
+			}
+		}
+
+		list, err = members.ListMembers(ctx, course.ID)
+		if err != nil {
+			t.Fatalf("members.ListMembers failed: %v", err)
+		}
+		if len(list) != 2 {
+			t.Fatalf("members.ListMembers: expected 2 members, got %d", len(list))
+		}
+		if list[0].UserID != owner.ID {
+			t.Fatalf("members.ListMembers: expected owner first, got user %d", list[0].UserID)
+		}
+
+		memberships, err := members.ListMembershipsByUser(ctx, colleague.ID)
+		if err != nil {
+			t.Fatalf("members.ListMembershipsByUser failed: %v", err)
+		}
+		if len(memberships) != 1 || memberships[0].CourseID != course.ID {
+			t.Fatalf("members.ListMembershipsByUser: unexpected result %+v", memberships)
+		}
+	})
+
+	t.Run("UpdateMemberRole", func(t *testing.T) {
+		ctx := context.Background()
+		members, _, colleague, course := setup(t)
+
+		if err := members.UpdateMemberRole(ctx, course.ID, colleague.ID, domain.CourseRoleTA); err != errors.ErrNotFound {
+			t.Fatalf("members.UpdateMemberRole: expected ErrNotFound, got %v", err)
+		}
+
+		m := domain.CourseMember{CourseID: course.ID, UserID: colleague.ID, Role: domain.CourseRoleTA}
+		if err := members.AddMember(ctx, &m); err != nil {
+			t.Fatalf("members.AddMember failed: %v", err)
+		}
+
+		if err := members.UpdateMemberRole(ctx, course.ID, colleague.ID, domain.CourseRoleCoInstructor); err != nil {
+			t.Fatalf("members.UpdateMemberRole failed: %v", err)
+		}
+
+		got, ok := members.GetMember(ctx, course.ID, colleague.ID)
+		if !ok || got.Role != domain.CourseRoleCoInstructor {
+			t.Fatalf("members.UpdateMemberRole: role not updated: %+v", got)
+		}
+	})
+
+	t.Run("RemoveMember", func(t *testing.T) {
+		ctx := context.Background()
+		members, _, colleague, course := setup(t)
+
+		if err := members.RemoveMember(ctx, course.ID, colleague.ID); err != errors.ErrNotFound {
+			t.Fatalf("members.RemoveMember: expected ErrNotFound, got %v", err)
+		}
+
+		m := domain.CourseMember{CourseID: course.ID, UserID: colleague.ID, Role: domain.CourseRoleTA}
+		if err := members.AddMember(ctx, &m); err != nil {
+			t.Fatalf("members.AddMember failed: %v", err)
+		}
+
+		if err := members.RemoveMember(ctx, course.ID, colleague.ID); err != nil {
+			t.Fatalf("members.RemoveMember failed: %v", err)
+		}
+		if _, ok := members.GetMember(ctx, course.ID, colleague.ID); ok {
+			t.Fatalf("members.RemoveMember: member still present")
+		}
+	})
+}
@@ -0,0 +1,98 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+type NewEmailChangeRepository func(t *testing.T) persistence.EmailChangeRepository
+
+func TestEmailChangeRepository(t *testing.T, newEmailChangeRepo NewEmailChangeRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.EmailChangeRepository, *domain.User) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		changes := newEmailChangeRepo(t)
+
+		u := domain.User{
+			Email:        "user@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		return changes, &u
+	}
+
+	t.Run("ConsumeEmailChangeToken", func(t *testing.T) {
+		ctx := context.Background()
+		changes, u := setup(t)
+
+		tokenHash := []byte("change-token-hash")
+		if err := changes.CreateEmailChangeToken(ctx, u.ID, "new@example.com", tokenHash, time.Now().Add(1*time.Hour)); err != nil {
+			t.Fatalf("changes.CreateEmailChangeToken failed: %v", err)
+		}
+
+		userID, newEmail, ok := changes.ConsumeEmailChangeToken(ctx, tokenHash, time.Now())
+		if !ok {
+			t.Fatalf("changes.ConsumeEmailChangeToken failed")
+		}
+		if userID != u.ID {
+			t.Fatalf("changes.ConsumeEmailChangeToken: expected user ID %d, got %d", u.ID, userID)
+		}
+		if newEmail != "new@example.com" {
+			t.Fatalf("changes.ConsumeEmailChangeToken: expected new@example.com, got %q", newEmail)
+		}
+
+		if _, _, ok := changes.ConsumeEmailChangeToken(ctx, tokenHash, time.Now()); ok {
+			t.Fatalf("changes.ConsumeEmailChangeToken: should return false when consuming same token twice")
+		}
+	})
+
+	t.Run("ConsumeEmailChangeTokenExpired", func(t *testing.T) {
+		ctx := context.Background()
+		changes, u := setup(t)
+
+		tokenHash := []byte("expired-change-token-hash")
+		if err := changes.CreateEmailChangeToken(ctx, u.ID, "new@example.com", tokenHash, time.Now().Add(-1*time.Hour)); err != nil {
+			t.Fatalf("changes.CreateEmailChangeToken failed: %v", err)
+		}
+
+		if _, _, ok := changes.ConsumeEmailChangeToken(ctx, tokenHash, time.Now()); ok {
+			t.Fatalf("changes.ConsumeEmailChangeToken: should return false for expired token")
+		}
+	})
+
+	t.Run("NewRequestReplacesPrevious", func(t *testing.T) {
+		ctx := context.Background()
+		changes, u := setup(t)
+
+		first := []byte("first-change-token-hash")
+		second := []byte("second-change-token-hash")
+		expiresAt := time.Now().Add(1 * time.Hour)
+
+		if err := changes.CreateEmailChangeToken(ctx, u.ID, "first@example.com", first, expiresAt); err != nil {
+			t.Fatalf("changes.CreateEmailChangeToken failed: %v", err)
+		}
+		if err := changes.CreateEmailChangeToken(ctx, u.ID, "second@example.com", second, expiresAt); err != nil {
+			t.Fatalf("changes.CreateEmailChangeToken failed: %v", err)
+		}
+
+		if _, _, ok := changes.ConsumeEmailChangeToken(ctx, first, time.Now()); ok {
+			t.Fatalf("changes.ConsumeEmailChangeToken: superseded token should be invalid")
+		}
+		_, newEmail, ok := changes.ConsumeEmailChangeToken(ctx, second, time.Now())
+		if !ok {
+			t.Fatalf("changes.ConsumeEmailChangeToken: latest token should be valid")
+		}
+		if newEmail != "second@example.com" {
+			t.Fatalf("changes.ConsumeEmailChangeToken: expected second@example.com, got %q", newEmail)
+		}
+	})
+}
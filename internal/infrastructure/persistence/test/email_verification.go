@@ -0,0 +1,77 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+type NewEmailVerificationRepository func(t *testing.T) persistence.EmailVerificationRepository
+
+func TestEmailVerificationRepository(t *testing.T, newVerificationRepo NewEmailVerificationRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.EmailVerificationRepository, *domain.User) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		verifications := newVerificationRepo(t)
+
+		u := domain.User{
+			Email:        "user@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		return verifications, &u
+	}
+
+	t.Run("ConsumeVerificationToken", func(t *testing.T) {
+		ctx := context.Background()
+		verifications, u := setup(t)
+
+		tokenHash := []byte("verify-token-hash")
+		if err := verifications.CreateVerificationToken(ctx, u.ID, tokenHash, time.Now().Add(1*time.Hour)); err != nil {
+			t.Fatalf("verifications.CreateVerificationToken failed: %v", err)
+		}
+
+		userID, ok := verifications.ConsumeVerificationToken(ctx, tokenHash, time.Now())
+		if !ok {
+			t.Fatalf("verifications.ConsumeVerificationToken failed")
+		}
+		if userID != u.ID {
+			t.Fatalf("verifications.ConsumeVerificationToken: expected user ID %d, got %d", u.ID, userID)
+		}
+
+		if _, ok := verifications.ConsumeVerificationToken(ctx, tokenHash, time.Now()); ok {
+			t.Fatalf("verifications.ConsumeVerificationToken: should return false when consuming same token twice")
+		}
+	})
+
+	t.Run("ConsumeVerificationTokenExpired", func(t *testing.T) {
+		ctx := context.Background()
+		verifications, u := setup(t)
+
+		tokenHash := []byte("expired-token-hash")
+		if err := verifications.CreateVerificationToken(ctx, u.ID, tokenHash, time.Now().Add(-1*time.Hour)); err != nil {
+			t.Fatalf("verifications.CreateVerificationToken failed: %v", err)
+		}
+
+		if _, ok := verifications.ConsumeVerificationToken(ctx, tokenHash, time.Now()); ok {
+			t.Fatalf("verifications.ConsumeVerificationToken: should return false for expired token")
+		}
+	})
+
+	t.Run("ConsumeVerificationTokenNotFound", func(t *testing.T) {
+		ctx := context.Background()
+		verifications, _ := setup(t)
+
+		if _, ok := verifications.ConsumeVerificationToken(ctx, []byte("missing-token-hash"), time.Now()); ok {
+			t.Fatalf("verifications.ConsumeVerificationToken: should return false for non-existent token")
+		}
+	})
+}
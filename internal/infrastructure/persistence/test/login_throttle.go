@@ -0,0 +1,140 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+type NewLoginThrottleRepository func(t *testing.T) persistence.LoginThrottleRepository
+
+func TestLoginThrottleRepository(t *testing.T, newLoginThrottleRepo NewLoginThrottleRepository) {
+	t.Helper()
+
+	const key = "login:email:user@example.com"
+	const window = time.Hour
+
+	t.Run("GetThrottleNotFound", func(t *testing.T) {
+		ctx := context.Background()
+		throttles := newLoginThrottleRepo(t)
+
+		if _, ok := throttles.GetThrottle(ctx, key); ok {
+			t.Fatalf("throttles.GetThrottle: expected no throttle")
+		}
+	})
+
+	t.Run("RecordFailure", func(t *testing.T) {
+		ctx := context.Background()
+		throttles := newLoginThrottleRepo(t)
+		now := time.Now()
+
+		for i := 1; i <= 3; i++ {
+			th, err := throttles.RecordFailure(ctx, key, now.Add(time.Duration(i)*time.Minute), window)
+			if err != nil {
+				t.Fatalf("throttles.RecordFailure failed: %v", err)
+			}
+			if th.Failures != i {
+				t.Fatalf("throttles.RecordFailure: expected %d failures, got %d", i, th.Failures)
+			}
+		}
+
+		th, ok := throttles.GetThrottle(ctx, key)
+		if !ok {
+			t.Fatalf("throttles.GetThrottle failed")
+		}
+		if th.Failures != 3 {
+			t.Fatalf("throttles.GetThrottle: expected 3 failures, got %d", th.Failures)
+		}
+		if th.LockedUntil != nil {
+			t.Fatalf("throttles.GetThrottle: expected throttle to be unlocked")
+		}
+
+		if _, ok := throttles.GetThrottle(ctx, "login:email:other@example.com"); ok {
+			t.Fatalf("throttles.GetThrottle: throttles should be per key")
+		}
+	})
+
+	t.Run("RecordFailureAfterWindow", func(t *testing.T) {
+		ctx := context.Background()
+		throttles := newLoginThrottleRepo(t)
+		now := time.Now()
+
+		if _, err := throttles.RecordFailure(ctx, key, now, window); err != nil {
+			t.Fatalf("throttles.RecordFailure failed: %v", err)
+		}
+		if _, err := throttles.RecordFailure(ctx, key, now, window); err != nil {
+			t.Fatalf("throttles.RecordFailure failed: %v", err)
+		}
+		if err := throttles.LockThrottle(ctx, key, now.Add(time.Minute)); err != nil {
+			t.Fatalf("throttles.LockThrottle failed: %v", err)
+		}
+
+		th, err := throttles.RecordFailure(ctx, key, now.Add(2*window), window)
+		if err != nil {
+			t.Fatalf("throttles.RecordFailure failed: %v", err)
+		}
+		if th.Failures != 1 {
+			t.Fatalf("throttles.RecordFailure: expected count to restart, got %d failures", th.Failures)
+		}
+		if th.LockedUntil != nil {
+			t.Fatalf("throttles.RecordFailure: expected lock to be cleared")
+		}
+	})
+
+	t.Run("LockThrottle", func(t *testing.T) {
+		ctx := context.Background()
+		throttles := newLoginThrottleRepo(t)
+		now := time.Now()
+
+		if err := throttles.LockThrottle(ctx, key, now.Add(time.Minute)); err == nil {
+			t.Fatalf("throttles.LockThrottle: expected error for unknown key")
+		}
+
+		if _, err := throttles.RecordFailure(ctx, key, now, window); err != nil {
+			t.Fatalf("throttles.RecordFailure failed: %v", err)
+		}
+		if err := throttles.LockThrottle(ctx, key, now.Add(time.Minute)); err != nil {
+			t.Fatalf("throttles.LockThrottle failed: %v", err)
+		}
+
+		th, ok := throttles.GetThrottle(ctx, key)
+		if !ok {
+			t.Fatalf("throttles.GetThrottle failed")
+		}
+		if !th.IsLocked(now) {
+			t.Fatalf("throttles.LockThrottle: expected throttle to be locked")
+		}
+		if th.IsLocked(now.Add(2 * time.Minute)) {
+			t.Fatalf("throttles.LockThrottle: expected lock to expire")
+		}
+
+		th, err := throttles.RecordFailure(ctx, key, now.Add(time.Second), window)
+		if err != nil {
+			t.Fatalf("throttles.RecordFailure failed: %v", err)
+		}
+		if !th.IsLocked(now) {
+			t.Fatalf("throttles.RecordFailure: expected lock to be kept")
+		}
+	})
+
+	t.Run("ResetThrottle", func(t *testing.T) {
+		ctx := context.Background()
+		throttles := newLoginThrottleRepo(t)
+
+		if err := throttles.ResetThrottle(ctx, key); err != nil {
+			t.Fatalf("throttles.ResetThrottle failed: %v", err)
+		}
+
+		if _, err := throttles.RecordFailure(ctx, key, time.Now(), window); err != nil {
+			t.Fatalf("throttles.RecordFailure failed: %v", err)
+		}
+		if err := throttles.ResetThrottle(ctx, key); err != nil {
+			t.Fatalf("throttles.ResetThrottle failed: %v", err)
+		}
+		if _, ok := throttles.GetThrottle(ctx, key); ok {
+			t.Fatalf("throttles.ResetThrottle: expected throttle to be removed")
+		}
+	})
+}
@@ -3,6 +3,7 @@ package test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/persistence"
@@ -303,6 +304,79 @@ func TestModuleRepository(t *testing.T, newModuleRepo NewModuleRepository, newCo
 		}
 	})
 
+	t.Run("UnlockRule", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		courses := newCourseRepo(t)
+		modules := newModuleRepo(t)
+
+		u := domain.User{
+			Email:        "instructor@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		c := domain.Course{
+			Title:        "Test Course",
+			Summary:      "A test course",
+			InstructorID: u.ID,
+			Status:       domain.CourseStatusDraft,
+		}
+		if err := courses.Create(ctx, &c); err != nil {
+			t.Fatalf("courses.Create failed: %v", err)
+		}
+
+		m := domain.Module{
+			CourseID: c.ID,
+			Title:    "Test Module",
+			Order:    1,
+			Status:   domain.ModuleStatusDraft,
+		}
+		if err := modules.Create(ctx, &m); err != nil {
+			t.Fatalf("modules.Create failed: %v", err)
+		}
+
+		v, ok := modules.GetByID(ctx, m.ID)
+		if !ok {
+			t.Fatalf("modules.GetByID failed")
+		}
+		if v.UnlockRule != domain.ModuleUnlockImmediately {
+			t.Fatalf("modules.Create: expected default unlock rule %q, got %q", domain.ModuleUnlockImmediately, v.UnlockRule)
+		}
+
+		v.UnlockRule = domain.ModuleUnlockAfterDays
+		v.UnlockDays = 7
+		if err := modules.Update(ctx, v); err != nil {
+			t.Fatalf("modules.Update failed: %v", err)
+		}
+
+		w, ok := modules.GetByID(ctx, m.ID)
+		if !ok {
+			t.Fatalf("modules.GetByID failed")
+		}
+		if w.UnlockRule != domain.ModuleUnlockAfterDays || w.UnlockDays != 7 || w.UnlockAt != nil {
+			t.Fatalf("modules.Update: unlock rule not persisted")
+		}
+
+		at := time.Date(2030, 1, 15, 0, 0, 0, 0, time.UTC)
+		w.UnlockRule = domain.ModuleUnlockOnDate
+		w.UnlockDays = 0
+		w.UnlockAt = &at
+		if err := modules.Update(ctx, w); err != nil {
+			t.Fatalf("modules.Update failed: %v", err)
+		}
+
+		x, ok := modules.GetByID(ctx, m.ID)
+		if !ok {
+			t.Fatalf("modules.GetByID failed")
+		}
+		if x.UnlockRule != domain.ModuleUnlockOnDate || x.UnlockAt == nil || !x.UnlockAt.Equal(at) {
+			t.Fatalf("modules.Update: unlock date not persisted")
+		}
+	})
+
 	t.Run("DeleteByID", func(t *testing.T) {
 		ctx := context.Background()
 		users := newUserRepo(t)
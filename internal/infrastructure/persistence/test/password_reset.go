@@ -65,6 +65,46 @@ func TestPasswordResetRepository(t *testing.T, newPasswordResetRepo NewPasswordR
 		}
 	})
 
+	t.Run("GetResetToken", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		resets := newPasswordResetRepo(t)
+
+		u := domain.User{
+			Email:        "user@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		tokenHash := []byte("test-token-hash")
+		expiresAt := time.Now().Add(1 * time.Hour)
+
+		if err := resets.CreateResetToken(ctx, u.ID, tokenHash, expiresAt); err != nil {
+			t.Fatalf("resets.CreateResetToken failed: %v", err)
+		}
+
+		now := time.Now()
+		userID, ok := resets.GetResetToken(ctx, tokenHash, now)
+		if !ok {
+			t.Fatalf("resets.GetResetToken failed")
+		}
+		if userID != u.ID {
+			t.Fatalf("resets.GetResetToken: expected user ID %d, got %d", u.ID, userID)
+		}
+
+		if _, ok := resets.ConsumeResetToken(ctx, tokenHash, now); !ok {
+			t.Fatalf("resets.ConsumeResetToken: token should still be usable after GetResetToken")
+		}
+		if _, ok := resets.GetResetToken(ctx, tokenHash, now); ok {
+			t.Fatalf("resets.GetResetToken: should return false for consumed token")
+		}
+		if _, ok := resets.GetResetToken(ctx, tokenHash, expiresAt.Add(time.Minute)); ok {
+			t.Fatalf("resets.GetResetToken: should return false for expired token")
+		}
+	})
+
 	t.Run("ConsumeResetTokenExpired", func(t *testing.T) {
 		ctx := context.Background()
 		users := newUserRepo(t)
@@ -0,0 +1,185 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+type NewProgressRepository func(t *testing.T) persistence.ProgressRepository
+
+func TestProgressRepository(t *testing.T, newProgressRepo NewProgressRepository, newReadingRepo NewReadingRepository, newModuleRepo NewModuleRepository, newCourseRepo NewCourseRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.ProgressRepository, *domain.User, *domain.Module, []domain.Reading) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		courses := newCourseRepo(t)
+		modules := newModuleRepo(t)
+		readings := newReadingRepo(t)
+		progress := newProgressRepo(t)
+
+		instructor := domain.User{
+			Email:        "instructor@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &instructor); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		learner := domain.User{
+			Email:        "learner@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &learner); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		c := domain.Course{
+			Title:        "Test Course",
+			Summary:      "A test course",
+			InstructorID: instructor.ID,
+			Status:       domain.CourseStatusPublished,
+		}
+		if err := courses.Create(ctx, &c); err != nil {
+			t.Fatalf("courses.Create failed: %v", err)
+		}
+
+		m := domain.Module{
+			CourseID:    c.ID,
+			Title:       "Test Module",
+			Description: "A test module",
+			Order:       1,
+			Status:      domain.ModuleStatusPublished,
+		}
+		if err := modules.Create(ctx, &m); err != nil {
+			t.Fatalf("modules.Create failed: %v", err)
+		}
+
+		items := make([]domain.Reading, 0, 2)
+		for i, title := range []string{"First Reading", "Second Reading"} {
+			content := "# " + title
+			r := domain.Reading{
+				BaseContentItem: domain.BaseContentItem{
+					ModuleID: m.ID,
+					Title:    title,
+					Order:    i + 1,
+					Status:   domain.ContentStatusPublished,
+				},
+				Format:  domain.ReadingFormatMarkdown,
+				Content: &content,
+			}
+			if err := readings.Create(ctx, &r); err != nil {
+				t.Fatalf("readings.Create failed: %v", err)
+			}
+			items = append(items, r)
+		}
+
+		return progress, &learner, &m, items
+	}
+
+	newCompletion := func(userID int64, m *domain.Module, r domain.Reading) domain.ContentCompletion {
+		return domain.ContentCompletion{
+			UserID:      userID,
+			CourseID:    m.CourseID,
+			ModuleID:    m.ID,
+			ContentID:   r.ID,
+			ContentType: domain.ContentTypeReading,
+		}
+	}
+
+	t.Run("CreateAndGet", func(t *testing.T) {
+		ctx := context.Background()
+		progress, learner, m, readings := setup(t)
+
+		c := newCompletion(learner.ID, m, readings[0])
+		if err := progress.Create(ctx, &c); err != nil {
+			t.Fatalf("progress.Create failed: %v", err)
+		}
+		if c.CompletedAt.IsZero() {
+			t.Fatalf("progress.Create: CompletedAt not set")
+		}
+
+		got, ok := progress.Get(ctx, learner.ID, domain.ContentTypeReading, readings[0].ID)
+		if !ok {
+			t.Fatalf("progress.Get: expected completion")
+		}
+		if got.CourseID != m.CourseID || got.ModuleID != m.ID {
+			t.Fatalf("progress.Get: course/module mismatch: got %d/%d", got.CourseID, got.ModuleID)
+		}
+
+		if _, ok := progress.Get(ctx, learner.ID, domain.ContentTypeReading, readings[1].ID); ok {
+			t.Fatalf("progress.Get: expected no completion for second reading")
+		}
+		if _, ok := progress.Get(ctx, learner.ID, domain.ContentTypeFile, readings[0].ID); ok {
+			t.Fatalf("progress.Get: expected no completion for different content type")
+		}
+	})
+
+	t.Run("CreateDuplicate", func(t *testing.T) {
+		ctx := context.Background()
+		progress, learner, m, readings := setup(t)
+
+		c := newCompletion(learner.ID, m, readings[0])
+		if err := progress.Create(ctx, &c); err != nil {
+			t.Fatalf("progress.Create failed: %v", err)
+		}
+
+		dup := newCompletion(learner.ID, m, readings[0])
+		if err := progress.Create(ctx, &dup); err != errors.ErrConflict {
+			t.Fatalf("progress.Create duplicate: expected ErrConflict, got %v", err)
+		}
+	})
+
+	t.Run("ListByUserAndCourse", func(t *testing.T) {
+		ctx := context.Background()
+		progress, learner, m, readings := setup(t)
+
+		for _, r := range readings {
+			c := newCompletion(learner.ID, m, r)
+			if err := progress.Create(ctx, &c); err != nil {
+				t.Fatalf("progress.Create failed: %v", err)
+			}
+		}
+
+		list, err := progress.ListByUserAndCourse(ctx, learner.ID, m.CourseID)
+		if err != nil {
+			t.Fatalf("progress.ListByUserAndCourse failed: %v", err)
+		}
+		if len(list) != 2 {
+			t.Fatalf("progress.ListByUserAndCourse: expected 2 completions, got %d", len(list))
+		}
+
+		list, err = progress.ListByUserAndCourse(ctx, learner.ID, m.CourseID+1000)
+		if err != nil {
+			t.Fatalf("progress.ListByUserAndCourse failed: %v", err)
+		}
+		if len(list) != 0 {
+			t.Fatalf("progress.ListByUserAndCourse: expected 0 completions, got %d", len(list))
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		ctx := context.Background()
+		progress, learner, m, readings := setup(t)
+
+		c := newCompletion(learner.ID, m, readings[0])
+		if err := progress.Create(ctx, &c); err != nil {
+			t.Fatalf("progress.Create failed: %v", err)
+		}
+
+		if err := progress.Delete(ctx, learner.ID, domain.ContentTypeReading, readings[0].ID); err != nil {
+			t.Fatalf("progress.Delete failed: %v", err)
+		}
+		if _, ok := progress.Get(ctx, learner.ID, domain.ContentTypeReading, readings[0].ID); ok {
+			t.Fatalf("progress.Get: expected completion to be deleted")
+		}
+
+		if err := progress.Delete(ctx, learner.ID, domain.ContentTypeReading, readings[0].ID); err != errors.ErrNotFound {
+			t.Fatalf("progress.Delete missing: expected ErrNotFound, got %v", err)
+		}
+	})
+}
@@ -2,10 +2,13 @@ package test
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
 )
 
 type NewProposalRepository func(t *testing.T) persistence.ProposalRepository
@@ -547,4 +550,591 @@ func TestProposalRepository(t *testing.T, newProposalRepo NewProposalRepository,
 			t.Fatalf("proposals.ListByAuthorID: proposals from different authors should be isolated")
 		}
 	})
+
+	t.Run("Comments", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		proposals := newProposalRepo(t)
+
+		author := domain.User{
+			Email:        "author@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &author); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		reviewer := domain.User{
+			Email:        "reviewer@example.com",
+			PasswordHash: make([]byte, 20),
+			Role:         domain.SystemRoleReviewer,
+		}
+		if err := users.Create(ctx, &reviewer); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		p := domain.Proposal{
+			Title:    "Test Proposal",
+			Summary:  "A test proposal",
+			AuthorID: author.ID,
+			Status:   domain.ProposalStatusChangesRequested,
+		}
+		if err := proposals.Create(ctx, &p); err != nil {
+			t.Fatalf("proposals.Create failed: %v", err)
+		}
+
+		list, err := proposals.ListComments(ctx, p.ID)
+		if err != nil {
+			t.Fatalf("proposals.ListComments failed: %v", err)
+		}
+		if len(list) != 0 {
+			t.Fatalf("proposals.ListComments: expected no comments, got %d", len(list))
+		}
+
+		review := domain.ProposalComment{
+			ProposalID: p.ID,
+			AuthorID:   reviewer.ID,
+			Kind:       domain.ProposalCommentKindReview,
+			Body:       "Please expand the outline",
+			Vote:       domain.ProposalVoteRequestChanges,
+			Status:     domain.ProposalStatusChangesRequested,
+		}
+		if err := proposals.CreateComment(ctx, &review); err != nil {
+			t.Fatalf("proposals.CreateComment failed: %v", err)
+		}
+		if review.ID == 0 || review.CreatedAt.IsZero() {
+			t.Fatalf("proposals.CreateComment: expected ID and CreatedAt set, got %+v", review)
+		}
+
+		reply := domain.ProposalComment{
+			ProposalID: p.ID,
+			AuthorID:   author.ID,
+			Kind:       domain.ProposalCommentKindReply,
+			Body:       "Done, see module 3",
+			Status:     domain.ProposalStatusChangesRequested,
+		}
+		if err := proposals.CreateComment(ctx, &reply); err != nil {
+			t.Fatalf("proposals.CreateComment failed: %v", err)
+		}
+
+		list, err = proposals.ListComments(ctx, p.ID)
+		if err != nil {
+			t.Fatalf("proposals.ListComments failed: %v", err)
+		}
+		if len(list) != 2 || list[0].ID != review.ID || list[1].ID != reply.ID {
+			t.Fatalf("proposals.ListComments: expected review then reply, got %+v", list)
+		}
+		if list[1].Vote != "" {
+			t.Fatalf("proposals.ListComments: reply should carry no vote")
+		}
+		if list[0].AuthorID != reviewer.ID || list[0].Kind != domain.ProposalCommentKindReview ||
+			list[0].Body != review.Body || list[0].Vote != domain.ProposalVoteRequestChanges ||
+			list[0].Status != domain.ProposalStatusChangesRequested {
+			t.Fatalf("proposals.ListComments: comments differ")
+		}
+
+		if err := proposals.DeleteByID(ctx, p.ID); err != nil {
+			t.Fatalf("proposals.DeleteByID failed: %v", err)
+		}
+		list, err = proposals.ListComments(ctx, p.ID)
+		if err != nil {
+			t.Fatalf("proposals.ListComments failed: %v", err)
+		}
+		if len(list) != 0 {
+			t.Fatalf("proposals.ListComments: expected comments removed with proposal, got %d", len(list))
+		}
+	})
+
+	t.Run("Versions", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		proposals := newProposalRepo(t)
+
+		author := domain.User{
+			Email:        "author@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &author); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		p := domain.Proposal{
+			Title:    "Test Proposal",
+			Summary:  "A test proposal",
+			Outline:  "1. Basics",
+			AuthorID: author.ID,
+			Status:   domain.ProposalStatusSubmitted,
+		}
+		if err := proposals.Create(ctx, &p); err != nil {
+			t.Fatalf("proposals.Create failed: %v", err)
+		}
+
+		first := domain.NewProposalVersion(&p)
+		if err := proposals.CreateVersion(ctx, first); err != nil {
+			t.Fatalf("proposals.CreateVersion failed: %v", err)
+		}
+		if first.ID == 0 || first.Number != 1 || first.SubmittedAt.IsZero() {
+			t.Fatalf("proposals.CreateVersion: expected version 1 with ID and SubmittedAt set, got %+v", first)
+		}
+
+		p.Outline = "1. Basics\n2. Advanced"
+		second := domain.NewProposalVersion(&p)
+		if err := proposals.CreateVersion(ctx, second); err != nil {
+			t.Fatalf("proposals.CreateVersion failed: %v", err)
+		}
+		if second.Number != 2 {
+			t.Fatalf("proposals.CreateVersion: expected number 2, got %d", second.Number)
+		}
+
+		v, ok := proposals.GetVersion(ctx, p.ID, 1)
+		if !ok {
+			t.Fatalf("proposals.GetVersion failed")
+		}
+		if v.Title != p.Title || v.Summary != p.Summary || v.Outline != "1. Basics" {
+			t.Fatalf("proposals.GetVersion: versions differ, got %+v", v)
+		}
+		if _, ok := proposals.GetVersion(ctx, p.ID, 3); ok {
+			t.Fatalf("proposals.GetVersion: should return false for non-existent version")
+		}
+
+		list, err := proposals.ListVersions(ctx, p.ID)
+		if err != nil {
+			t.Fatalf("proposals.ListVersions failed: %v", err)
+		}
+		if len(list) != 2 || list[0].Number != 2 || list[1].Number != 1 {
+			t.Fatalf("proposals.ListVersions: expected versions 2, 1, got %+v", list)
+		}
+
+		if err := proposals.DeleteByID(ctx, p.ID); err != nil {
+			t.Fatalf("proposals.DeleteByID failed: %v", err)
+		}
+		list, err = proposals.ListVersions(ctx, p.ID)
+		if err != nil {
+			t.Fatalf("proposals.ListVersions failed: %v", err)
+		}
+		if len(list) != 0 {
+			t.Fatalf("proposals.ListVersions: expected versions removed with proposal, got %d", len(list))
+		}
+	})
+
+	t.Run("OutlineModules", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		proposals := newProposalRepo(t)
+
+		author := domain.User{
+			Email:        "author@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &author); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		empty := domain.Proposal{
+			Title:    "No Outline",
+			AuthorID: author.ID,
+			Status:   domain.ProposalStatusDraft,
+		}
+		if err := proposals.Create(ctx, &empty); err != nil {
+			t.Fatalf("proposals.Create failed: %v", err)
+		}
+		v, ok := proposals.GetByID(ctx, empty.ID)
+		if !ok {
+			t.Fatalf("proposals.GetByID failed")
+		}
+		if v.OutlineModules == nil || len(v.OutlineModules) != 0 {
+			t.Fatalf("proposals.GetByID: expected empty outline modules, got %#v", v.OutlineModules)
+		}
+
+		modules := []domain.OutlineModule{
+			{Title: "Basics", Description: "Getting started", Items: []string{"Setup", "Hello world"}},
+			{Title: "Advanced", Items: []string{}},
+		}
+		p := domain.Proposal{
+			Title:          "Structured",
+			OutlineModules: modules,
+			AuthorID:       author.ID,
+			Status:         domain.ProposalStatusDraft,
+		}
+		if err := proposals.Create(ctx, &p); err != nil {
+			t.Fatalf("proposals.Create failed: %v", err)
+		}
+		modules[0].Items[0] = "Modified"
+
+		v, ok = proposals.GetByID(ctx, p.ID)
+		if !ok {
+			t.Fatalf("proposals.GetByID failed")
+		}
+		if len(v.OutlineModules) != 2 ||
+			v.OutlineModules[0].Title != "Basics" ||
+			v.OutlineModules[0].Description != "Getting started" ||
+			len(v.OutlineModules[0].Items) != 2 ||
+			v.OutlineModules[0].Items[0] != "Setup" ||
+			v.OutlineModules[1].Title != "Advanced" ||
+			len(v.OutlineModules[1].Items) != 0 {
+			t.Fatalf("proposals.GetByID: outline modules differ, got %+v", v.OutlineModules)
+		}
+
+		v.OutlineModules = append(v.OutlineModules, domain.OutlineModule{Title: "Capstone", Items: []string{"Project"}})
+		if err := proposals.Update(ctx, v); err != nil {
+			t.Fatalf("proposals.Update failed: %v", err)
+		}
+		if err := proposals.CreateVersion(ctx, domain.NewProposalVersion(v)); err != nil {
+			t.Fatalf("proposals.CreateVersion failed: %v", err)
+		}
+
+		w, ok := proposals.GetByID(ctx, p.ID)
+		if !ok {
+			t.Fatalf("proposals.GetByID failed")
+		}
+		if len(w.OutlineModules) != 3 || w.OutlineModules[2].Items[0] != "Project" {
+			t.Fatalf("proposals.Update: outline modules not updated, got %+v", w.OutlineModules)
+		}
+
+		version, ok := proposals.GetVersion(ctx, p.ID, 1)
+		if !ok {
+			t.Fatalf("proposals.GetVersion failed")
+		}
+		if len(version.OutlineModules) != 3 || version.OutlineModules[2].Title != "Capstone" {
+			t.Fatalf("proposals.GetVersion: outline modules not snapshotted, got %+v", version.OutlineModules)
+		}
+	})
+
+	t.Run("Attachments", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		proposals := newProposalRepo(t)
+
+		author := domain.User{
+			Email:        "author@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &author); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		p := domain.Proposal{
+			Title:    "Test Proposal",
+			AuthorID: author.ID,
+			Status:   domain.ProposalStatusDraft,
+		}
+		if err := proposals.Create(ctx, &p); err != nil {
+			t.Fatalf("proposals.Create failed: %v", err)
+		}
+
+		slides := domain.ProposalAttachment{
+			ProposalID:  p.ID,
+			FileName:    "slides.pdf",
+			FileSize:    1024,
+			MimeType:    "application/pdf",
+			StoragePath: "proposals/1/slides.pdf",
+		}
+		if err := proposals.CreateAttachment(ctx, &slides, 10, 1<<20); err != nil {
+			t.Fatalf("proposals.CreateAttachment failed: %v", err)
+		}
+		if slides.ID == 0 || slides.CreatedAt.IsZero() {
+			t.Fatalf("proposals.CreateAttachment: expected ID and CreatedAt set, got %+v", slides)
+		}
+
+		syllabus := domain.ProposalAttachment{
+			ProposalID:  p.ID,
+			FileName:    "syllabus.docx",
+			FileSize:    2048,
+			MimeType:    "application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+			StoragePath: "proposals/1/syllabus.docx",
+		}
+		if err := proposals.CreateAttachment(ctx, &syllabus, 10, 1<<20); err != nil {
+			t.Fatalf("proposals.CreateAttachment failed: %v", err)
+		}
+
+		a, ok := proposals.GetAttachment(ctx, p.ID, slides.ID)
+		if !ok {
+			t.Fatalf("proposals.GetAttachment failed")
+		}
+		if a.FileName != slides.FileName || a.FileSize != slides.FileSize ||
+			a.MimeType != slides.MimeType || a.StoragePath != slides.StoragePath {
+			t.Fatalf("proposals.GetAttachment: attachments differ, got %+v", a)
+		}
+		if _, ok := proposals.GetAttachment(ctx, p.ID+1, slides.ID); ok {
+			t.Fatalf("proposals.GetAttachment: should not find an attachment under another proposal")
+		}
+
+		list, err := proposals.ListAttachments(ctx, p.ID)
+		if err != nil {
+			t.Fatalf("proposals.ListAttachments failed: %v", err)
+		}
+		if len(list) != 2 || list[0].ID != slides.ID || list[1].ID != syllabus.ID {
+			t.Fatalf("proposals.ListAttachments: expected slides then syllabus, got %+v", list)
+		}
+
+		if err := proposals.DeleteAttachment(ctx, p.ID, slides.ID); err != nil {
+			t.Fatalf("proposals.DeleteAttachment failed: %v", err)
+		}
+		if err := proposals.DeleteAttachment(ctx, p.ID, slides.ID); err != errors.ErrNotFound {
+			t.Fatalf("proposals.DeleteAttachment: expected ErrNotFound, got %v", err)
+		}
+
+		if err := proposals.DeleteByID(ctx, p.ID); err != nil {
+			t.Fatalf("proposals.DeleteByID failed: %v", err)
+		}
+		list, err = proposals.ListAttachments(ctx, p.ID)
+		if err != nil {
+			t.Fatalf("proposals.ListAttachments failed: %v", err)
+		}
+		if len(list) != 0 {
+			t.Fatalf("proposals.ListAttachments: expected attachments removed with proposal, got %d", len(list))
+		}
+	})
+
+	t.Run("AttachmentLimits", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		proposals := newProposalRepo(t)
+
+		author := domain.User{
+			Email:        "author@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &author); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		p := domain.Proposal{
+			Title:    "Test Proposal",
+			AuthorID: author.ID,
+			Status:   domain.ProposalStatusDraft,
+		}
+		if err := proposals.Create(ctx, &p); err != nil {
+			t.Fatalf("proposals.Create failed: %v", err)
+		}
+
+		attachment := func(name string, size int64) *domain.ProposalAttachment {
+			return &domain.ProposalAttachment{
+				ProposalID:  p.ID,
+				FileName:    name,
+				FileSize:    size,
+				MimeType:    "application/pdf",
+				StoragePath: "proposals/1/" + name,
+			}
+		}
+
+		if err := proposals.CreateAttachment(ctx, attachment("one.pdf", 600), 2, 1000); err != nil {
+			t.Fatalf("proposals.CreateAttachment failed: %v", err)
+		}
+		if err := proposals.CreateAttachment(ctx, attachment("big.pdf", 401), 2, 1000); err != errors.ErrAttachmentLimitReached {
+			t.Fatalf("proposals.CreateAttachment: expected ErrAttachmentLimitReached over maxBytes, got %v", err)
+		}
+		if err := proposals.CreateAttachment(ctx, attachment("two.pdf", 400), 2, 1000); err != nil {
+			t.Fatalf("proposals.CreateAttachment: expected exactly maxBytes to fit, got %v", err)
+		}
+		if err := proposals.CreateAttachment(ctx, attachment("three.pdf", 0), 2, 1000); err != errors.ErrAttachmentLimitReached {
+			t.Fatalf("proposals.CreateAttachment: expected ErrAttachmentLimitReached over maxCount, got %v", err)
+		}
+
+		list, err := proposals.ListAttachments(ctx, p.ID)
+		if err != nil {
+			t.Fatalf("proposals.ListAttachments failed: %v", err)
+		}
+		if len(list) != 2 {
+			t.Fatalf("proposals.ListAttachments: expected 2 attachments, got %d", len(list))
+		}
+
+		if err := proposals.CreateAttachment(ctx, &domain.ProposalAttachment{
+			ProposalID:  p.ID + 1000,
+			FileName:    "orphan.pdf",
+			StoragePath: "proposals/orphan.pdf",
+		}, 2, 1000); err != errors.ErrNotFound {
+			t.Fatalf("proposals.CreateAttachment: expected ErrNotFound for a missing proposal, got %v", err)
+		}
+	})
+}
+
+type NewProposalQueueRepositories func(t *testing.T) (persistence.ProposalRepository, persistence.UserRepository)
+
+func TestProposalQueue(t *testing.T, newRepos NewProposalQueueRepositories) {
+	t.Helper()
+
+	setup := func(t *testing.T) persistence.ProposalRepository {
+		ctx := context.Background()
+		proposals, users := newRepos(t)
+
+		authors := make(map[string]int64)
+		for _, name := range []string{"Ada", "Grace"} {
+			u := domain.User{
+				Name:         name,
+				Email:        strings.ToLower(name) + "@example.com",
+				PasswordHash: make([]byte, 20),
+			}
+			if err := users.Create(ctx, &u); err != nil {
+				t.Fatalf("users.Create failed: %v", err)
+			}
+			authors[name] = u.ID
+		}
+
+		base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		for i, p := range []struct {
+			title  string
+			author string
+			status domain.ProposalStatus
+		}{
+			{"First", "Ada", domain.ProposalStatusSubmitted},
+			{"Second", "Grace", domain.ProposalStatusSubmitted},
+			{"Third", "Ada", domain.ProposalStatusApproved},
+			{"Fourth", "Grace", domain.ProposalStatusChangesRequested},
+			{"Draft", "Ada", domain.ProposalStatusDraft},
+		} {
+			proposal := domain.Proposal{
+				Title:    p.title,
+				AuthorID: authors[p.author],
+				Status:   p.status,
+			}
+			if p.status != domain.ProposalStatusDraft {
+				// Submission order is the reverse of creation order.
+				submittedAt := base.AddDate(0, 0, -i)
+				proposal.SubmittedAt = &submittedAt
+			}
+			if err := proposals.Create(ctx, &proposal); err != nil {
+				t.Fatalf("proposals.Create failed: %v", err)
+			}
+		}
+
+		return proposals
+	}
+
+	expectTitles := func(t *testing.T, list []domain.Proposal, want ...string) {
+		t.Helper()
+		got := make([]string, 0, len(list))
+		for _, p := range list {
+			got = append(got, p.Title)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("proposals.ListQueue: expected %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("proposals.ListQueue: expected %v, got %v", want, got)
+			}
+		}
+	}
+
+	t.Run("SortUpdatedPaginated", func(t *testing.T) {
+		ctx := context.Background()
+		proposals := setup(t)
+
+		list, next, err := proposals.ListQueue(ctx, &domain.ProposalFilter{
+			Sort:  domain.ProposalSortUpdated,
+			Limit: 3,
+		})
+		if err != nil {
+			t.Fatalf("proposals.ListQueue failed: %v", err)
+		}
+		expectTitles(t, list, "Fourth", "Third", "Second")
+		if next == nil {
+			t.Fatalf("proposals.ListQueue: expected next cursor")
+		}
+
+		list, next, err = proposals.ListQueue(ctx, &domain.ProposalFilter{
+			Sort:  domain.ProposalSortUpdated,
+			After: next,
+			Limit: 3,
+		})
+		if err != nil {
+			t.Fatalf("proposals.ListQueue failed: %v", err)
+		}
+		expectTitles(t, list, "First")
+		if next != nil {
+			t.Fatalf("proposals.ListQueue: expected no next cursor on last page")
+		}
+	})
+
+	t.Run("SortSubmittedAscendingPaginated", func(t *testing.T) {
+		ctx := context.Background()
+		proposals := setup(t)
+
+		list, next, err := proposals.ListQueue(ctx, &domain.ProposalFilter{
+			Sort:      domain.ProposalSortSubmitted,
+			Ascending: true,
+			Limit:     2,
+		})
+		if err != nil {
+			t.Fatalf("proposals.ListQueue failed: %v", err)
+		}
+		expectTitles(t, list, "Fourth", "Third")
+		if next == nil {
+			t.Fatalf("proposals.ListQueue: expected next cursor")
+		}
+
+		list, _, err = proposals.ListQueue(ctx, &domain.ProposalFilter{
+			Sort:      domain.ProposalSortSubmitted,
+			Ascending: true,
+			After:     next,
+			Limit:     2,
+		})
+		if err != nil {
+			t.Fatalf("proposals.ListQueue failed: %v", err)
+		}
+		expectTitles(t, list, "Second", "First")
+		if list[0].SubmittedAt == nil {
+			t.Fatalf("proposals.ListQueue: expected submitted_at to be set")
+		}
+	})
+
+	t.Run("FilterStatusAndAuthor", func(t *testing.T) {
+		ctx := context.Background()
+		proposals := setup(t)
+
+		list, _, err := proposals.ListQueue(ctx, &domain.ProposalFilter{
+			Statuses: []domain.ProposalStatus{domain.ProposalStatusSubmitted},
+			Sort:     domain.ProposalSortSubmitted,
+		})
+		if err != nil {
+			t.Fatalf("proposals.ListQueue failed: %v", err)
+		}
+		expectTitles(t, list, "First", "Second")
+
+		list, _, err = proposals.ListQueue(ctx, &domain.ProposalFilter{
+			Author: "GRACE@",
+			Sort:   domain.ProposalSortUpdated,
+		})
+		if err != nil {
+			t.Fatalf("proposals.ListQueue failed: %v", err)
+		}
+		expectTitles(t, list, "Fourth", "Second")
+
+		list, _, err = proposals.ListQueue(ctx, &domain.ProposalFilter{
+			Author: "a%",
+			Sort:   domain.ProposalSortUpdated,
+		})
+		if err != nil {
+			t.Fatalf("proposals.ListQueue failed: %v", err)
+		}
+		expectTitles(t, list)
+	})
+
+	t.Run("CountByStatus", func(t *testing.T) {
+		ctx := context.Background()
+		proposals := setup(t)
+
+		counts, err := proposals.CountByStatus(ctx, &domain.ProposalFilter{})
+		if err != nil {
+			t.Fatalf("proposals.CountByStatus failed: %v", err)
+		}
+		if counts[domain.ProposalStatusSubmitted] != 2 ||
+			counts[domain.ProposalStatusApproved] != 1 ||
+			counts[domain.ProposalStatusChangesRequested] != 1 ||
+			counts[domain.ProposalStatusDraft] != 0 {
+			t.Fatalf("proposals.CountByStatus: unexpected counts %v", counts)
+		}
+
+		counts, err = proposals.CountByStatus(ctx, &domain.ProposalFilter{
+			Author:   "ada",
+			Statuses: []domain.ProposalStatus{domain.ProposalStatusApproved},
+		})
+		if err != nil {
+			t.Fatalf("proposals.CountByStatus failed: %v", err)
+		}
+		if counts[domain.ProposalStatusSubmitted] != 1 || counts[domain.ProposalStatusApproved] != 1 {
+			t.Fatalf("proposals.CountByStatus: unexpected counts %v", counts)
+		}
+	})
 }
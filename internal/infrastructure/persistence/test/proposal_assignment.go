@@ -0,0 +1,210 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+type NewProposalAssignmentRepository func(t *testing.T) persistence.ProposalAssignmentRepository
+
+func TestProposalAssignmentRepository(t *testing.T, newAssignmentRepo NewProposalAssignmentRepository, newProposalRepo NewProposalRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.ProposalAssignmentRepository, *domain.User, *domain.User, *domain.Proposal) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		proposals := newProposalRepo(t)
+
+		author := domain.User{
+			Email:        "author@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &author); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		reviewer := domain.User{
+			Email:        "reviewer@example.com",
+			PasswordHash: make([]byte, 20),
+			Role:         domain.SystemRoleReviewer,
+		}
+		if err := users.Create(ctx, &reviewer); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		p := domain.Proposal{
+			Title:    "Test Proposal",
+			AuthorID: author.ID,
+			Status:   domain.ProposalStatusSubmitted,
+		}
+		if err := proposals.Create(ctx, &p); err != nil {
+			t.Fatalf("proposals.Create failed: %v", err)
+		}
+
+		return newAssignmentRepo(t), &author, &reviewer, &p
+	}
+
+	t.Run("AssignAndGet", func(t *testing.T) {
+		ctx := context.Background()
+		assignments, author, reviewer, p := setup(t)
+
+		a := domain.ProposalAssignment{
+			ProposalID: p.ID,
+			ReviewerID: reviewer.ID,
+			AssignedBy: &author.ID,
+		}
+		if err := assignments.AssignReviewer(ctx, &a); err != nil {
+			t.Fatalf("assignments.AssignReviewer failed: %v", err)
+		}
+		if a.AssignedAt.IsZero() || a.PendingSince.IsZero() {
+			t.Fatalf("assignments.AssignReviewer: timestamps not set")
+		}
+
+		dup := domain.ProposalAssignment{ProposalID: p.ID, ReviewerID: reviewer.ID}
+		if err := assignments.AssignReviewer(ctx, &dup); err != errors.ErrConflict {
+			t.Fatalf("assignments.AssignReviewer: expected ErrConflict for duplicate, got %v", err)
+		}
+
+		v, ok := assignments.GetAssignment(ctx, p.ID, reviewer.ID)
+		if !ok {
+			t.Fatalf("assignments.GetAssignment failed")
+		}
+		if v.HasVoted() || v.AssignedBy == nil || *v.AssignedBy != author.ID {
+			t.Fatalf("assignments.GetAssignment: assignments differ: %+v", v)
+		}
+
+		if _, ok := assignments.GetAssignment(ctx, p.ID, author.ID); ok {
+			t.Fatalf("assignments.GetAssignment: should return false for unassigned user")
+		}
+	})
+
+	t.Run("VotesAndRounds", func(t *testing.T) {
+		ctx := context.Background()
+		assignments, _, reviewer, p := setup(t)
+
+		a := domain.ProposalAssignment{ProposalID: p.ID, ReviewerID: reviewer.ID}
+		if err := assignments.AssignReviewer(ctx, &a); err != nil {
+			t.Fatalf("assignments.AssignReviewer failed: %v", err)
+		}
+
+		if err := assignments.RecordVote(ctx, p.ID, reviewer.ID, domain.ProposalVoteRequestChanges, "More detail", time.Now()); err != nil {
+			t.Fatalf("assignments.RecordVote failed: %v", err)
+		}
+		v, _ := assignments.GetAssignment(ctx, p.ID, reviewer.ID)
+		if v.Vote != domain.ProposalVoteRequestChanges || v.Notes != "More detail" || v.VotedAt == nil {
+			t.Fatalf("assignments.RecordVote: vote not persisted: %+v", v)
+		}
+
+		if err := assignments.RecordVote(ctx, p.ID, reviewer.ID+100, domain.ProposalVoteApprove, "", time.Now()); err != errors.ErrNotFound {
+			t.Fatalf("assignments.RecordVote: expected ErrNotFound for unassigned reviewer, got %v", err)
+		}
+
+		roundStart := time.Now().Add(time.Minute)
+		if err := assignments.StartRound(ctx, p.ID, roundStart); err != nil {
+			t.Fatalf("assignments.StartRound failed: %v", err)
+		}
+		v, _ = assignments.GetAssignment(ctx, p.ID, reviewer.ID)
+		if v.HasVoted() || v.Notes != "" || v.VotedAt != nil {
+			t.Fatalf("assignments.StartRound: vote not cleared: %+v", v)
+		}
+		if v.PendingSince.Sub(roundStart).Abs() > time.Millisecond {
+			t.Fatalf("assignments.StartRound: expected pending since %v, got %v", roundStart, v.PendingSince)
+		}
+	})
+
+	t.Run("ListAndRemove", func(t *testing.T) {
+		ctx := context.Background()
+		assignments, author, reviewer, p := setup(t)
+
+		for _, reviewerID := range []int64{reviewer.ID, author.ID} {
+			a := domain.ProposalAssignment{ProposalID: p.ID, ReviewerID: reviewerID}
+			if err := assignments.AssignReviewer(ctx, &a); err != nil {
+				t.Fatalf("assignments.AssignReviewer failed: %v", err)
+			}
+		}
+
+		list, err := assignments.ListAssignments(ctx, p.ID)
+		if err != nil {
+			t.Fatalf("assignments.ListAssignments failed: %v", err)
+		}
+		if len(list) != 2 || list[0].ReviewerID != reviewer.ID {
+			t.Fatalf("assignments.ListAssignments: expected 2 in assignment order, got %+v", list)
+		}
+
+		byReviewer, err := assignments.ListAssignmentsByReviewer(ctx, reviewer.ID)
+		if err != nil {
+			t.Fatalf("assignments.ListAssignmentsByReviewer failed: %v", err)
+		}
+		if len(byReviewer) != 1 || byReviewer[0].ProposalID != p.ID {
+			t.Fatalf("assignments.ListAssignmentsByReviewer: expected 1, got %+v", byReviewer)
+		}
+
+		last, err := assignments.LastAssignedAt(ctx)
+		if err != nil {
+			t.Fatalf("assignments.LastAssignedAt failed: %v", err)
+		}
+		if last[reviewer.ID].IsZero() || last[author.ID].IsZero() {
+			t.Fatalf("assignments.LastAssignedAt: missing reviewers: %v", last)
+		}
+
+		if err := assignments.RemoveAssignment(ctx, p.ID, author.ID); err != nil {
+			t.Fatalf("assignments.RemoveAssignment failed: %v", err)
+		}
+		if err := assignments.RemoveAssignment(ctx, p.ID, author.ID); err != errors.ErrNotFound {
+			t.Fatalf("assignments.RemoveAssignment: expected ErrNotFound, got %v", err)
+		}
+		list, _ = assignments.ListAssignments(ctx, p.ID)
+		if len(list) != 1 {
+			t.Fatalf("assignments.RemoveAssignment: expected 1 remaining, got %d", len(list))
+		}
+	})
+
+	t.Run("PendingAndReminders", func(t *testing.T) {
+		ctx := context.Background()
+		assignments, author, reviewer, p := setup(t)
+
+		for _, reviewerID := range []int64{reviewer.ID, author.ID} {
+			a := domain.ProposalAssignment{ProposalID: p.ID, ReviewerID: reviewerID}
+			if err := assignments.AssignReviewer(ctx, &a); err != nil {
+				t.Fatalf("assignments.AssignReviewer failed: %v", err)
+			}
+		}
+		if err := assignments.RecordVote(ctx, p.ID, author.ID, domain.ProposalVoteApprove, "", time.Now()); err != nil {
+			t.Fatalf("assignments.RecordVote failed: %v", err)
+		}
+
+		stale, err := assignments.ListPendingSince(ctx, time.Now().Add(-time.Hour))
+		if err != nil {
+			t.Fatalf("assignments.ListPendingSince failed: %v", err)
+		}
+		if len(stale) != 0 {
+			t.Fatalf("assignments.ListPendingSince: expected none before assignment, got %d", len(stale))
+		}
+
+		later := time.Now().Add(time.Hour)
+		stale, err = assignments.ListPendingSince(ctx, later)
+		if err != nil {
+			t.Fatalf("assignments.ListPendingSince failed: %v", err)
+		}
+		if len(stale) != 1 || stale[0].ReviewerID != reviewer.ID {
+			t.Fatalf("assignments.ListPendingSince: expected only the reviewer who has not voted, got %+v", stale)
+		}
+
+		if err := assignments.MarkReminded(ctx, p.ID, reviewer.ID, later); err != nil {
+			t.Fatalf("assignments.MarkReminded failed: %v", err)
+		}
+		stale, _ = assignments.ListPendingSince(ctx, later)
+		if len(stale) != 0 {
+			t.Fatalf("assignments.ListPendingSince: expected reminded assignment to wait, got %d", len(stale))
+		}
+		stale, _ = assignments.ListPendingSince(ctx, later.Add(time.Hour))
+		if len(stale) != 1 || stale[0].RemindedAt == nil {
+			t.Fatalf("assignments.ListPendingSince: expected assignment due again with reminder set, got %+v", stale)
+		}
+	})
+}
@@ -0,0 +1,341 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+type NewQuizRepository func(t *testing.T) persistence.QuizRepository
+type NewQuizAttemptRepository func(t *testing.T) persistence.QuizAttemptRepository
+
+func TestQuizRepository(t *testing.T, newQuizRepo NewQuizRepository, newModuleRepo NewModuleRepository, newCourseRepo NewCourseRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.QuizRepository, *domain.Module) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		courses := newCourseRepo(t)
+		modules := newModuleRepo(t)
+		quizzes := newQuizRepo(t)
+
+		u := domain.User{
+			Email:        "instructor@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		c := domain.Course{
+			Title:        "Test Course",
+			Summary:      "A test course",
+			InstructorID: u.ID,
+			Status:       domain.CourseStatusDraft,
+		}
+		if err := courses.Create(ctx, &c); err != nil {
+			t.Fatalf("courses.Create failed: %v", err)
+		}
+
+		m := domain.Module{
+			CourseID:    c.ID,
+			Title:       "Test Module",
+			Description: "A test module",
+			Order:       1,
+			Status:      domain.ModuleStatusDraft,
+		}
+		if err := modules.Create(ctx, &m); err != nil {
+			t.Fatalf("modules.Create failed: %v", err)
+		}
+
+		return quizzes, &m
+	}
+
+	newQuiz := func(moduleID int64, title string, order int) domain.Quiz {
+		return domain.Quiz{
+			BaseContentItem: domain.BaseContentItem{
+				ModuleID: moduleID,
+				Title:    title,
+				Order:    order,
+				Status:   domain.ContentStatusDraft,
+			},
+			Questions: []domain.QuizQuestion{
+				{
+					Prompt:         "What is 2 + 2?",
+					Type:           domain.QuestionTypeMultipleChoice,
+					Options:        []string{"3", "4", "5"},
+					CorrectOptions: []int{1},
+					Points:         1,
+				},
+				{
+					Prompt:          "Name the Go keyword for deferred calls.",
+					Type:            domain.QuestionTypeShortAnswer,
+					AcceptedAnswers: []string{"defer"},
+					Points:          2,
+				},
+			},
+			MaxAttempts:  3,
+			PassingScore: 70,
+		}
+	}
+
+	t.Run("CreateAndGetByID", func(t *testing.T) {
+		ctx := context.Background()
+		quizzes, m := setup(t)
+
+		q := newQuiz(m.ID, "Test Quiz", 1)
+		if err := quizzes.Create(ctx, &q); err != nil {
+			t.Fatalf("quizzes.Create failed: %v", err)
+		}
+		if q.ID == 0 {
+			t.Fatalf("quizzes.Create: ID not set")
+		}
+		if q.CreatedAt.IsZero() || q.UpdatedAt.IsZero() {
+			t.Fatalf("quizzes.Create: timestamps not set")
+		}
+
+		v, ok := quizzes.GetByID(ctx, q.ID)
+		if !ok {
+			t.Fatalf("quizzes.GetByID failed")
+		}
+		if v.Title != q.Title || v.ModuleID != q.ModuleID {
+			t.Fatalf("quizzes.GetByID: quizzes differ")
+		}
+		if len(v.Questions) != 2 {
+			t.Fatalf("quizzes.GetByID: expected 2 questions, got %d", len(v.Questions))
+		}
+		if v.Questions[0].CorrectOptions[0] != 1 || v.Questions[1].AcceptedAnswers[0] != "defer" {
+			t.Fatalf("quizzes.GetByID: questions not retrieved correctly")
+		}
+		if v.MaxAttempts != 3 || v.PassingScore != 70 {
+			t.Fatalf("quizzes.GetByID: settings not retrieved correctly")
+		}
+	})
+
+	t.Run("GetByIDNotFound", func(t *testing.T) {
+		ctx := context.Background()
+		quizzes := newQuizRepo(t)
+
+		_, ok := quizzes.GetByID(ctx, -1)
+		if ok {
+			t.Fatalf("quizzes.GetByID: should return false for non-existent quiz")
+		}
+	})
+
+	t.Run("ListByModuleID", func(t *testing.T) {
+		ctx := context.Background()
+		quizzes, m := setup(t)
+
+		q1 := newQuiz(m.ID, "Quiz 1", 2)
+		if err := quizzes.Create(ctx, &q1); err != nil {
+			t.Fatalf("quizzes.Create failed: %v", err)
+		}
+		q2 := newQuiz(m.ID, "Quiz 2", 1)
+		if err := quizzes.Create(ctx, &q2); err != nil {
+			t.Fatalf("quizzes.Create failed: %v", err)
+		}
+
+		list, err := quizzes.ListByModuleID(ctx, m.ID)
+		if err != nil {
+			t.Fatalf("quizzes.ListByModuleID failed: %v", err)
+		}
+		if len(list) != 2 {
+			t.Fatalf("quizzes.ListByModuleID: expected 2 quizzes, got %d", len(list))
+		}
+		if list[0].ID != q2.ID || list[1].ID != q1.ID {
+			t.Fatalf("quizzes.ListByModuleID: quizzes not in correct order")
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		ctx := context.Background()
+		quizzes, m := setup(t)
+
+		q := newQuiz(m.ID, "Original Title", 1)
+		if err := quizzes.Create(ctx, &q); err != nil {
+			t.Fatalf("quizzes.Create failed: %v", err)
+		}
+
+		v, ok := quizzes.GetByID(ctx, q.ID)
+		if !ok {
+			t.Fatalf("quizzes.GetByID failed")
+		}
+
+		v.Title = "Updated Title"
+		v.Status = domain.ContentStatusPublished
+		v.Questions = v.Questions[:1]
+		v.MaxAttempts = 0
+		if err := quizzes.Update(ctx, v); err != nil {
+			t.Fatalf("quizzes.Update failed: %v", err)
+		}
+
+		w, ok := quizzes.GetByID(ctx, q.ID)
+		if !ok {
+			t.Fatalf("quizzes.GetByID failed")
+		}
+		if w.Title != "Updated Title" || w.Status != domain.ContentStatusPublished {
+			t.Fatalf("quizzes.Update: fields not updated")
+		}
+		if len(w.Questions) != 1 {
+			t.Fatalf("quizzes.Update: questions not updated")
+		}
+		if w.MaxAttempts != 0 {
+			t.Fatalf("quizzes.Update: max attempts not updated")
+		}
+	})
+
+	t.Run("DeleteByID", func(t *testing.T) {
+		ctx := context.Background()
+		quizzes, m := setup(t)
+
+		q := newQuiz(m.ID, "Test Quiz", 1)
+		if err := quizzes.Create(ctx, &q); err != nil {
+			t.Fatalf("quizzes.Create failed: %v", err)
+		}
+
+		if err := quizzes.DeleteByID(ctx, q.ID); err != nil {
+			t.Fatalf("quizzes.DeleteByID failed: %v", err)
+		}
+
+		_, ok := quizzes.GetByID(ctx, q.ID)
+		if ok {
+			t.Fatalf("quizzes.DeleteByID: quiz still exists after deletion")
+		}
+	})
+}
+
+func TestQuizAttemptRepository(t *testing.T, newQuizAttemptRepo NewQuizAttemptRepository, newQuizRepo NewQuizRepository, newModuleRepo NewModuleRepository, newCourseRepo NewCourseRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	t.Run("CreateAndListByQuizAndUser", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		courses := newCourseRepo(t)
+		modules := newModuleRepo(t)
+		quizzes := newQuizRepo(t)
+		attempts := newQuizAttemptRepo(t)
+
+		instructor := domain.User{
+			Email:        "instructor@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &instructor); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		learner := domain.User{
+			Email:        "learner@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &learner); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		c := domain.Course{
+			Title:        "Test Course",
+			Summary:      "A test course",
+			InstructorID: instructor.ID,
+			Status:       domain.CourseStatusDraft,
+		}
+		if err := courses.Create(ctx, &c); err != nil {
+			t.Fatalf("courses.Create failed: %v", err)
+		}
+
+		m := domain.Module{
+			CourseID: c.ID,
+			Title:    "Test Module",
+			Order:    1,
+			Status:   domain.ModuleStatusDraft,
+		}
+		if err := modules.Create(ctx, &m); err != nil {
+			t.Fatalf("modules.Create failed: %v", err)
+		}
+
+		q := domain.Quiz{
+			BaseContentItem: domain.BaseContentItem{
+				ModuleID: m.ID,
+				Title:    "Test Quiz",
+				Order:    1,
+				Status:   domain.ContentStatusPublished,
+			},
+			Questions: []domain.QuizQuestion{
+				{
+					Prompt:          "Name the Go keyword for deferred calls.",
+					Type:            domain.QuestionTypeShortAnswer,
+					AcceptedAnswers: []string{"defer"},
+					Points:          1,
+				},
+			},
+		}
+		if err := quizzes.Create(ctx, &q); err != nil {
+			t.Fatalf("quizzes.Create failed: %v", err)
+		}
+
+		a1 := domain.QuizAttempt{
+			QuizID:   q.ID,
+			UserID:   learner.ID,
+			Answers:  []domain.QuizAnswer{{Text: "go"}},
+			Score:    0,
+			MaxScore: 1,
+		}
+		if err := attempts.Create(ctx, &a1, 2); err != nil {
+			t.Fatalf("attempts.Create failed: %v", err)
+		}
+		if a1.ID == 0 || a1.SubmittedAt.IsZero() {
+			t.Fatalf("attempts.Create: ID or SubmittedAt not set")
+		}
+
+		a2 := domain.QuizAttempt{
+			QuizID:   q.ID,
+			UserID:   learner.ID,
+			Answers:  []domain.QuizAnswer{{Text: "defer"}},
+			Score:    1,
+			MaxScore: 1,
+			Passed:   true,
+		}
+		if err := attempts.Create(ctx, &a2, 2); err != nil {
+			t.Fatalf("attempts.Create failed: %v", err)
+		}
+
+		if a1.Number != 1 || a2.Number != 2 {
+			t.Fatalf("attempts.Create: expected numbers 1 and 2, got %d and %d", a1.Number, a2.Number)
+		}
+
+		a3 := domain.QuizAttempt{
+			QuizID:   q.ID,
+			UserID:   learner.ID,
+			Answers:  []domain.QuizAnswer{{Text: "defer"}},
+			Score:    1,
+			MaxScore: 1,
+			Passed:   true,
+		}
+		if err := attempts.Create(ctx, &a3, 2); err != errors.ErrAttemptLimitReached {
+			t.Fatalf("attempts.Create: expected ErrAttemptLimitReached, got %v", err)
+		}
+
+		list, err := attempts.ListByQuizAndUser(ctx, q.ID, learner.ID)
+		if err != nil {
+			t.Fatalf("attempts.ListByQuizAndUser failed: %v", err)
+		}
+		if len(list) != 2 {
+			t.Fatalf("attempts.ListByQuizAndUser: expected 2 attempts, got %d", len(list))
+		}
+		if list[0].ID != a1.ID || list[1].ID != a2.ID || list[1].Number != 2 {
+			t.Fatalf("attempts.ListByQuizAndUser: attempts not in submission order")
+		}
+		if !list[1].Passed || list[1].Answers[0].Text != "defer" {
+			t.Fatalf("attempts.ListByQuizAndUser: attempt not retrieved correctly")
+		}
+
+		other, err := attempts.ListByQuizAndUser(ctx, q.ID, instructor.ID)
+		if err != nil {
+			t.Fatalf("attempts.ListByQuizAndUser failed: %v", err)
+		}
+		if len(other) != 0 {
+			t.Fatalf("attempts.ListByQuizAndUser: expected no attempts for other user, got %d", len(other))
+		}
+	})
+}
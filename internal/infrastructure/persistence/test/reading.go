@@ -398,6 +398,108 @@ func TestReadingRepository(t *testing.T, newReadingRepo NewReadingRepository, ne
 		}
 	})
 
+	t.Run("Revisions", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		courses := newCourseRepo(t)
+		modules := newModuleRepo(t)
+		readings := newReadingRepo(t)
+
+		u := domain.User{
+			Email:        "instructor@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		c := domain.Course{
+			Title:        "Test Course",
+			Summary:      "A test course",
+			InstructorID: u.ID,
+			Status:       domain.CourseStatusDraft,
+		}
+		if err := courses.Create(ctx, &c); err != nil {
+			t.Fatalf("courses.Create failed: %v", err)
+		}
+
+		m := domain.Module{
+			CourseID: c.ID,
+			Title:    "Test Module",
+			Order:    1,
+			Status:   domain.ModuleStatusDraft,
+		}
+		if err := modules.Create(ctx, &m); err != nil {
+			t.Fatalf("modules.Create failed: %v", err)
+		}
+
+		content := "# Test Reading"
+		r := domain.Reading{
+			BaseContentItem: domain.BaseContentItem{
+				ModuleID: m.ID,
+				Title:    "Test Reading",
+				Order:    1,
+				Status:   domain.ContentStatusDraft,
+			},
+			Format:  domain.ReadingFormatMarkdown,
+			Content: &content,
+		}
+		if err := readings.Create(ctx, &r); err != nil {
+			t.Fatalf("readings.Create failed: %v", err)
+		}
+
+		first := domain.ReadingRevision{
+			ReadingID: r.ID,
+			AuthorID:  u.ID,
+			Format:    domain.ReadingFormatMarkdown,
+			Content:   "# First",
+		}
+		if err := readings.CreateRevision(ctx, &first); err != nil {
+			t.Fatalf("readings.CreateRevision failed: %v", err)
+		}
+		if first.ID == 0 || first.Number != 1 || first.CreatedAt.IsZero() {
+			t.Fatalf("readings.CreateRevision: expected revision 1 with ID and CreatedAt set, got %+v", first)
+		}
+
+		restoredFrom := 1
+		second := domain.ReadingRevision{
+			ReadingID:    r.ID,
+			AuthorID:     u.ID,
+			Format:       domain.ReadingFormatPlain,
+			Content:      "Second",
+			RestoredFrom: &restoredFrom,
+		}
+		if err := readings.CreateRevision(ctx, &second); err != nil {
+			t.Fatalf("readings.CreateRevision failed: %v", err)
+		}
+		if second.Number != 2 {
+			t.Fatalf("readings.CreateRevision: expected number 2, got %d", second.Number)
+		}
+
+		v, ok := readings.GetRevision(ctx, r.ID, 2)
+		if !ok {
+			t.Fatalf("readings.GetRevision failed")
+		}
+		if v.Content != "Second" || v.Format != domain.ReadingFormatPlain || v.AuthorID != u.ID {
+			t.Fatalf("readings.GetRevision: revisions differ")
+		}
+		if v.RestoredFrom == nil || *v.RestoredFrom != 1 {
+			t.Fatalf("readings.GetRevision: restored_from not persisted")
+		}
+
+		if _, ok := readings.GetRevision(ctx, r.ID, 3); ok {
+			t.Fatalf("readings.GetRevision: should return false for non-existent revision")
+		}
+
+		list, err := readings.ListRevisions(ctx, r.ID)
+		if err != nil {
+			t.Fatalf("readings.ListRevisions failed: %v", err)
+		}
+		if len(list) != 2 || list[0].Number != 2 || list[1].Number != 1 {
+			t.Fatalf("readings.ListRevisions: expected revisions 2, 1, got %+v", list)
+		}
+	})
+
 	t.Run("DeleteByID", func(t *testing.T) {
 		ctx := context.Background()
 		users := newUserRepo(t)
@@ -0,0 +1,122 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+type NewRecoveryCodeRepository func(t *testing.T) persistence.RecoveryCodeRepository
+
+func TestRecoveryCodeRepository(t *testing.T, newRecoveryCodeRepo NewRecoveryCodeRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.RecoveryCodeRepository, *domain.User) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		codes := newRecoveryCodeRepo(t)
+
+		u := domain.User{
+			Email:        "user@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		return codes, &u
+	}
+
+	t.Run("ConsumeRecoveryCode", func(t *testing.T) {
+		ctx := context.Background()
+		codes, u := setup(t)
+
+		hashes := [][]byte{[]byte("code-hash-1"), []byte("code-hash-2")}
+		if err := codes.ReplaceRecoveryCodes(ctx, u.ID, hashes); err != nil {
+			t.Fatalf("codes.ReplaceRecoveryCodes failed: %v", err)
+		}
+
+		count, err := codes.CountRecoveryCodes(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("codes.CountRecoveryCodes failed: %v", err)
+		}
+		if count != 2 {
+			t.Fatalf("codes.CountRecoveryCodes: expected 2, got %d", count)
+		}
+
+		if !codes.ConsumeRecoveryCode(ctx, u.ID, hashes[0], time.Now()) {
+			t.Fatalf("codes.ConsumeRecoveryCode failed")
+		}
+		if codes.ConsumeRecoveryCode(ctx, u.ID, hashes[0], time.Now()) {
+			t.Fatalf("codes.ConsumeRecoveryCode: should return false when consuming same code twice")
+		}
+
+		count, err = codes.CountRecoveryCodes(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("codes.CountRecoveryCodes failed: %v", err)
+		}
+		if count != 1 {
+			t.Fatalf("codes.CountRecoveryCodes: expected 1, got %d", count)
+		}
+	})
+
+	t.Run("ConsumeRecoveryCodeWrongUser", func(t *testing.T) {
+		ctx := context.Background()
+		codes, u := setup(t)
+
+		hash := []byte("code-hash")
+		if err := codes.ReplaceRecoveryCodes(ctx, u.ID, [][]byte{hash}); err != nil {
+			t.Fatalf("codes.ReplaceRecoveryCodes failed: %v", err)
+		}
+
+		if codes.ConsumeRecoveryCode(ctx, u.ID+1, hash, time.Now()) {
+			t.Fatalf("codes.ConsumeRecoveryCode: should return false for another user")
+		}
+	})
+
+	t.Run("ReplaceRecoveryCodes", func(t *testing.T) {
+		ctx := context.Background()
+		codes, u := setup(t)
+
+		old := []byte("old-code-hash")
+		if err := codes.ReplaceRecoveryCodes(ctx, u.ID, [][]byte{old}); err != nil {
+			t.Fatalf("codes.ReplaceRecoveryCodes failed: %v", err)
+		}
+
+		replacement := []byte("new-code-hash")
+		if err := codes.ReplaceRecoveryCodes(ctx, u.ID, [][]byte{replacement}); err != nil {
+			t.Fatalf("codes.ReplaceRecoveryCodes failed: %v", err)
+		}
+
+		if codes.ConsumeRecoveryCode(ctx, u.ID, old, time.Now()) {
+			t.Fatalf("codes.ConsumeRecoveryCode: replaced code should no longer be valid")
+		}
+		if !codes.ConsumeRecoveryCode(ctx, u.ID, replacement, time.Now()) {
+			t.Fatalf("codes.ConsumeRecoveryCode failed for new code")
+		}
+	})
+
+	t.Run("DeleteRecoveryCodes", func(t *testing.T) {
+		ctx := context.Background()
+		codes, u := setup(t)
+
+		hash := []byte("code-hash")
+		if err := codes.ReplaceRecoveryCodes(ctx, u.ID, [][]byte{hash}); err != nil {
+			t.Fatalf("codes.ReplaceRecoveryCodes failed: %v", err)
+		}
+		if err := codes.DeleteRecoveryCodes(ctx, u.ID); err != nil {
+			t.Fatalf("codes.DeleteRecoveryCodes failed: %v", err)
+		}
+
+		count, err := codes.CountRecoveryCodes(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("codes.CountRecoveryCodes failed: %v", err)
+		}
+		if count != 0 {
+			t.Fatalf("codes.CountRecoveryCodes: expected 0, got %d", count)
+		}
+	})
+}
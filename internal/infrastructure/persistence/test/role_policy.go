@@ -0,0 +1,65 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+type NewRolePolicyRepository func(t *testing.T) persistence.RolePolicyRepository
+
+func TestRolePolicyRepository(t *testing.T, newRolePolicyRepo NewRolePolicyRepository) {
+	t.Helper()
+
+	t.Run("GetRolePolicyNotFound", func(t *testing.T) {
+		ctx := context.Background()
+		policies := newRolePolicyRepo(t)
+
+		if _, ok := policies.GetRolePolicy(ctx, domain.SystemRoleAdmin); ok {
+			t.Fatalf("policies.GetRolePolicy: expected no policy")
+		}
+	})
+
+	t.Run("SaveRolePolicy", func(t *testing.T) {
+		ctx := context.Background()
+		policies := newRolePolicyRepo(t)
+
+		p := domain.RolePolicy{
+			Role:             domain.SystemRoleAdmin,
+			RequireTwoFactor: true,
+		}
+		if err := policies.SaveRolePolicy(ctx, &p); err != nil {
+			t.Fatalf("policies.SaveRolePolicy failed: %v", err)
+		}
+		if p.UpdatedAt.IsZero() {
+			t.Fatalf("policies.SaveRolePolicy: expected UpdatedAt to be set")
+		}
+
+		q, ok := policies.GetRolePolicy(ctx, domain.SystemRoleAdmin)
+		if !ok {
+			t.Fatalf("policies.GetRolePolicy failed")
+		}
+		if !q.RequireTwoFactor {
+			t.Fatalf("policies.GetRolePolicy: expected two-factor requirement")
+		}
+
+		p.RequireTwoFactor = false
+		if err := policies.SaveRolePolicy(ctx, &p); err != nil {
+			t.Fatalf("policies.SaveRolePolicy failed: %v", err)
+		}
+
+		q, ok = policies.GetRolePolicy(ctx, domain.SystemRoleAdmin)
+		if !ok {
+			t.Fatalf("policies.GetRolePolicy failed")
+		}
+		if q.RequireTwoFactor {
+			t.Fatalf("policies.SaveRolePolicy: policy not updated")
+		}
+
+		if _, ok := policies.GetRolePolicy(ctx, domain.SystemRoleUser); ok {
+			t.Fatalf("policies.GetRolePolicy: policies should be per role")
+		}
+	})
+}
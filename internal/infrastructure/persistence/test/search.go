@@ -0,0 +1,164 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+type NewSearchRepository func(t *testing.T, courses persistence.CourseRepository, modules persistence.ModuleRepository, readings persistence.ReadingRepository) persistence.SearchRepository
+
+func TestSearchRepository(t *testing.T, newSearchRepo NewSearchRepository, newReadingRepo NewReadingRepository, newModuleRepo NewModuleRepository, newCourseRepo NewCourseRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.SearchRepository, *domain.Course, *domain.Module, *domain.Reading) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		courses := newCourseRepo(t)
+		modules := newModuleRepo(t)
+		readings := newReadingRepo(t)
+
+		u := domain.User{
+			Email:        "instructor@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		c := domain.Course{
+			Title:              "Systems Programming",
+			Summary:            "Learn how memory works",
+			LearningObjectives: "Understand pointers",
+			InstructorID:       u.ID,
+			Status:             domain.CourseStatusPublished,
+		}
+		if err := courses.Create(ctx, &c); err != nil {
+			t.Fatalf("courses.Create failed: %v", err)
+		}
+
+		draft := domain.Course{
+			Title:        "Draft Pointers Course",
+			Summary:      "Not yet live",
+			InstructorID: u.ID,
+			Status:       domain.CourseStatusDraft,
+		}
+		if err := courses.Create(ctx, &draft); err != nil {
+			t.Fatalf("courses.Create failed: %v", err)
+		}
+
+		m := domain.Module{
+			CourseID:    c.ID,
+			Title:       "Memory",
+			Description: "Stack and heap allocation",
+			Order:       1,
+			Status:      domain.ModuleStatusPublished,
+		}
+		if err := modules.Create(ctx, &m); err != nil {
+			t.Fatalf("modules.Create failed: %v", err)
+		}
+
+		content := "A pointer stores the address of another value on the heap."
+		r := domain.Reading{
+			BaseContentItem: domain.BaseContentItem{
+				ModuleID: m.ID,
+				Title:    "Pointer Basics",
+				Order:    1,
+				Status:   domain.ContentStatusPublished,
+			},
+			Format:  domain.ReadingFormatMarkdown,
+			Content: &content,
+		}
+		if err := readings.Create(ctx, &r); err != nil {
+			t.Fatalf("readings.Create failed: %v", err)
+		}
+
+		hidden := "Pointer arithmetic is covered later."
+		d := domain.Reading{
+			BaseContentItem: domain.BaseContentItem{
+				ModuleID: m.ID,
+				Title:    "Unreleased",
+				Order:    2,
+				Status:   domain.ContentStatusDraft,
+			},
+			Format:  domain.ReadingFormatMarkdown,
+			Content: &hidden,
+		}
+		if err := readings.Create(ctx, &d); err != nil {
+			t.Fatalf("readings.Create failed: %v", err)
+		}
+
+		return newSearchRepo(t, courses, modules, readings), &c, &m, &r
+	}
+
+	t.Run("Search", func(t *testing.T) {
+		ctx := context.Background()
+		search, c, _, r := setup(t)
+
+		results, err := search.Search(ctx, "pointers", 10)
+		if err != nil {
+			t.Fatalf("search.Search failed: %v", err)
+		}
+		if len(results) != 2 {
+			t.Fatalf("search.Search: expected 2 results, got %d", len(results))
+		}
+
+		found := make(map[domain.SearchResultType]domain.SearchResult)
+		for _, result := range results {
+			found[result.Type] = result
+		}
+		if found[domain.SearchResultCourse].ID != c.ID {
+			t.Fatalf("search.Search: expected course %d in results", c.ID)
+		}
+		if found[domain.SearchResultReading].ID != r.ID || found[domain.SearchResultReading].CourseID != c.ID {
+			t.Fatalf("search.Search: expected reading %d in results", r.ID)
+		}
+		if results[0].Type != domain.SearchResultReading {
+			t.Fatalf("search.Search: expected title match to rank first, got %s", results[0].Type)
+		}
+	})
+
+	t.Run("SearchModuleDescription", func(t *testing.T) {
+		ctx := context.Background()
+		search, c, m, _ := setup(t)
+
+		results, err := search.Search(ctx, "allocation", 10)
+		if err != nil {
+			t.Fatalf("search.Search failed: %v", err)
+		}
+		if len(results) != 1 {
+			t.Fatalf("search.Search: expected 1 result, got %d", len(results))
+		}
+		if results[0].Type != domain.SearchResultModule || results[0].ID != m.ID || results[0].CourseTitle != c.Title {
+			t.Fatalf("search.Search: expected module %d, got %+v", m.ID, results[0])
+		}
+	})
+
+	t.Run("SearchAllTerms", func(t *testing.T) {
+		ctx := context.Background()
+		search, _, _, _ := setup(t)
+
+		results, err := search.Search(ctx, "pointer heap", 10)
+		if err != nil {
+			t.Fatalf("search.Search failed: %v", err)
+		}
+		if len(results) != 1 || results[0].Type != domain.SearchResultReading {
+			t.Fatalf("search.Search: expected only the reading to match all terms, got %+v", results)
+		}
+	})
+
+	t.Run("SearchNoResults", func(t *testing.T) {
+		ctx := context.Background()
+		search, _, _, _ := setup(t)
+
+		results, err := search.Search(ctx, "arithmetic", 10)
+		if err != nil {
+			t.Fatalf("search.Search failed: %v", err)
+		}
+		if len(results) != 0 {
+			t.Fatalf("search.Search: expected no results for unpublished content, got %d", len(results))
+		}
+	})
+}
@@ -0,0 +1,271 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/auth"
+)
+
+type NewSessionStore func(t *testing.T, ttl time.Duration) auth.SessionStore
+
+func TestSessionStore(t *testing.T, newSessionStore NewSessionStore, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T, ttl time.Duration) (auth.SessionStore, int64, int64) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+
+		u1 := domain.User{
+			Email:        "first@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u1); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+		u2 := domain.User{
+			Email:        "second@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u2); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		return newSessionStore(t, ttl), u1.ID, u2.ID
+	}
+
+	t.Run("CreateAndGet", func(t *testing.T) {
+		sessions, userID, _ := setup(t, time.Hour)
+
+		sessionID, err := sessions.Create(userID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		if sessionID == "" {
+			t.Fatalf("sessions.Create: session ID is empty")
+		}
+
+		got, ok := sessions.Get(sessionID)
+		if !ok {
+			t.Fatalf("sessions.Get: session not found")
+		}
+		if got != userID {
+			t.Fatalf("sessions.Get: expected user ID %d, got %d", userID, got)
+		}
+	})
+
+	t.Run("GetNonExistent", func(t *testing.T) {
+		sessions, _, _ := setup(t, time.Hour)
+
+		if _, ok := sessions.Get("nonexistent"); ok {
+			t.Fatalf("sessions.Get: expected false for non-existent session ID")
+		}
+	})
+
+	t.Run("GetExpired", func(t *testing.T) {
+		sessions, userID, _ := setup(t, -time.Minute)
+
+		sessionID, err := sessions.Create(userID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		if _, ok := sessions.Get(sessionID); ok {
+			t.Fatalf("sessions.Get: expected false for expired session")
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		sessions, userID, _ := setup(t, time.Hour)
+
+		sessionID, err := sessions.Create(userID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		if err := sessions.Delete(sessionID); err != nil {
+			t.Fatalf("sessions.Delete failed: %v", err)
+		}
+		if _, ok := sessions.Get(sessionID); ok {
+			t.Fatalf("sessions.Get: session should not exist after Delete")
+		}
+	})
+
+	t.Run("DeleteByUserID", func(t *testing.T) {
+		sessions, userID, otherID := setup(t, time.Hour)
+
+		first, err := sessions.Create(userID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		second, err := sessions.Create(userID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		other, err := sessions.Create(otherID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+
+		if err := sessions.DeleteByUserID(userID); err != nil {
+			t.Fatalf("sessions.DeleteByUserID failed: %v", err)
+		}
+		if _, ok := sessions.Get(first); ok {
+			t.Fatalf("sessions.Get: first session should be deleted")
+		}
+		if _, ok := sessions.Get(second); ok {
+			t.Fatalf("sessions.Get: second session should be deleted")
+		}
+		if _, ok := sessions.Get(other); !ok {
+			t.Fatalf("sessions.Get: other user's session should remain")
+		}
+	})
+
+	t.Run("CSRFToken", func(t *testing.T) {
+		sessions, userID, _ := setup(t, time.Hour)
+
+		sessionID, err := sessions.Create(userID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		if _, ok := sessions.GetCSRFToken(sessionID); ok {
+			t.Fatalf("sessions.GetCSRFToken: expected no token for new session")
+		}
+
+		if err := sessions.SetCSRFToken(sessionID, "csrf-token"); err != nil {
+			t.Fatalf("sessions.SetCSRFToken failed: %v", err)
+		}
+		token, ok := sessions.GetCSRFToken(sessionID)
+		if !ok || token != "csrf-token" {
+			t.Fatalf("sessions.GetCSRFToken: expected %q, got %q", "csrf-token", token)
+		}
+
+		if err := sessions.DeleteCSRFToken(sessionID); err != nil {
+			t.Fatalf("sessions.DeleteCSRFToken failed: %v", err)
+		}
+		if _, ok := sessions.GetCSRFToken(sessionID); ok {
+			t.Fatalf("sessions.GetCSRFToken: token should be deleted")
+		}
+		if _, ok := sessions.Get(sessionID); !ok {
+			t.Fatalf("sessions.Get: session should remain after DeleteCSRFToken")
+		}
+	})
+
+	t.Run("ListByUserID", func(t *testing.T) {
+		sessions, userID, otherID := setup(t, time.Hour)
+
+		sessionID, err := sessions.Create(userID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		if _, err := sessions.Create(otherID); err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		if err := sessions.SetClientInfo(sessionID, "203.0.113.7", "Firefox"); err != nil {
+			t.Fatalf("sessions.SetClientInfo failed: %v", err)
+		}
+
+		list, err := sessions.ListByUserID(userID)
+		if err != nil {
+			t.Fatalf("sessions.ListByUserID failed: %v", err)
+		}
+		if len(list) != 1 {
+			t.Fatalf("sessions.ListByUserID: expected 1 session, got %d", len(list))
+		}
+
+		got := list[0]
+		if got.ID != auth.SessionPublicID(sessionID) {
+			t.Fatalf("sessions.ListByUserID: expected public ID, got %q", got.ID)
+		}
+		if got.IP != "203.0.113.7" || got.UserAgent != "Firefox" {
+			t.Fatalf("sessions.ListByUserID: unexpected client info %+v", got)
+		}
+		if got.CreatedAt.IsZero() || got.LastSeenAt.IsZero() || !got.ExpiresAt.After(got.CreatedAt) {
+			t.Fatalf("sessions.ListByUserID: unexpected timestamps %+v", got)
+		}
+	})
+
+	t.Run("Touch", func(t *testing.T) {
+		sessions, userID, _ := setup(t, time.Hour)
+
+		sessionID, err := sessions.Create(userID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		list, err := sessions.ListByUserID(userID)
+		if err != nil {
+			t.Fatalf("sessions.ListByUserID failed: %v", err)
+		}
+		created := list[0].LastSeenAt
+
+		if err := sessions.Touch(sessionID, created.Add(time.Second)); err != nil {
+			t.Fatalf("sessions.Touch failed: %v", err)
+		}
+		list, _ = sessions.ListByUserID(userID)
+		if !list[0].LastSeenAt.Equal(created) {
+			t.Fatalf("sessions.Touch: expected touch within interval to be skipped")
+		}
+
+		later := created.Add(10 * time.Minute)
+		if err := sessions.Touch(sessionID, later); err != nil {
+			t.Fatalf("sessions.Touch failed: %v", err)
+		}
+		list, _ = sessions.ListByUserID(userID)
+		if list[0].LastSeenAt.Sub(later).Abs() > time.Millisecond {
+			t.Fatalf("sessions.Touch: expected LastSeenAt %v, got %v", later, list[0].LastSeenAt)
+		}
+	})
+
+	t.Run("DeleteByPublicID", func(t *testing.T) {
+		sessions, userID, otherID := setup(t, time.Hour)
+
+		sessionID, err := sessions.Create(userID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		publicID := auth.SessionPublicID(sessionID)
+
+		if err := sessions.DeleteByPublicID(otherID, publicID); err == nil {
+			t.Fatalf("sessions.DeleteByPublicID: expected error for another user's session")
+		}
+		if err := sessions.DeleteByPublicID(userID, publicID); err != nil {
+			t.Fatalf("sessions.DeleteByPublicID failed: %v", err)
+		}
+		if _, ok := sessions.Get(sessionID); ok {
+			t.Fatalf("sessions.Get: session should not exist after DeleteByPublicID")
+		}
+		if err := sessions.DeleteByPublicID(userID, publicID); err == nil {
+			t.Fatalf("sessions.DeleteByPublicID: expected error for deleted session")
+		}
+	})
+
+	t.Run("DeleteOthersByUserID", func(t *testing.T) {
+		sessions, userID, otherID := setup(t, time.Hour)
+
+		current, err := sessions.Create(userID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		stale, err := sessions.Create(userID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+		other, err := sessions.Create(otherID)
+		if err != nil {
+			t.Fatalf("sessions.Create failed: %v", err)
+		}
+
+		if err := sessions.DeleteOthersByUserID(userID, current); err != nil {
+			t.Fatalf("sessions.DeleteOthersByUserID failed: %v", err)
+		}
+		if _, ok := sessions.Get(current); !ok {
+			t.Fatalf("sessions.Get: current session should remain")
+		}
+		if _, ok := sessions.Get(stale); ok {
+			t.Fatalf("sessions.Get: other session should be deleted")
+		}
+		if _, ok := sessions.Get(other); !ok {
+			t.Fatalf("sessions.Get: other user's session should remain")
+		}
+	})
+}
@@ -3,6 +3,7 @@ package test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/persistence"
@@ -312,4 +313,201 @@ func TestUserRepository(t *testing.T, newUserRepo NewUserRepository) {
 			t.Fatalf("users.Update: role not updated")
 		}
 	})
+
+	t.Run("UpdateTwoFactor", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+
+		u := domain.User{
+			Email:        "user@example.com",
+			PasswordHash: make([]byte, 20),
+			Role:         domain.SystemRoleUser,
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		v, ok := users.GetByID(ctx, u.ID)
+		if !ok {
+			t.Fatalf("users.GetByID failed")
+		}
+		if v.TOTPEnabled || v.TOTPSecret != "" {
+			t.Fatalf("users.Create: expected two-factor authentication to be disabled")
+		}
+
+		v.TOTPSecret = "JBSWY3DPEHPK3PXP"
+		v.TOTPEnabled = true
+		v.TOTPLastStep = 12345
+		if err := users.Update(ctx, v); err != nil {
+			t.Fatalf("users.Update failed: %v", err)
+		}
+
+		w, ok := users.GetByEmail(ctx, u.Email)
+		if !ok {
+			t.Fatalf("users.GetByEmail failed")
+		}
+		if w.TOTPSecret != v.TOTPSecret || !w.TOTPEnabled || w.TOTPLastStep != v.TOTPLastStep {
+			t.Fatalf("users.Update: two-factor fields not updated, got %+v", w)
+		}
+	})
+
+	t.Run("UpdateVerifiedAt", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+
+		u := domain.User{
+			Email:        "user@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		v, ok := users.GetByID(ctx, u.ID)
+		if !ok {
+			t.Fatalf("users.GetByID failed")
+		}
+		if v.IsVerified() {
+			t.Fatalf("users.Create: expected new user to be unverified")
+		}
+
+		now := time.Now()
+		v.VerifiedAt = &now
+		if err := users.Update(ctx, v); err != nil {
+			t.Fatalf("users.Update failed: %v", err)
+		}
+
+		w, ok := users.GetByID(ctx, u.ID)
+		if !ok {
+			t.Fatalf("users.GetByID failed")
+		}
+		if !w.IsVerified() {
+			t.Fatalf("users.Update: verified_at not updated")
+		}
+	})
+
+	t.Run("UpdateSuspendedAt", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+
+		u := domain.User{
+			Email:        "user@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		v, ok := users.GetByID(ctx, u.ID)
+		if !ok {
+			t.Fatalf("users.GetByID failed")
+		}
+		if v.IsSuspended() {
+			t.Fatalf("users.Create: expected new user not to be suspended")
+		}
+
+		now := time.Now()
+		v.SuspendedAt = &now
+		if err := users.Update(ctx, v); err != nil {
+			t.Fatalf("users.Update failed: %v", err)
+		}
+
+		w, ok := users.GetByID(ctx, u.ID)
+		if !ok {
+			t.Fatalf("users.GetByID failed")
+		}
+		if !w.IsSuspended() {
+			t.Fatalf("users.Update: suspended_at not updated")
+		}
+
+		w.SuspendedAt = nil
+		if err := users.Update(ctx, w); err != nil {
+			t.Fatalf("users.Update failed: %v", err)
+		}
+
+		x, ok := users.GetByID(ctx, u.ID)
+		if !ok {
+			t.Fatalf("users.GetByID failed")
+		}
+		if x.IsSuspended() {
+			t.Fatalf("users.Update: suspended_at not cleared")
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+
+		seed := []domain.User{
+			{Email: "ann@example.com", Name: "Ann Lee", Role: domain.SystemRoleUser},
+			{Email: "bob@example.com", Name: "Bob Stone", Role: domain.SystemRoleAdmin},
+			{Email: "cat@example.org", Name: "Cat Annan", Role: domain.SystemRoleReviewer},
+			{Email: "dan@example.org", Name: "Dan Moe", Role: domain.SystemRoleUser},
+		}
+		for i := range seed {
+			seed[i].PasswordHash = make([]byte, 20)
+			if err := users.Create(ctx, &seed[i]); err != nil {
+				t.Fatalf("users.Create failed: %v", err)
+			}
+		}
+
+		list, next, err := users.List(ctx, &domain.UserFilter{})
+		if err != nil {
+			t.Fatalf("users.List failed: %v", err)
+		}
+		if len(list) != 4 || next != nil {
+			t.Fatalf("users.List: expected 4 users and no cursor, got %d, %v", len(list), next)
+		}
+		if list[0].ID != seed[3].ID || list[3].ID != seed[0].ID {
+			t.Fatalf("users.List: expected newest first, got %d..%d", list[0].ID, list[3].ID)
+		}
+
+		list, _, err = users.List(ctx, &domain.UserFilter{Search: "ANN"})
+		if err != nil {
+			t.Fatalf("users.List failed: %v", err)
+		}
+		if len(list) != 2 || list[0].ID != seed[2].ID || list[1].ID != seed[0].ID {
+			t.Fatalf("users.List: search by name or email returned %v", list)
+		}
+
+		list, _, err = users.List(ctx, &domain.UserFilter{Search: "example.org"})
+		if err != nil {
+			t.Fatalf("users.List failed: %v", err)
+		}
+		if len(list) != 2 {
+			t.Fatalf("users.List: search by email domain returned %d users", len(list))
+		}
+
+		list, _, err = users.List(ctx, &domain.UserFilter{Search: "%"})
+		if err != nil {
+			t.Fatalf("users.List failed: %v", err)
+		}
+		if len(list) != 0 {
+			t.Fatalf("users.List: wildcard search should match literally, got %d users", len(list))
+		}
+
+		list, _, err = users.List(ctx, &domain.UserFilter{Role: domain.SystemRoleUser})
+		if err != nil {
+			t.Fatalf("users.List failed: %v", err)
+		}
+		if len(list) != 2 || list[0].ID != seed[3].ID || list[1].ID != seed[0].ID {
+			t.Fatalf("users.List: role filter returned %v", list)
+		}
+
+		page, next, err := users.List(ctx, &domain.UserFilter{Limit: 3})
+		if err != nil {
+			t.Fatalf("users.List failed: %v", err)
+		}
+		if len(page) != 3 || next == nil || next.ID != page[2].ID {
+			t.Fatalf("users.List: expected 3 users and a cursor, got %d, %v", len(page), next)
+		}
+
+		rest, next, err := users.List(ctx, &domain.UserFilter{Limit: 3, After: next})
+		if err != nil {
+			t.Fatalf("users.List failed: %v", err)
+		}
+		if len(rest) != 1 || next != nil || rest[0].ID != seed[0].ID {
+			t.Fatalf("users.List: expected the last user and no cursor, got %v, %v", rest, next)
+		}
+	})
 }
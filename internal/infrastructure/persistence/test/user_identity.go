@@ -0,0 +1,82 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+)
+
+type NewUserIdentityRepository func(t *testing.T) persistence.UserIdentityRepository
+
+func TestUserIdentityRepository(t *testing.T, newIdentityRepo NewUserIdentityRepository, newUserRepo NewUserRepository) {
+	t.Helper()
+
+	setup := func(t *testing.T) (persistence.UserIdentityRepository, *domain.User) {
+		ctx := context.Background()
+		users := newUserRepo(t)
+		identities := newIdentityRepo(t)
+
+		u := domain.User{
+			Email:        "user@example.com",
+			PasswordHash: make([]byte, 20),
+		}
+		if err := users.Create(ctx, &u); err != nil {
+			t.Fatalf("users.Create failed: %v", err)
+		}
+
+		return identities, &u
+	}
+
+	t.Run("CreateAndGet", func(t *testing.T) {
+		ctx := context.Background()
+		identities, u := setup(t)
+
+		identity := domain.UserIdentity{
+			UserID:  u.ID,
+			Issuer:  "https://idp.example.edu",
+			Subject: "abc123",
+			Email:   "user@example.com",
+		}
+		if err := identities.CreateIdentity(ctx, &identity); err != nil {
+			t.Fatalf("identities.CreateIdentity failed: %v", err)
+		}
+		if identity.ID == 0 {
+			t.Fatalf("identities.CreateIdentity: expected ID to be set")
+		}
+		if identity.CreatedAt.IsZero() {
+			t.Fatalf("identities.CreateIdentity: expected CreatedAt to be set")
+		}
+
+		got, ok := identities.GetIdentity(ctx, "https://idp.example.edu", "abc123")
+		if !ok {
+			t.Fatalf("identities.GetIdentity failed")
+		}
+		if got.ID != identity.ID || got.UserID != u.ID || got.Email != "user@example.com" {
+			t.Fatalf("identities.GetIdentity: unexpected identity %+v", got)
+		}
+
+		if _, ok := identities.GetIdentity(ctx, "https://other.example.edu", "abc123"); ok {
+			t.Fatalf("identities.GetIdentity: expected no identity for another issuer")
+		}
+		if _, ok := identities.GetIdentity(ctx, "https://idp.example.edu", "other"); ok {
+			t.Fatalf("identities.GetIdentity: expected no identity for another subject")
+		}
+	})
+
+	t.Run("CreateDuplicate", func(t *testing.T) {
+		ctx := context.Background()
+		identities, u := setup(t)
+
+		first := domain.UserIdentity{UserID: u.ID, Issuer: "https://idp.example.edu", Subject: "abc123"}
+		if err := identities.CreateIdentity(ctx, &first); err != nil {
+			t.Fatalf("identities.CreateIdentity failed: %v", err)
+		}
+
+		second := domain.UserIdentity{UserID: u.ID, Issuer: "https://idp.example.edu", Subject: "abc123"}
+		if err := identities.CreateIdentity(ctx, &second); err == nil {
+			t.Fatalf("identities.CreateIdentity: expected error for duplicate issuer and subject")
+		}
+	})
+}
@@ -0,0 +1,264 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+const contextLines = 3
+
+type opKind int
+
+const (
+	opEqual opKind = iota
+	opDelete
+	opInsert
+)
+
+type op struct {
+	kind opKind
+	a    int
+	b    int
+	text string
+}
+
+// Unified returns a unified diff of two texts compared line by line, or an
+// empty string if they are identical.
+func Unified(fromName, toName, from, to string) string {
+	ops := lineOps(splitLines(from), splitLines(to))
+
+	var buf strings.Builder
+	for i := 0; i < len(ops); {
+		if ops[i].kind == opEqual {
+			i++
+			continue
+		}
+
+		if buf.Len() == 0 {
+			fmt.Fprintf(&buf, "--- %s\n+++ %s\n", fromName, toName)
+		}
+
+		start := max(i-contextLines, 0)
+		last := i
+		for j := i; j < len(ops); j++ {
+			if ops[j].kind != opEqual {
+				last = j
+			} else if j-last > 2*contextLines {
+				break
+			}
+		}
+		end := min(last+contextLines+1, len(ops))
+
+		writeHunk(&buf, ops[start:end])
+		i = end
+	}
+
+	return buf.String()
+}
+
+func writeHunk(buf *strings.Builder, ops []op) {
+	var aCount, bCount int
+	for _, o := range ops {
+		if o.kind != opInsert {
+			aCount++
+		}
+		if o.kind != opDelete {
+			bCount++
+		}
+	}
+
+	fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(ops[0].a, aCount), hunkRange(ops[0].b, bCount))
+	for _, o := range ops {
+		switch o.kind {
+		case opEqual:
+			buf.WriteString(" ")
+		case opDelete:
+			buf.WriteString("-")
+		case opInsert:
+			buf.WriteString("+")
+		}
+		buf.WriteString(o.text)
+		buf.WriteString("\n")
+	}
+}
+
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+// maxLines bounds the combined input size that is diffed line by line. Larger
+// inputs are reported as a full replacement rather than searched for a minimal
+// edit script.
+const maxLines = 20000
+
+// lineOps computes a shortest edit script between a and b using the
+// linear-space variant of Myers' algorithm and returns it in order.
+func lineOps(a, b []string) []op {
+	n, m := len(a), len(b)
+	if n+m > maxLines {
+		ops := make([]op, 0, n+m)
+		for i := range a {
+			ops = append(ops, op{kind: opDelete, a: i, b: 0, text: a[i]})
+		}
+		for j := range b {
+			ops = append(ops, op{kind: opInsert, a: n, b: j, text: b[j]})
+		}
+		return ops
+	}
+
+	size := 2*(n+m) + 3
+	s := &myers{
+		a:  a,
+		b:  b,
+		vf: make([]int, size),
+		vb: make([]int, size),
+	}
+	s.compare(0, n, 0, m)
+	return deletesFirst(s.ops)
+}
+
+// deletesFirst reorders each run of changes so that its deletions precede its
+// insertions, as in conventional unified diffs.
+func deletesFirst(ops []op) []op {
+	for i := 0; i < len(ops); {
+		if ops[i].kind == opEqual {
+			i++
+			continue
+		}
+
+		start := i
+		a, b := ops[i].a, ops[i].b
+		var deletes, inserts []op
+		for ; i < len(ops) && ops[i].kind != opEqual; i++ {
+			if ops[i].kind == opDelete {
+				deletes = append(deletes, ops[i])
+			} else {
+				inserts = append(inserts, ops[i])
+			}
+		}
+
+		j := start
+		for _, o := range deletes {
+			o.b = b
+			ops[j] = o
+			j++
+		}
+		for _, o := range inserts {
+			o.a = a + len(deletes)
+			ops[j] = o
+			j++
+		}
+	}
+	return ops
+}
+
+type myers struct {
+	a, b   []string
+	vf, vb []int
+	ops    []op
+}
+
+// compare appends the edit script turning a[aLo:aHi] into b[bLo:bHi].
+func (s *myers) compare(aLo, aHi, bLo, bHi int) {
+	for aLo < aHi && bLo < bHi && s.a[aLo] == s.b[bLo] {
+		s.ops = append(s.ops, op{kind: opEqual, a: aLo, b: bLo, text: s.a[aLo]})
+		aLo++
+		bLo++
+	}
+
+	suffix := 0
+	for aLo < aHi-suffix && bLo < bHi-suffix && s.a[aHi-suffix-1] == s.b[bHi-suffix-1] {
+		suffix++
+	}
+	aHi -= suffix
+	bHi -= suffix
+
+	switch {
+	case aLo == aHi:
+		for j := bLo; j < bHi; j++ {
+			s.ops = append(s.ops, op{kind: opInsert, a: aLo, b: j, text: s.b[j]})
+		}
+	case bLo == bHi:
+		for i := aLo; i < aHi; i++ {
+			s.ops = append(s.ops, op{kind: opDelete, a: i, b: bLo, text: s.a[i]})
+		}
+	default:
+		x, y, u, v := s.middleSnake(aLo, aHi, bLo, bHi)
+		s.compare(aLo, x, bLo, y)
+		for ; x < u; x, y = x+1, y+1 {
+			s.ops = append(s.ops, op{kind: opEqual, a: x, b: y, text: s.a[x]})
+		}
+		s.compare(u, aHi, v, bHi)
+	}
+
+	for i := 0; i < suffix; i++ {
+		s.ops = append(s.ops, op{kind: opEqual, a: aHi + i, b: bHi + i, text: s.a[aHi+i]})
+	}
+}
+
+// middleSnake finds the middle snake of an optimal path from (aLo, bLo) to
+// (aHi, bHi) and returns its start (x, y) and end (u, v). Both ranges must be
+// non-empty and must not share a common prefix or suffix.
+func (s *myers) middleSnake(aLo, aHi, bLo, bHi int) (x, y, u, v int) {
+	n, m := aHi-aLo, bHi-bLo
+	delta := n - m
+	odd := delta%2 != 0
+	offset := n + m + 1
+	vf, vb := s.vf, s.vb
+	vf[offset+1] = 0
+	vb[offset+1] = 0
+
+	for d := 0; d <= (n+m+1)/2; d++ {
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && vf[offset+k-1] < vf[offset+k+1]) {
+				x = vf[offset+k+1]
+			} else {
+				x = vf[offset+k-1] + 1
+			}
+			y := x - k
+			x0, y0 := x, y
+			for x < n && y < m && s.a[aLo+x] == s.b[bLo+y] {
+				x++
+				y++
+			}
+			vf[offset+k] = x
+
+			if r := delta - k; odd && r >= -(d-1) && r <= d-1 && x+vb[offset+r] >= n {
+				return aLo + x0, bLo + y0, aLo + x, bLo + y
+			}
+		}
+
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && vb[offset+k-1] < vb[offset+k+1]) {
+				x = vb[offset+k+1]
+			} else {
+				x = vb[offset+k-1] + 1
+			}
+			y := x - k
+			x0, y0 := x, y
+			for x < n && y < m && s.a[aHi-x-1] == s.b[bHi-y-1] {
+				x++
+				y++
+			}
+			vb[offset+k] = x
+
+			if r := delta - k; !odd && r >= -d && r <= d && x+vf[offset+r] >= n {
+				return aHi - x, bHi - y, aHi - x0, bHi - y0
+			}
+		}
+	}
+
+	panic("diff: no middle snake")
+}
@@ -0,0 +1,170 @@
+package diff
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func numbered(from, to int) string {
+	var buf strings.Builder
+	for i := from; i <= to; i++ {
+		fmt.Fprintf(&buf, "%d\n", i)
+	}
+	return buf.String()
+}
+
+// withLine returns s with its nth line (1-based) replaced by text.
+func withLine(s string, n int, text string) string {
+	lines := strings.Split(s, "\n")
+	lines[n-1] = text
+	return strings.Join(lines, "\n")
+}
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want string
+	}{
+		{"Identical", "a\nb\nc\n", "a\nb\nc\n", ""},
+		{"BothEmpty", "", "", ""},
+		{"EmptyToText", "", "a\nb\n", "--- old\n+++ new\n@@ -0,0 +1,2 @@\n+a\n+b\n"},
+		{"TextToEmpty", "a\nb\n", "", "--- old\n+++ new\n@@ -1,2 +0,0 @@\n-a\n-b\n"},
+		{"PureInsert", "a\nb\nc\n", "a\nb\nx\nc\n", "--- old\n+++ new\n@@ -1,3 +1,4 @@\n a\n b\n+x\n c\n"},
+		{"PureDelete", "a\nb\nx\nc\n", "a\nb\nc\n", "--- old\n+++ new\n@@ -1,4 +1,3 @@\n a\n b\n-x\n c\n"},
+		{"Replace", "a\nb\nc\n", "a\nx\nc\n", "--- old\n+++ new\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n"},
+		{"ReplaceLast", "a\nb\n", "a\nx\n", "--- old\n+++ new\n@@ -1,2 +1,2 @@\n a\n-b\n+x\n"},
+		{
+			"ContextTrimmed",
+			numbered(1, 10),
+			withLine(numbered(1, 10), 5, "five"),
+			"--- old\n+++ new\n@@ -2,7 +2,7 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n",
+		},
+		{
+			"HunksMergedWithinTwiceContext",
+			numbered(1, 12),
+			withLine(withLine(numbered(1, 12), 2, "two"), 9, "nine"),
+			"--- old\n+++ new\n@@ -1,12 +1,12 @@\n 1\n-2\n+two\n 3\n 4\n 5\n 6\n 7\n 8\n-9\n+nine\n 10\n 11\n 12\n",
+		},
+		{
+			"HunksSplitBeyondTwiceContext",
+			numbered(1, 13),
+			withLine(withLine(numbered(1, 13), 2, "two"), 10, "ten"),
+			"--- old\n+++ new\n@@ -1,5 +1,5 @@\n 1\n-2\n+two\n 3\n 4\n 5\n@@ -7,7 +7,7 @@\n 7\n 8\n 9\n-10\n+ten\n 11\n 12\n 13\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Unified("old", "new", tt.from, tt.to)
+			if got != tt.want {
+				t.Fatalf("Unified:\ngot:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+// lcsLength is a quadratic reference used to check that lineOps is minimal.
+func lcsLength(a, b []string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			if a[i] == b[j] {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(cur[j], prev[j+1])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func checkOps(t *testing.T, a, b []string, ops []op) int {
+	t.Helper()
+
+	var gotA, gotB []string
+	equal := 0
+	for i, o := range ops {
+		if i > 0 && o.kind == opDelete && ops[i-1].kind == opInsert {
+			t.Fatalf("insert precedes delete at op %d: %+v", i, ops)
+		}
+		if o.a != len(gotA) || o.b != len(gotB) {
+			t.Fatalf("op %d has position (%d, %d), want (%d, %d)", i, o.a, o.b, len(gotA), len(gotB))
+		}
+		switch o.kind {
+		case opEqual:
+			if a[o.a] != o.text || b[o.b] != o.text {
+				t.Fatalf("equal op %+v does not match inputs", o)
+			}
+			gotA = append(gotA, o.text)
+			gotB = append(gotB, o.text)
+			equal++
+		case opDelete:
+			if a[o.a] != o.text {
+				t.Fatalf("delete op %+v does not match input", o)
+			}
+			gotA = append(gotA, o.text)
+		case opInsert:
+			if b[o.b] != o.text {
+				t.Fatalf("insert op %+v does not match input", o)
+			}
+			gotB = append(gotB, o.text)
+		}
+	}
+	if strings.Join(gotA, "\n") != strings.Join(a, "\n") || strings.Join(gotB, "\n") != strings.Join(b, "\n") {
+		t.Fatalf("ops do not reproduce inputs\na: %q\nb: %q\nops: %+v", a, b, ops)
+	}
+	return equal
+}
+
+func TestLineOpsMinimal(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	alphabet := []string{"a", "b", "c", "d"}
+	random := func() []string {
+		lines := make([]string, rng.Intn(12))
+		for i := range lines {
+			lines[i] = alphabet[rng.Intn(len(alphabet))]
+		}
+		return lines
+	}
+
+	for i := 0; i < 2000; i++ {
+		a, b := random(), random()
+		ops := lineOps(a, b)
+		if got, want := checkOps(t, a, b, ops), lcsLength(a, b); got != want {
+			t.Fatalf("lineOps(%q, %q) kept %d lines, want %d", a, b, got, want)
+		}
+	}
+}
+
+func TestLineOpsUnrelatedLargeInputs(t *testing.T) {
+	a := make([]string, 4000)
+	b := make([]string, 4000)
+	for i := range a {
+		a[i] = fmt.Sprintf("a%d", i)
+		b[i] = fmt.Sprintf("b%d", i)
+	}
+
+	ops := lineOps(a, b)
+	if got := checkOps(t, a, b, ops); got != 0 {
+		t.Fatalf("expected no equal lines, got %d", got)
+	}
+}
+
+func TestLineOpsOverMaxLines(t *testing.T) {
+	a := strings.Split(numbered(1, maxLines/2+1), "\n")
+	b := append([]string{"first"}, a...)
+
+	ops := lineOps(a, b)
+	checkOps(t, a, b, ops)
+	for _, o := range ops {
+		if o.kind == opEqual {
+			t.Fatalf("expected a full replacement over maxLines, got equal op %+v", o)
+		}
+	}
+}
@@ -16,6 +16,12 @@ var (
 	ErrInvalidToken            = errors.New("invalid or expired token")
 	ErrInvalidStatusTransition = errors.New("invalid status transition")
 	ErrInvalidLogin            = errors.New("invalid login")
+	ErrAttemptLimitReached     = errors.New("attempt limit reached")
+	ErrAttachmentLimitReached  = errors.New("attachment limit reached")
+	ErrModuleLocked            = errors.New("module locked")
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+	ErrTooManyRequests         = errors.New("too many requests")
+	ErrAccountSuspended        = errors.New("account suspended")
 )
 
 type AppError struct {
@@ -61,16 +67,23 @@ func GetStatusCode(err error) int {
 		return http.StatusNotFound
 	case errors.Is(err, ErrUnauthorized),
 		errors.Is(err, ErrInvalidCredentials),
-		errors.Is(err, ErrInvalidLogin):
+		errors.Is(err, ErrInvalidLogin),
+		errors.Is(err, ErrInvalidTwoFactorCode):
 		return http.StatusUnauthorized
-	case errors.Is(err, ErrForbidden):
+	case errors.Is(err, ErrForbidden),
+		errors.Is(err, ErrModuleLocked),
+		errors.Is(err, ErrAccountSuspended):
 		return http.StatusForbidden
 	case errors.Is(err, ErrConflict):
 		return http.StatusConflict
 	case errors.Is(err, ErrInvalidInput) || errors.Is(err, ErrInvalidToken):
 		return http.StatusBadRequest
-	case errors.Is(err, ErrInvalidStatusTransition):
+	case errors.Is(err, ErrInvalidStatusTransition),
+		errors.Is(err, ErrAttemptLimitReached),
+		errors.Is(err, ErrAttachmentLimitReached):
 		return http.StatusConflict
+	case errors.Is(err, ErrTooManyRequests):
+		return http.StatusTooManyRequests
 	default:
 		return http.StatusInternalServerError
 	}
@@ -101,6 +114,18 @@ func GetUserMessage(err error) string {
 		return "Invalid status transition"
 	case errors.Is(err, ErrInvalidLogin):
 		return "Invalid email or password"
+	case errors.Is(err, ErrAttemptLimitReached):
+		return "Attempt limit reached"
+	case errors.Is(err, ErrAttachmentLimitReached):
+		return "Attachment limit reached"
+	case errors.Is(err, ErrModuleLocked):
+		return "This module is not available yet"
+	case errors.Is(err, ErrInvalidTwoFactorCode):
+		return "Invalid authentication code"
+	case errors.Is(err, ErrTooManyRequests):
+		return "Too many attempts. Please try again later."
+	case errors.Is(err, ErrAccountSuspended):
+		return "This account has been suspended"
 	default:
 		return "An error occurred. Please try again later."
 	}
@@ -0,0 +1,124 @@
+// Package password decides whether a new password is acceptable.
+package password
+
+import (
+	"bufio"
+	_ "embed"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"bytecourses/internal/pkg/errors"
+)
+
+const (
+	DefaultMinLength = 10
+
+	// MaxLength is the number of bytes bcrypt hashes; longer passwords are
+	// rejected rather than silently truncated.
+	MaxLength = 72
+
+	// Personal details shorter than this are too likely to appear by chance.
+	minPersonalLength = 3
+)
+
+// blocklist holds common and previously breached passwords, lowercased, one
+// per line.
+//
+//go:embed blocklist.txt
+var blocklist string
+
+var (
+	blockedOnce sync.Once
+	blocked     map[string]struct{}
+)
+
+func isBlocked(password string) bool {
+	blockedOnce.Do(func() {
+		blocked = make(map[string]struct{})
+		scanner := bufio.NewScanner(strings.NewReader(blocklist))
+		for scanner.Scan() {
+			if line := strings.TrimSpace(scanner.Text()); line != "" {
+				blocked[line] = struct{}{}
+			}
+		}
+	})
+
+	password = strings.ToLower(password)
+	if _, ok := blocked[password]; ok {
+		return true
+	}
+
+	// "Welcome2027!" is no stronger than "welcome", so also check the
+	// password with trailing digits and punctuation removed.
+	base := strings.TrimRightFunc(password, func(r rune) bool {
+		return unicode.IsDigit(r) || unicode.IsPunct(r) || unicode.IsSymbol(r)
+	})
+	_, ok := blocked[base]
+	return ok
+}
+
+type Policy struct {
+	MinLength int
+}
+
+func DefaultPolicy() *Policy {
+	return &Policy{MinLength: DefaultMinLength}
+}
+
+// Validate checks password against every rule and reports each one it
+// breaks under field. Email and name are the account's own details, which
+// must not appear in the password.
+func (p *Policy) Validate(field string, password string, email string, name string) error {
+	errs := errors.NewValidationErrors()
+	for _, message := range p.Check(password, email, name) {
+		errs.Add(field, message)
+	}
+	if errs.HasErrors() {
+		return errs
+	}
+	return nil
+}
+
+// Check returns a message for each rule password breaks.
+func (p *Policy) Check(password string, email string, name string) []string {
+	var failed []string
+
+	minLength := p.MinLength
+	if minLength <= 0 {
+		minLength = DefaultMinLength
+	}
+	if utf8.RuneCountInString(password) < minLength {
+		failed = append(failed, "must be at least "+strconv.Itoa(minLength)+" characters")
+	}
+	if len(password) > MaxLength {
+		failed = append(failed, "must be at most "+strconv.Itoa(MaxLength)+" bytes")
+	}
+
+	lower := strings.ToLower(password)
+	local, _, _ := strings.Cut(email, "@")
+	if containsPersonal(lower, []string{email, local}) {
+		failed = append(failed, "must not contain your email address")
+	}
+	if containsPersonal(lower, strings.Fields(name)) {
+		failed = append(failed, "must not contain your name")
+	}
+
+	if isBlocked(password) {
+		failed = append(failed, "is too common and appears in lists of breached passwords")
+	}
+
+	return failed
+}
+
+func containsPersonal(password string, parts []string) bool {
+	for _, part := range parts {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if utf8.RuneCountInString(part) >= minPersonalLength && strings.Contains(password, part) {
+			return true
+		}
+	}
+	return false
+}
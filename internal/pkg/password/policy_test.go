@@ -0,0 +1,70 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"bytecourses/internal/pkg/errors"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	policy := DefaultPolicy()
+
+	tests := []struct {
+		name     string
+		password string
+		want     []string
+	}{
+		{"Valid", "correct horse battery", nil},
+		{"TooShort", "x7#kq", []string{"must be at least 10 characters"}},
+		{"TooLong", strings.Repeat("x7#kq", 15), []string{"must be at most 72 bytes"}},
+		{"ContainsEmail", "ann.lee-rocks-42", []string{"must not contain your email address"}},
+		{"ContainsName", "Kowalski-4-ever", []string{"must not contain your name"}},
+		{"Blocklisted", "Password123", []string{"is too common and appears in lists of breached passwords"}},
+		{"BlocklistedWithSuffix", "football2027!!", []string{"is too common and appears in lists of breached passwords"}},
+		{"Multiple", "ann.lee", []string{
+			"must be at least 10 characters",
+			"must not contain your email address",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := policy.Check(tt.password, "ann.lee@example.com", "Jo Kowalski")
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Fatalf("Check(%q) = %q, want %q", tt.password, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicyMinLength(t *testing.T) {
+	policy := &Policy{MinLength: 16}
+
+	got := policy.Check("correct horse", "", "")
+	if len(got) != 1 || got[0] != "must be at least 16 characters" {
+		t.Fatalf("Check: got %q", got)
+	}
+}
+
+func TestPolicyValidate(t *testing.T) {
+	policy := DefaultPolicy()
+
+	if err := policy.Validate("password", "correct horse battery", "ann@example.com", "Ann"); err != nil {
+		t.Fatalf("Validate: unexpected error: %v", err)
+	}
+
+	err := policy.Validate("password", "qwerty", "ann@example.com", "Ann")
+	errs, ok := err.(*errors.ValidationErrors)
+	if !ok {
+		t.Fatalf("Validate: expected *errors.ValidationErrors, got %T", err)
+	}
+	if len(errs.Errors) != 2 {
+		t.Fatalf("Validate: expected 2 errors, got %v", errs.Errors)
+	}
+	for _, e := range errs.Errors {
+		if e.Field != "password" {
+			t.Fatalf("Validate: expected field password, got %q", e.Field)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// Package policy decides who may act on a course or a proposal. Services ask
+// an Authorizer rather than comparing user IDs themselves, so that course
+// membership and system roles are interpreted in one place.
+package policy
+
+import (
+	"context"
+	"sort"
+
+	"bytecourses/internal/domain"
+)
+
+type Action string
+
+const (
+	// ViewCourse covers unpublished courses, modules and content.
+	ViewCourse Action = "course.view"
+	// EditCourse covers the course details, modules and content.
+	EditCourse          Action = "course.edit"
+	ManageCourseMembers Action = "course.manage_members"
+	ViewSubmissions     Action = "submission.view"
+	GradeSubmissions    Action = "submission.grade"
+	ReviewProposals     Action = "proposal.review"
+	// AssignReviewers covers choosing who reviews a proposal.
+	AssignReviewers Action = "proposal.assign_reviewers"
+)
+
+var courseRoleActions = map[domain.CourseRole]map[Action]bool{
+	domain.CourseRoleOwner: {
+		ViewCourse:          true,
+		EditCourse:          true,
+		ManageCourseMembers: true,
+		ViewSubmissions:     true,
+		GradeSubmissions:    true,
+	},
+	domain.CourseRoleCoInstructor: {
+		ViewCourse:       true,
+		EditCourse:       true,
+		ViewSubmissions:  true,
+		GradeSubmissions: true,
+	},
+	domain.CourseRoleTA: {
+		ViewCourse:       true,
+		ViewSubmissions:  true,
+		GradeSubmissions: true,
+	},
+}
+
+// Admins can look at any course and sort out its staff, but changing course
+// material or grades stays with the people teaching it.
+var systemRoleActions = map[domain.SystemRole]map[Action]bool{
+	domain.SystemRoleReviewer: {
+		ReviewProposals: true,
+	},
+	domain.SystemRoleAdmin: {
+		ViewCourse:          true,
+		ManageCourseMembers: true,
+		ViewSubmissions:     true,
+		ReviewProposals:     true,
+		AssignReviewers:     true,
+	},
+}
+
+// Actor is the user an action is checked for. Role may be left empty when
+// only the user's course membership should count.
+type Actor struct {
+	UserID int64
+	Role   domain.SystemRole
+}
+
+func UserActor(u *domain.User) Actor {
+	if u == nil {
+		return Actor{}
+	}
+	return Actor{UserID: u.ID, Role: u.Role}
+}
+
+type MemberLookup interface {
+	GetMember(ctx context.Context, courseID, userID int64) (*domain.CourseMember, bool)
+}
+
+type Authorizer struct {
+	Members MemberLookup
+}
+
+func NewAuthorizer(members MemberLookup) *Authorizer {
+	return &Authorizer{
+		Members: members,
+	}
+}
+
+// Allows reports whether the system role alone permits the action.
+func Allows(role domain.SystemRole, action Action) bool {
+	return systemRoleActions[role][action]
+}
+
+// RolesAllowing lists the system roles that permit the action, in a stable
+// order.
+func RolesAllowing(action Action) []domain.SystemRole {
+	roles := make([]domain.SystemRole, 0, len(systemRoleActions))
+	for role, actions := range systemRoleActions {
+		if actions[action] {
+			roles = append(roles, role)
+		}
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		return roles[i] < roles[j]
+	})
+	return roles
+}
+
+// RoleAllows reports whether the course role permits the action.
+func RoleAllows(role domain.CourseRole, action Action) bool {
+	return courseRoleActions[role][action]
+}
+
+// CourseRole returns the user's role on the course. The course's instructor
+// is its owner whether or not a membership row exists.
+func (a *Authorizer) CourseRole(ctx context.Context, course *domain.Course, userID int64) (domain.CourseRole, bool) {
+	if userID == 0 {
+		return "", false
+	}
+	if course.InstructorID == userID {
+		return domain.CourseRoleOwner, true
+	}
+
+	member, ok := a.Members.GetMember(ctx, course.ID, userID)
+	if !ok {
+		return "", false
+	}
+	return member.Role, true
+}
+
+func (a *Authorizer) Can(ctx context.Context, actor Actor, course *domain.Course, action Action) bool {
+	if Allows(actor.Role, action) {
+		return true
+	}
+
+	role, ok := a.CourseRole(ctx, course, actor.UserID)
+	return ok && RoleAllows(role, action)
+}
@@ -0,0 +1,77 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"bytecourses/internal/domain"
+)
+
+type members map[int64]domain.CourseRole
+
+func (m members) GetMember(ctx context.Context, courseID, userID int64) (*domain.CourseMember, bool) {
+	role, ok := m[userID]
+	if !ok {
+		return nil, false
+	}
+	return &domain.CourseMember{CourseID: courseID, UserID: userID, Role: role}, true
+}
+
+func TestAuthorizerCan(t *testing.T) {
+	course := &domain.Course{ID: 1, InstructorID: 10}
+	authorizer := NewAuthorizer(members{
+		20: domain.CourseRoleCoInstructor,
+		30: domain.CourseRoleTA,
+	})
+
+	tests := []struct {
+		name   string
+		actor  Actor
+		action Action
+		want   bool
+	}{
+		{"OwnerEdits", Actor{UserID: 10}, EditCourse, true},
+		{"OwnerManagesMembers", Actor{UserID: 10}, ManageCourseMembers, true},
+		{"CoInstructorEdits", Actor{UserID: 20}, EditCourse, true},
+		{"CoInstructorCannotManageMembers", Actor{UserID: 20}, ManageCourseMembers, false},
+		{"TAGrades", Actor{UserID: 30}, GradeSubmissions, true},
+		{"TACannotEdit", Actor{UserID: 30}, EditCourse, false},
+		{"StrangerCannotView", Actor{UserID: 40}, ViewCourse, false},
+		{"AdminViews", Actor{UserID: 40, Role: domain.SystemRoleAdmin}, ViewCourse, true},
+		{"AdminCannotGrade", Actor{UserID: 40, Role: domain.SystemRoleAdmin}, GradeSubmissions, false},
+		{"ReviewerCannotView", Actor{UserID: 40, Role: domain.SystemRoleReviewer}, ViewCourse, false},
+		{"AnonymousCannotView", Actor{}, ViewCourse, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := authorizer.Can(context.Background(), tt.actor, course, tt.action); got != tt.want {
+				t.Fatalf("Can(%+v, %q) = %v, want %v", tt.actor, tt.action, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllows(t *testing.T) {
+	if !Allows(domain.SystemRoleReviewer, ReviewProposals) {
+		t.Fatal("reviewer should be allowed to review proposals")
+	}
+	if !Allows(domain.SystemRoleAdmin, ReviewProposals) {
+		t.Fatal("admin should be allowed to review proposals")
+	}
+	if Allows(domain.SystemRoleUser, ReviewProposals) {
+		t.Fatal("user should not be allowed to review proposals")
+	}
+}
+
+func TestRolesAllowing(t *testing.T) {
+	roles := RolesAllowing(ReviewProposals)
+	if len(roles) != 2 || roles[0] != domain.SystemRoleAdmin || roles[1] != domain.SystemRoleReviewer {
+		t.Fatalf("RolesAllowing(ReviewProposals) = %v, want [admin reviewer]", roles)
+	}
+
+	roles = RolesAllowing(AssignReviewers)
+	if len(roles) != 1 || roles[0] != domain.SystemRoleAdmin {
+		t.Fatalf("RolesAllowing(AssignReviewers) = %v, want [admin]", roles)
+	}
+}
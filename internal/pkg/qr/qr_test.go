@@ -0,0 +1,362 @@
+package qr
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDataCapacity(t *testing.T) {
+	// Byte mode capacities at level M from ISO/IEC 18004 Table 7.
+	want := []int{1: 14, 2: 26, 3: 42, 4: 62, 5: 84, 6: 106, 7: 122, 8: 152, 9: 180, 10: 213}
+
+	for version := 1; version < len(versions); version++ {
+		if got := dataCapacity(version); got != want[version] {
+			t.Fatalf("dataCapacity(%d) = %d, want %d", version, got, want[version])
+		}
+	}
+}
+
+func TestEncodeVersionSelection(t *testing.T) {
+	tests := []struct {
+		length int
+		size   int
+	}{
+		{0, 21},
+		{14, 21},
+		{15, 25},
+		{62, 33},
+		{63, 37},
+		{213, 57},
+	}
+
+	for _, tt := range tests {
+		code, err := Encode(strings.Repeat("a", tt.length))
+		if err != nil {
+			t.Fatalf("Encode(%d bytes): unexpected error: %v", tt.length, err)
+		}
+		if code.Size != tt.size {
+			t.Fatalf("Encode(%d bytes): size = %d, want %d", tt.length, code.Size, tt.size)
+		}
+	}
+
+	if _, err := Encode(strings.Repeat("a", 214)); err != ErrTooLong {
+		t.Fatalf("Encode(214 bytes): expected ErrTooLong, got %v", err)
+	}
+}
+
+func TestErrorCorrectionKnownVector(t *testing.T) {
+	// "HELLO WORLD" at 1-M, from the worked example at thonky.com.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+
+	if got := rsRemainder(data, rsDivisor(10)); !bytes.Equal(got, want) {
+		t.Fatalf("rsRemainder = %v, want %v", got, want)
+	}
+}
+
+func TestFormatBitsKnownVectors(t *testing.T) {
+	// Level M format strings for each mask, from ISO/IEC 18004 Annex C.
+	want := []string{
+		"101010000010010",
+		"101000100100101",
+		"101111001111100",
+		"101101101001011",
+		"100010111111001",
+		"100000011001110",
+		"100111110010111",
+		"100101010100000",
+	}
+
+	for mask, w := range want {
+		s := newSymbol(1)
+		s.drawFormatBits(mask)
+		if got := readFormatBits(s.modules, s.size); got != w {
+			t.Fatalf("format bits for mask %d = %s, want %s", mask, got, w)
+		}
+	}
+}
+
+func TestVersionBitsKnownVector(t *testing.T) {
+	s := newSymbol(7)
+	s.drawVersion()
+
+	// Version 7 information from ISO/IEC 18004 Annex D, least significant
+	// bit first.
+	const want = "000111110010010100"
+	var got strings.Builder
+	for i := 17; i >= 0; i-- {
+		if s.modules[i/3][s.size-11+i%3] {
+			got.WriteByte('1')
+		} else {
+			got.WriteByte('0')
+		}
+	}
+	if got.String() != want {
+		t.Fatalf("version bits = %s, want %s", got.String(), want)
+	}
+}
+
+func TestApplyMaskIsAnInvolutionOnDataModules(t *testing.T) {
+	s := newSymbol(2)
+	s.drawFunctionPatterns()
+	before := cloneModules(s.modules)
+
+	for mask := 0; mask < 8; mask++ {
+		s.applyMask(mask)
+		for y := range s.modules {
+			for x := range s.modules[y] {
+				if s.isFunction[y][x] && s.modules[y][x] != before[y][x] {
+					t.Fatalf("mask %d changed function module (%d, %d)", mask, x, y)
+				}
+			}
+		}
+		s.applyMask(mask)
+		for y := range s.modules {
+			if !equalRow(s.modules[y], before[y]) {
+				t.Fatalf("mask %d applied twice did not restore row %d", mask, y)
+			}
+		}
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	inputs := []string{
+		"",
+		"hello",
+		"otpauth://totp/ByteCourses:ann%40example.com?algorithm=SHA1&digits=6&issuer=ByteCourses&period=30&secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ",
+		strings.Repeat("0123456789", 12),
+		strings.Repeat("x", 213),
+	}
+
+	for _, input := range inputs {
+		code, err := Encode(input)
+		if err != nil {
+			t.Fatalf("Encode(%q): unexpected error: %v", input, err)
+		}
+		if got := decode(t, code); got != input {
+			t.Fatalf("round trip: got %q, want %q", got, input)
+		}
+	}
+}
+
+func TestEncodeRoundTripEachMask(t *testing.T) {
+	const input = "otpauth://totp/ByteCourses:ann"
+	data := []byte(input)
+
+	for _, version := range []int{3, 7} {
+		for mask := 0; mask < 8; mask++ {
+			s := newSymbol(version)
+			s.drawFunctionPatterns()
+			s.drawCodewords(addErrorCorrection(version, encodeData(version, data)))
+			s.applyMask(mask)
+			s.drawFormatBits(mask)
+
+			code := &Code{Size: s.size, modules: s.modules}
+			if got := decode(t, code); got != input {
+				t.Fatalf("version %d mask %d: got %q, want %q", version, mask, got, input)
+			}
+		}
+	}
+}
+
+func TestSVG(t *testing.T) {
+	svg, err := SVG("hello")
+	if err != nil {
+		t.Fatalf("SVG: unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(svg, "<svg") || !strings.Contains(svg, `viewBox="0 0 29 29"`) {
+		t.Fatalf("SVG: unexpected output %q", svg)
+	}
+}
+
+// decode reads a symbol back independently of the encoder's bookkeeping: it
+// recovers the mask from the format bits, unmasks and reads the codewords,
+// checks each block's error correction and parses the byte mode segment.
+func decode(t *testing.T, code *Code) string {
+	t.Helper()
+
+	modules := make([][]bool, code.Size)
+	for y := range modules {
+		modules[y] = make([]bool, code.Size)
+		for x := range modules[y] {
+			modules[y][x] = code.Dark(x, y)
+		}
+	}
+
+	version := (code.Size - 17) / 4
+	format := readFormatBits(modules, code.Size)
+	var raw int
+	for _, c := range format {
+		raw = raw<<1 | int(c-'0')
+	}
+	raw ^= 0x5412
+	if level := raw >> 13; level != 0 {
+		t.Fatalf("format level = %02b, want M (00)", level)
+	}
+	mask := raw >> 10 & 7
+
+	layout := newSymbol(version)
+	layout.drawFunctionPatterns()
+
+	var bits []bool
+	for right := code.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		upward := (right+1)&2 == 0
+		for vert := 0; vert < code.Size; vert++ {
+			y := vert
+			if upward {
+				y = code.Size - 1 - vert
+			}
+			for _, x := range []int{right, right - 1} {
+				if layout.isFunction[y][x] {
+					continue
+				}
+				bits = append(bits, modules[y][x] != maskBit(mask, x, y))
+			}
+		}
+	}
+
+	v := versions[version]
+	codewords := make([]byte, v.totalCodewords)
+	for i := range codewords {
+		for j := 0; j < 8; j++ {
+			if bits[i*8+j] {
+				codewords[i] |= 1 << (7 - j)
+			}
+		}
+	}
+
+	dataCodewords := v.totalCodewords - v.eccPerBlock*v.blocks
+	shortLen := dataCodewords / v.blocks
+	numShort := v.blocks - dataCodewords%v.blocks
+	blocks := make([][]byte, v.blocks)
+	k := 0
+	for i := 0; i <= shortLen; i++ {
+		for b := range blocks {
+			if i < shortLen || b >= numShort {
+				blocks[b] = append(blocks[b], codewords[k])
+				k++
+			}
+		}
+	}
+	for i := 0; i < v.eccPerBlock; i++ {
+		for b := range blocks {
+			blocks[b] = append(blocks[b], codewords[k])
+			k++
+		}
+	}
+
+	var data []byte
+	for b, block := range blocks {
+		// A valid codeword polynomial vanishes at the generator's roots
+		// α^0 … α^(n-1).
+		root := byte(1)
+		for i := 0; i < v.eccPerBlock; i++ {
+			var sum byte
+			for _, c := range block {
+				sum = gfMultiply(sum, root) ^ c
+			}
+			if sum != 0 {
+				t.Fatalf("block %d: non-zero syndrome at α^%d", b, i)
+			}
+			root = gfMultiply(root, 2)
+		}
+		data = append(data, block[:len(block)-v.eccPerBlock]...)
+	}
+
+	pos := 0
+	read := func(n int) int {
+		value := 0
+		for i := 0; i < n; i++ {
+			bit := data[(pos+i)/8] >> (7 - (pos+i)%8) & 1
+			value = value<<1 | int(bit)
+		}
+		pos += n
+		return value
+	}
+
+	if mode := read(4); mode != 0b0100 {
+		t.Fatalf("mode = %04b, want byte mode", mode)
+	}
+	out := make([]byte, read(countBits(version)))
+	for i := range out {
+		out[i] = byte(read(8))
+	}
+	return string(out)
+}
+
+// readFormatBits returns the format string read from around the top left
+// finder pattern, most significant bit first, after checking that the copy
+// split between the other two finders matches it.
+func readFormatBits(modules [][]bool, size int) string {
+	primary := [15][2]int{
+		{8, 0}, {8, 1}, {8, 2}, {8, 3}, {8, 4}, {8, 5}, {8, 7}, {8, 8},
+		{7, 8}, {5, 8}, {4, 8}, {3, 8}, {2, 8}, {1, 8}, {0, 8},
+	}
+
+	var b strings.Builder
+	for i := 14; i >= 0; i-- {
+		x, y := primary[i][0], primary[i][1]
+		primaryDark := modules[y][x]
+
+		var sx, sy int
+		if i < 8 {
+			sx, sy = size-1-i, 8
+		} else {
+			sx, sy = 8, size-15+i
+		}
+		if modules[sy][sx] != primaryDark {
+			return "mismatched copies"
+		}
+
+		if primaryDark {
+			b.WriteByte('1')
+		} else {
+			b.WriteByte('0')
+		}
+	}
+	return b.String()
+}
+
+// maskBit evaluates the mask patterns from ISO/IEC 18004 Table 10, where i is
+// the row and j the column.
+func maskBit(mask, j, i int) bool {
+	switch mask {
+	case 0:
+		return (i+j)%2 == 0
+	case 1:
+		return i%2 == 0
+	case 2:
+		return j%3 == 0
+	case 3:
+		return (i+j)%3 == 0
+	case 4:
+		return (i/2+j/3)%2 == 0
+	case 5:
+		return (i*j)%2+(i*j)%3 == 0
+	case 6:
+		return ((i*j)%2+(i*j)%3)%2 == 0
+	default:
+		return ((i+j)%2+(i*j)%3)%2 == 0
+	}
+}
+
+func cloneModules(modules [][]bool) [][]bool {
+	out := make([][]bool, len(modules))
+	for i := range modules {
+		out[i] = append([]bool(nil), modules[i]...)
+	}
+	return out
+}
+
+func equalRow(a, b []bool) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return len(a) == len(b)
+}
@@ -0,0 +1,147 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+
+	"bytecourses/internal/domain"
+)
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "by": true, "for": true, "from": true, "in": true, "is": true,
+	"it": true, "of": true, "on": true, "or": true, "that": true, "the": true,
+	"this": true, "to": true, "with": true,
+}
+
+var suffixes = []struct {
+	suffix      string
+	replacement string
+}{
+	{"ations", ""},
+	{"ation", ""},
+	{"ings", ""},
+	{"ing", ""},
+	{"ies", "y"},
+	{"ed", ""},
+	{"s", ""},
+}
+
+// Terms splits text into normalized, stemmed search terms with stop words
+// removed. Terms are returned in the order they appear.
+func Terms(text string) []string {
+	words := strings.FieldsFunc(text, isSeparator)
+	terms := make([]string, 0, len(words))
+	for _, word := range words {
+		if term := Normalize(word); term != "" {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// Normalize lowercases a single word and strips common English suffixes so
+// that e.g. "Pointers" and "pointer" produce the same term.
+func Normalize(word string) string {
+	word = strings.ToLower(word)
+	if stopWords[word] {
+		return ""
+	}
+	for _, s := range suffixes {
+		if strings.HasSuffix(word, s.suffix) && !strings.HasSuffix(word, "ss") && len(word)-len(s.suffix) >= 3 {
+			word = strings.TrimSuffix(word, s.suffix) + s.replacement
+			break
+		}
+	}
+	if len(word) > 3 {
+		word = strings.TrimSuffix(word, "e")
+	}
+	return word
+}
+
+// Snippet returns a window of roughly maxLen characters around the first
+// occurrence of any of terms, split into parts so matches can be highlighted.
+func Snippet(text string, terms []string, maxLen int) []domain.SnippetPart {
+	text = strings.Join(strings.Fields(text), " ")
+	if text == "" {
+		return []domain.SnippetPart{}
+	}
+
+	wanted := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		wanted[term] = true
+	}
+
+	type span struct {
+		start, end int
+		match      bool
+	}
+	var spans []span
+	first := -1
+	start := -1
+	for i, r := range text + " " {
+		if isSeparator(r) {
+			if start >= 0 {
+				match := wanted[Normalize(text[start:i])]
+				if match && first < 0 {
+					first = start
+				}
+				spans = append(spans, span{start: start, end: i, match: match})
+				start = -1
+			}
+			continue
+		}
+		if start < 0 {
+			start = i
+		}
+	}
+
+	from := 0
+	if first > maxLen/3 {
+		from = first - maxLen/3
+	}
+	to := min(from+maxLen, len(text))
+	for _, s := range spans {
+		if s.start < from && s.end > from {
+			from = s.end
+		}
+		if s.start < to && s.end > to {
+			to = s.start
+		}
+	}
+
+	var parts []domain.SnippetPart
+	appendText := func(s string, match bool) {
+		if s == "" {
+			return
+		}
+		if n := len(parts); n > 0 && parts[n-1].Match == match {
+			parts[n-1].Text += s
+			return
+		}
+		parts = append(parts, domain.SnippetPart{Text: s, Match: match})
+	}
+
+	if from > 0 {
+		appendText("…", false)
+	}
+	pos := from
+	for _, s := range spans {
+		if !s.match || s.start < from || s.end > to {
+			continue
+		}
+		appendText(text[pos:s.start], false)
+		appendText(text[s.start:s.end], true)
+		pos = s.end
+	}
+	appendText(text[pos:to], false)
+	if to < len(text) {
+		appendText("…", false)
+	}
+
+	return parts
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
@@ -0,0 +1,127 @@
+package search
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"bytecourses/internal/domain"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		word string
+		want string
+	}{
+		{"Pointers", "pointer"},
+		{"Testing", "test"},
+		{"tested", "test"},
+		{"tests", "test"},
+		{"libraries", "library"},
+		{"Queries", "query"},
+		{"configuration", "configur"},
+		{"configure", "configur"},
+		{"Stations", "station"},
+		{"class", "class"},
+		{"uses", "use"},
+		{"Go", "go"},
+		{"ed", "ed"},
+		{"the", ""},
+		{"THE", ""},
+	}
+
+	for _, tt := range tests {
+		if got := Normalize(tt.word); got != tt.want {
+			t.Fatalf("Normalize(%q) = %q, want %q", tt.word, got, tt.want)
+		}
+	}
+}
+
+func TestTerms(t *testing.T) {
+	got := Terms("The Go Programming Language, 2nd-edition: pointers & interfaces")
+	want := []string{"go", "programm", "languag", "2nd", "edition", "pointer", "interfac"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("Terms = %q, want %q", got, want)
+	}
+
+	if got := Terms("the and of"); len(got) != 0 {
+		t.Fatalf("Terms of stop words = %q, want none", got)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	long := strings.Repeat("filler words here ", 10) +
+		"the pointer section explains pointers clearly " +
+		strings.Repeat("more trailing text ", 10)
+
+	tests := []struct {
+		name   string
+		text   string
+		query  string
+		maxLen int
+		want   []domain.SnippetPart
+	}{
+		{
+			"Empty",
+			"", "go", 20,
+			[]domain.SnippetPart{},
+		},
+		{
+			"MatchWithinShortText",
+			"Learn about pointers in Go", "pointer", 80,
+			[]domain.SnippetPart{
+				{Text: "Learn about "},
+				{Text: "pointers", Match: true},
+				{Text: " in Go"},
+			},
+		},
+		{
+			"CollapsesWhitespace",
+			"Learn\n\n about   pointers", "pointer", 80,
+			[]domain.SnippetPart{
+				{Text: "Learn about "},
+				{Text: "pointers", Match: true},
+			},
+		},
+		{
+			"NoMatchTruncatesAtWordBoundary",
+			"alpha beta gamma delta", "zeta", 10,
+			[]domain.SnippetPart{
+				{Text: "alpha beta…"},
+			},
+		},
+		{
+			"WindowAroundLaterMatch",
+			long, "pointers", 60,
+			[]domain.SnippetPart{
+				{Text: "… words here the "},
+				{Text: "pointer", Match: true},
+				{Text: " section explains "},
+				{Text: "pointers", Match: true},
+				{Text: " …"},
+			},
+		},
+		{
+			"HighlightsEveryStemmedTerm",
+			"Go channels and goroutines make channels easy", "channel goroutine", 200,
+			[]domain.SnippetPart{
+				{Text: "Go "},
+				{Text: "channels", Match: true},
+				{Text: " and "},
+				{Text: "goroutines", Match: true},
+				{Text: " make "},
+				{Text: "channels", Match: true},
+				{Text: " easy"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Snippet(tt.text, Terms(tt.query), tt.maxLen)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("Snippet = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package totp
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// rfcSecret is the SHA-1 key from RFC 6238 Appendix B, "12345678901234567890",
+// in base32.
+const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestCodeRFC6238Vectors(t *testing.T) {
+	// The RFC lists 8 digit codes; 6 digit codes are their last six digits.
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		got, err := Code(rfcSecret, Step(time.Unix(tt.unix, 0)))
+		if err != nil {
+			t.Fatalf("Code(%d): unexpected error: %v", tt.unix, err)
+		}
+		if got != tt.want {
+			t.Fatalf("Code(%d) = %q, want %q", tt.unix, got, tt.want)
+		}
+	}
+}
+
+func TestCodeAcceptsLowercaseSecret(t *testing.T) {
+	got, err := Code(strings.ToLower(rfcSecret), 1)
+	if err != nil {
+		t.Fatalf("Code: unexpected error: %v", err)
+	}
+	if got != "287082" {
+		t.Fatalf("Code = %q, want %q", got, "287082")
+	}
+}
+
+func TestCodeRejectsInvalidSecret(t *testing.T) {
+	if _, err := Code("not base32!", 1); err == nil {
+		t.Fatal("Code: expected an error for an invalid secret")
+	}
+}
+
+func TestValidateSkew(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	current := Step(now)
+
+	tests := []struct {
+		name   string
+		offset int64
+		ok     bool
+	}{
+		{"Current", 0, true},
+		{"PreviousStep", -1, true},
+		{"NextStep", 1, true},
+		{"TwoStepsBehind", -2, false},
+		{"TwoStepsAhead", 2, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, err := Code(rfcSecret, current+tt.offset)
+			if err != nil {
+				t.Fatalf("Code: unexpected error: %v", err)
+			}
+
+			step, ok := Validate(rfcSecret, code, now)
+			if ok != tt.ok {
+				t.Fatalf("Validate ok = %v, want %v", ok, tt.ok)
+			}
+			if ok && step != current+tt.offset {
+				t.Fatalf("Validate step = %d, want %d", step, current+tt.offset)
+			}
+		})
+	}
+}
+
+func TestValidateReturnsCodeStepForReplayCheck(t *testing.T) {
+	issued := time.Unix(1111111109, 0)
+	code, err := Code(rfcSecret, Step(issued))
+	if err != nil {
+		t.Fatalf("Code: unexpected error: %v", err)
+	}
+
+	first, ok := Validate(rfcSecret, code, issued)
+	if !ok {
+		t.Fatal("Validate: expected the code to be accepted")
+	}
+
+	// Replaying the code in the next step still matches, but reports the step
+	// it was issued for so callers can reject it as already used.
+	replay, ok := Validate(rfcSecret, code, issued.Add(Period*time.Second))
+	if !ok {
+		t.Fatal("Validate: expected the code to match within the skew window")
+	}
+	if replay != first {
+		t.Fatalf("Validate replay step = %d, want %d", replay, first)
+	}
+}
+
+func TestValidateNormalizesInput(t *testing.T) {
+	now := time.Unix(59, 0)
+
+	if _, ok := Validate(rfcSecret, " 287 082 ", now); !ok {
+		t.Fatal("Validate: expected spaces to be ignored")
+	}
+	for _, code := range []string{"", "28708", "2870820", "287083"} {
+		if _, ok := Validate(rfcSecret, code, now); ok {
+			t.Fatalf("Validate(%q): expected rejection", code)
+		}
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	a, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret: unexpected error: %v", err)
+	}
+	b, err := GenerateSecret()
+	if err != nil {
+		t.Fatalf("GenerateSecret: unexpected error: %v", err)
+	}
+	if a == b {
+		t.Fatal("GenerateSecret: expected distinct secrets")
+	}
+	if len(a) != 32 {
+		t.Fatalf("GenerateSecret: expected 32 base32 characters, got %d", len(a))
+	}
+	if _, err := Code(a, 1); err != nil {
+		t.Fatalf("Code: generated secret did not decode: %v", err)
+	}
+}
+
+func TestProvisioningURI(t *testing.T) {
+	uri := ProvisioningURI("Byte Courses", "ann@example.com", rfcSecret)
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		t.Fatalf("url.Parse: %v", err)
+	}
+	if u.Scheme != "otpauth" || u.Host != "totp" {
+		t.Fatalf("unexpected URI %q", uri)
+	}
+	if u.Path != "/Byte Courses:ann@example.com" {
+		t.Fatalf("unexpected label %q", u.Path)
+	}
+
+	q := u.Query()
+	want := map[string]string{
+		"secret":    rfcSecret,
+		"issuer":    "Byte Courses",
+		"algorithm": "SHA1",
+		"digits":    "6",
+		"period":    "30",
+	}
+	for k, v := range want {
+		if q.Get(k) != v {
+			t.Fatalf("query %s = %q, want %q", k, q.Get(k), v)
+		}
+	}
+}
@@ -42,6 +42,24 @@ func (fv *FieldValidator) MaxLength(maxLen int) *FieldValidator {
 	return fv
 }
 
+func (fv *FieldValidator) Min(min int) *FieldValidator {
+	if n, ok := fv.value.(int); ok {
+		if n < min {
+			fv.errs.Add(fv.name, "must be at least "+strconv.Itoa(min))
+		}
+	}
+	return fv
+}
+
+func (fv *FieldValidator) Max(max int) *FieldValidator {
+	if n, ok := fv.value.(int); ok {
+		if n > max {
+			fv.errs.Add(fv.name, "must be at most "+strconv.Itoa(max))
+		}
+	}
+	return fv
+}
+
 func (fv *FieldValidator) IsLower() *FieldValidator {
 	if s, ok := fv.value.(string); ok {
 		if s != strings.ToLower(s) {
@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/auth"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/validation"
+)
+
+const (
+	apiTokenPrefix         = "bc_"
+	apiTokenDisplayLength  = 10
+	apiTokenDefaultTTLDays = 30
+	apiTokenMaxTTLDays     = 365
+)
+
+var (
+	_ Command = (*CreateAPITokenCommand)(nil)
+	_ Command = (*RevokeAPITokenCommand)(nil)
+	_ Query   = (*ListAPITokensQuery)(nil)
+)
+
+// CreatedAPIToken carries the plaintext secret, which is only ever returned
+// from CreateAPIToken.
+type CreatedAPIToken struct {
+	domain.APIToken
+	Secret string `json:"secret"`
+}
+
+type CreateAPITokenCommand struct {
+	UserID        int64               `json:"-"`
+	Name          string              `json:"name"`
+	Scopes        []domain.TokenScope `json:"scopes"`
+	ExpiresInDays int                 `json:"expires_in_days"`
+}
+
+func (c *CreateAPITokenCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.Name, "name").Required().MaxLength(80).IsTrimmed()
+	v.Field(c.ExpiresInDays, "expires_in_days").Min(0).Max(apiTokenMaxTTLDays)
+}
+
+func (s *AuthService) CreateAPIToken(ctx context.Context, cmd *CreateAPITokenCommand) (*CreatedAPIToken, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+	if len(cmd.Scopes) == 0 {
+		return nil, errors.ErrInvalidInput
+	}
+
+	scopes := make([]domain.TokenScope, 0, len(cmd.Scopes))
+	for _, scope := range cmd.Scopes {
+		if !domain.IsValidTokenScope(scope) {
+			return nil, errors.ErrInvalidInput
+		}
+		if !slices.Contains(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	if _, ok := s.Users.GetByID(ctx, cmd.UserID); !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	secret, err := auth.GenerateToken()
+	if err != nil {
+		return nil, err
+	}
+	secret = apiTokenPrefix + secret
+
+	ttlDays := cmd.ExpiresInDays
+	if ttlDays == 0 {
+		ttlDays = apiTokenDefaultTTLDays
+	}
+
+	tokenHash := auth.HashToken(secret)
+	token := domain.APIToken{
+		UserID:    cmd.UserID,
+		Name:      cmd.Name,
+		Prefix:    secret[:apiTokenDisplayLength],
+		TokenHash: tokenHash[:],
+		Scopes:    scopes,
+		ExpiresAt: time.Now().AddDate(0, 0, ttlDays),
+	}
+	if err := s.Tokens.CreateAPIToken(ctx, &token); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewAPITokenCreatedEvent(token.UserID, token.ID, token.Name, token.Scopes)
+	_ = s.Events.Publish(ctx, event)
+
+	return &CreatedAPIToken{
+		APIToken: token,
+		Secret:   secret,
+	}, nil
+}
+
+type ListAPITokensQuery struct {
+	UserID int64 `json:"user_id"`
+}
+
+func (s *AuthService) ListAPITokens(ctx context.Context, query *ListAPITokensQuery) ([]domain.APIToken, error) {
+	return s.Tokens.ListAPITokensByUserID(ctx, query.UserID)
+}
+
+type RevokeAPITokenCommand struct {
+	UserID  int64 `json:"-"`
+	TokenID int64 `json:"-"`
+}
+
+func (c *RevokeAPITokenCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.TokenID, "token_id").Required().EntityID()
+}
+
+func (s *AuthService) RevokeAPIToken(ctx context.Context, cmd *RevokeAPITokenCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	if err := s.Tokens.DeleteAPIToken(ctx, cmd.UserID, cmd.TokenID); err != nil {
+		return err
+	}
+
+	event := domain.NewAPITokenRevokedEvent(cmd.UserID, cmd.TokenID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
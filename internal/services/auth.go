@@ -6,30 +6,62 @@ import (
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/auth"
+	"bytecourses/internal/infrastructure/oidc"
 	"bytecourses/internal/infrastructure/persistence"
 	"bytecourses/internal/pkg/errors"
 	"bytecourses/internal/pkg/events"
+	"bytecourses/internal/pkg/password"
 	"bytecourses/internal/pkg/validation"
 )
 
 type AuthService struct {
-	Users    persistence.UserRepository
-	Resets   persistence.PasswordResetRepository
-	Sessions auth.SessionStore
-	Events   events.EventBus
+	Users           persistence.UserRepository
+	Resets          persistence.PasswordResetRepository
+	Verifications   persistence.EmailVerificationRepository
+	EmailChanges    persistence.EmailChangeRepository
+	RecoveryCodes   persistence.RecoveryCodeRepository
+	RolePolicies    persistence.RolePolicyRepository
+	Throttles       persistence.LoginThrottleRepository
+	Tokens          persistence.APITokenRepository
+	Identities      persistence.UserIdentityRepository
+	Sessions        auth.SessionStore
+	PendingSessions auth.SessionStore
+	OIDC            *oidc.Provider
+	Passwords       *password.Policy
+	Events          events.EventBus
 }
 
 func NewAuthService(
 	userRepo persistence.UserRepository,
 	resetRepo persistence.PasswordResetRepository,
+	verificationRepo persistence.EmailVerificationRepository,
+	emailChangeRepo persistence.EmailChangeRepository,
+	recoveryCodeRepo persistence.RecoveryCodeRepository,
+	rolePolicyRepo persistence.RolePolicyRepository,
+	throttleRepo persistence.LoginThrottleRepository,
+	tokenRepo persistence.APITokenRepository,
+	identityRepo persistence.UserIdentityRepository,
 	sessionStore auth.SessionStore,
+	pendingSessionStore auth.SessionStore,
+	oidcProvider *oidc.Provider,
+	passwordPolicy *password.Policy,
 	eventBus events.EventBus,
 ) *AuthService {
 	return &AuthService{
-		Users:    userRepo,
-		Resets:   resetRepo,
-		Sessions: sessionStore,
-		Events:   eventBus,
+		Users:           userRepo,
+		Resets:          resetRepo,
+		Verifications:   verificationRepo,
+		EmailChanges:    emailChangeRepo,
+		RecoveryCodes:   recoveryCodeRepo,
+		RolePolicies:    rolePolicyRepo,
+		Throttles:       throttleRepo,
+		Tokens:          tokenRepo,
+		Identities:      identityRepo,
+		Sessions:        sessionStore,
+		PendingSessions: pendingSessionStore,
+		OIDC:            oidcProvider,
+		Passwords:       passwordPolicy,
+		Events:          eventBus,
 	}
 }
 
@@ -41,12 +73,18 @@ var (
 	_ Command = (*DeleteUserCommand)(nil)
 	_ Command = (*RequestPasswordResetCommand)(nil)
 	_ Command = (*ConfirmPasswordResetCommand)(nil)
+	_ Command = (*ChangePasswordCommand)(nil)
+	_ Command = (*VerifyEmailCommand)(nil)
+	_ Command = (*ResendVerificationCommand)(nil)
 )
 
+const verificationTokenTTL = 24 * time.Hour
+
 type RegisterCommand struct {
 	Name     string `json:"name"`
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	BaseURL  string `json:"-"`
 }
 
 func (c *RegisterCommand) Validate(v *validation.Validator) {
@@ -59,6 +97,9 @@ func (s *AuthService) Register(ctx context.Context, cmd *RegisterCommand) (*doma
 	if err := validation.Validate(cmd); err != nil {
 		return nil, err
 	}
+	if err := s.Passwords.Validate("password", cmd.Password, cmd.Email, cmd.Name); err != nil {
+		return nil, err
+	}
 	if _, found := s.Users.GetByEmail(ctx, cmd.Email); found {
 		return nil, errors.ErrConflict
 	}
@@ -81,12 +122,17 @@ func (s *AuthService) Register(ctx context.Context, cmd *RegisterCommand) (*doma
 	event := domain.NewUserRegisteredEvent(user.ID, user.Email, user.Name)
 	_ = s.Events.Publish(ctx, event)
 
+	if err := s.requestVerification(ctx, &user, cmd.BaseURL); err != nil {
+		return nil, err
+	}
+
 	return &user, nil
 }
 
 type LoginCommand struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	IP       string `json:"-"`
 }
 
 func (c *LoginCommand) Validate(v *validation.Validator) {
@@ -94,26 +140,73 @@ func (c *LoginCommand) Validate(v *validation.Validator) {
 	v.Field(c.Password, "password").Required() // Do not validate password rules on login
 }
 
-func (s *AuthService) Login(ctx context.Context, cmd *LoginCommand) (string, error) {
+// LoginResult holds either a full session or, when a second factor is still
+// needed, a short-lived pending session to be exchanged via VerifyTwoFactor.
+type LoginResult struct {
+	SessionID          string `json:"-"`
+	PendingSessionID   string `json:"-"`
+	TwoFactorRequired  bool   `json:"two_factor_required"`
+	EnrollmentRequired bool   `json:"enrollment_required"`
+}
+
+func (s *AuthService) Login(ctx context.Context, cmd *LoginCommand) (*LoginResult, error) {
 	if err := validation.Validate(cmd); err != nil {
-		return "", err
+		return nil, err
+	}
+
+	now := time.Now()
+	if err := s.checkLoginThrottle(ctx, cmd.Email, cmd.IP, now); err != nil {
+		return nil, err
 	}
 
 	user, ok := s.Users.GetByEmail(ctx, cmd.Email)
 	if !ok {
 		auth.CheckPassword(make([]byte, 20), "") // Always perform a password check to combat timing attacks
-		return "", errors.ErrInvalidLogin
+		if err := s.recordLoginFailure(ctx, cmd.Email, cmd.IP, nil, now); err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrInvalidLogin
 	}
 	if err := auth.CheckPassword(user.PasswordHash, cmd.Password); err != nil {
-		return "", errors.ErrInvalidLogin
+		if err := s.recordLoginFailure(ctx, cmd.Email, cmd.IP, user, now); err != nil {
+			return nil, err
+		}
+		return nil, errors.ErrInvalidLogin
+	}
+
+	return s.completeLogin(ctx, user)
+}
+
+// completeLogin finishes a login whose first factor has been checked, either
+// starting a session or handing out a pending one for the second factor.
+func (s *AuthService) completeLogin(ctx context.Context, user *domain.User) (*LoginResult, error) {
+	if user.IsSuspended() {
+		return nil, errors.ErrAccountSuspended
+	}
+
+	enrollmentRequired := !user.TOTPEnabled && s.requiresTwoFactor(ctx, user)
+	if user.TOTPEnabled || enrollmentRequired {
+		pendingSessionID, err := s.PendingSessions.Create(user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &LoginResult{
+			PendingSessionID:   pendingSessionID,
+			TwoFactorRequired:  true,
+			EnrollmentRequired: enrollmentRequired,
+		}, nil
+	}
+
+	if err := s.resetLoginThrottle(ctx, user.Email); err != nil {
+		return nil, err
 	}
 
 	sessionID, err := s.Sessions.Create(user.ID)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return sessionID, nil
+	return &LoginResult{SessionID: sessionID}, nil
 }
 
 type LogoutCommand struct {
@@ -196,6 +289,7 @@ func (s *AuthService) DeleteUser(ctx context.Context, cmd *DeleteUserCommand) er
 type RequestPasswordResetCommand struct {
 	Email   string `json:"email"`
 	BaseURL string `json:"-"`
+	IP      string `json:"-"`
 }
 
 func (c *RequestPasswordResetCommand) Validate(v *validation.Validator) {
@@ -207,6 +301,11 @@ func (s *AuthService) RequestPasswordReset(ctx context.Context, cmd *RequestPass
 		return err
 	}
 
+	allowed, err := s.allowPasswordReset(ctx, cmd.Email, cmd.IP, time.Now())
+	if err != nil || !allowed {
+		return err // Drop throttled requests silently to avoid email enumeration
+	}
+
 	user, ok := s.Users.GetByEmail(ctx, cmd.Email)
 	if !ok {
 		return nil // Return nil to avoid email enumeration
@@ -246,8 +345,11 @@ func (s *AuthService) ConfirmPasswordReset(ctx context.Context, cmd *ConfirmPass
 	}
 
 	hash := auth.HashToken(cmd.Token)
+	now := time.Now()
 
-	userID, ok := s.Resets.ConsumeResetToken(ctx, hash[:], time.Now())
+	// Check the new password before consuming the token so that a rejected
+	// password does not use up the reset link.
+	userID, ok := s.Resets.GetResetToken(ctx, hash[:], now)
 	if !ok {
 		return errors.ErrInvalidToken
 	}
@@ -255,6 +357,12 @@ func (s *AuthService) ConfirmPasswordReset(ctx context.Context, cmd *ConfirmPass
 	if !ok {
 		return errors.ErrNotFound
 	}
+	if err := s.Passwords.Validate("new_password", cmd.NewPassword, user.Email, user.Name); err != nil {
+		return err
+	}
+	if _, ok := s.Resets.ConsumeResetToken(ctx, hash[:], now); !ok {
+		return errors.ErrInvalidToken
+	}
 
 	passwordHash, err := auth.HashPassword(cmd.NewPassword)
 	if err != nil {
@@ -271,3 +379,139 @@ func (s *AuthService) ConfirmPasswordReset(ctx context.Context, cmd *ConfirmPass
 
 	return nil
 }
+
+type ChangePasswordCommand struct {
+	UserID           int64  `json:"-"`
+	CurrentSessionID string `json:"-"`
+	CurrentPassword  string `json:"current_password"`
+	NewPassword      string `json:"new_password"`
+}
+
+func (c *ChangePasswordCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.CurrentPassword, "current_password").Required()
+	v.Field(c.NewPassword, "new_password").Required().Password()
+}
+
+func (s *AuthService) ChangePassword(ctx context.Context, cmd *ChangePasswordCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if err := s.checkCurrentPassword(ctx, user, cmd.CurrentPassword); err != nil {
+		return err
+	}
+	if err := s.Passwords.Validate("new_password", cmd.NewPassword, user.Email, user.Name); err != nil {
+		return err
+	}
+
+	passwordHash, err := auth.HashPassword(cmd.NewPassword)
+	if err != nil {
+		return err
+	}
+
+	user.PasswordHash = passwordHash
+	if err := s.Users.Update(ctx, user); err != nil {
+		return err
+	}
+
+	// Anyone else holding a session may have learned the old password.
+	if err := s.Sessions.DeleteOthersByUserID(user.ID, cmd.CurrentSessionID); err != nil {
+		return err
+	}
+	if err := s.PendingSessions.DeleteByUserID(user.ID); err != nil {
+		return err
+	}
+
+	event := domain.NewPasswordChangedEvent(user.ID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+type VerifyEmailCommand struct {
+	Token string `json:"token"`
+}
+
+func (c *VerifyEmailCommand) Validate(v *validation.Validator) {
+	v.Field(c.Token, "token").Required().IsTrimmed()
+}
+
+func (s *AuthService) VerifyEmail(ctx context.Context, cmd *VerifyEmailCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	hash := auth.HashToken(cmd.Token)
+
+	userID, ok := s.Verifications.ConsumeVerificationToken(ctx, hash[:], time.Now())
+	if !ok {
+		return errors.ErrInvalidToken
+	}
+	user, ok := s.Users.GetByID(ctx, userID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if user.IsVerified() {
+		return nil
+	}
+
+	now := time.Now()
+	user.VerifiedAt = &now
+	if err := s.Users.Update(ctx, user); err != nil {
+		return err
+	}
+
+	event := domain.NewEmailVerifiedEvent(user.ID, user.Email, user.Name)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+type ResendVerificationCommand struct {
+	UserID  int64  `json:"-"`
+	BaseURL string `json:"-"`
+}
+
+func (c *ResendVerificationCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+}
+
+func (s *AuthService) ResendVerification(ctx context.Context, cmd *ResendVerificationCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if user.IsVerified() {
+		return errors.ErrConflict
+	}
+
+	return s.requestVerification(ctx, user, cmd.BaseURL)
+}
+
+func (s *AuthService) requestVerification(ctx context.Context, user *domain.User, baseURL string) error {
+	token, err := auth.GenerateToken()
+	if err != nil {
+		return err
+	}
+
+	tokenHash := auth.HashToken(token)
+	expiresAt := time.Now().Add(verificationTokenTTL)
+	if err := s.Verifications.CreateVerificationToken(ctx, user.ID, tokenHash[:], expiresAt); err != nil {
+		return err
+	}
+
+	verifyURL := baseURL + "/verify-email"
+	event := domain.NewEmailVerificationRequestedEvent(user.ID, user.Email, user.Name, verifyURL, token)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+)
+
+type moduleGate struct {
+	Modules     persistence.ModuleRepository
+	Enrollments persistence.EnrollmentRepository
+	Progress    persistence.ProgressRepository
+	Readings    persistence.ReadingRepository
+	Files       persistence.FileRepository
+}
+
+// apply sets Locked and AvailableAt on each module for the given learner.
+// Modules must be ordered by Order, as returned by ListByCourseID.
+func (g moduleGate) apply(ctx context.Context, userID, courseID int64, modules []domain.Module) error {
+	enrollment, ok := g.Enrollments.GetByUserAndCourse(ctx, userID, courseID)
+	if !ok {
+		return errors.ErrForbidden
+	}
+
+	now := time.Now()
+	var progress *domain.CourseProgress
+	var previous *domain.Module
+
+	for i := range modules {
+		module := &modules[i]
+
+		previousCompleted := true
+		if module.UnlockRule == domain.ModuleUnlockAfterPrevious && previous != nil {
+			if progress == nil {
+				p, err := courseProgress(ctx, g.Progress, g.Readings, g.Files, userID, courseID, modules)
+				if err != nil {
+					return err
+				}
+				progress = p
+			}
+			mp := progress.Module(previous.ID)
+			previousCompleted = !previous.Locked && mp.Completed >= mp.Total
+		}
+
+		module.Locked = !module.IsUnlocked(enrollment.EnrolledAt, now, previousCompleted)
+		module.AvailableAt = nil
+		if module.Locked {
+			module.AvailableAt = module.UnlocksAt(enrollment.EnrolledAt)
+		}
+
+		if module.Status == domain.ModuleStatusPublished {
+			previous = module
+		}
+	}
+
+	return nil
+}
+
+func (g moduleGate) check(ctx context.Context, userID int64, module *domain.Module) error {
+	modules, err := g.Modules.ListByCourseID(ctx, module.CourseID)
+	if err != nil {
+		return err
+	}
+
+	if err := g.apply(ctx, userID, module.CourseID, modules); err != nil {
+		return err
+	}
+
+	for i := range modules {
+		if modules[i].ID != module.ID {
+			continue
+		}
+		module.Locked = modules[i].Locked
+		module.AvailableAt = modules[i].AvailableAt
+		if module.Locked {
+			return errors.ErrModuleLocked
+		}
+		return nil
+	}
+
+	return errors.ErrNotFound
+}
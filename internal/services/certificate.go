@@ -0,0 +1,171 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/events"
+	"bytecourses/internal/pkg/validation"
+)
+
+var (
+	_ Command = (*IssueCertificateCommand)(nil)
+)
+
+var (
+	_ Query = (*GetCertificateQuery)(nil)
+	_ Query = (*GetCourseCertificateQuery)(nil)
+	_ Query = (*ListCertificatesQuery)(nil)
+)
+
+type CertificateService struct {
+	Certificates persistence.CertificateRepository
+	Enrollments  persistence.EnrollmentRepository
+	Courses      persistence.CourseRepository
+	Users        persistence.UserRepository
+	Events       events.EventBus
+}
+
+func NewCertificateService(
+	certificates persistence.CertificateRepository,
+	enrollments persistence.EnrollmentRepository,
+	courses persistence.CourseRepository,
+	users persistence.UserRepository,
+	eventBus events.EventBus,
+) *CertificateService {
+	return &CertificateService{
+		Certificates: certificates,
+		Enrollments:  enrollments,
+		Courses:      courses,
+		Users:        users,
+		Events:       eventBus,
+	}
+}
+
+type IssueCertificateCommand struct {
+	CourseID int64 `json:"course_id"`
+	UserID   int64 `json:"user_id"`
+}
+
+func (c *IssueCertificateCommand) Validate(v *validation.Validator) {
+	v.Field(c.CourseID, "course_id").EntityID()
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+func (s *CertificateService) Issue(ctx context.Context, cmd *IssueCertificateCommand) (*domain.Certificate, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+
+	if existing, ok := s.Certificates.GetByUserAndCourse(ctx, cmd.UserID, cmd.CourseID); ok {
+		return existing, nil
+	}
+
+	if _, ok := s.Enrollments.GetByUserAndCourse(ctx, cmd.UserID, cmd.CourseID); !ok {
+		return nil, errors.ErrForbidden
+	}
+
+	course, ok := s.Courses.GetByID(ctx, cmd.CourseID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	learner, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	var instructorName string
+	if instructor, ok := s.Users.GetByID(ctx, course.InstructorID); ok {
+		instructorName = displayName(instructor)
+	}
+
+	code, err := generateCertificateCode()
+	if err != nil {
+		return nil, err
+	}
+
+	certificate := domain.Certificate{
+		Code:           code,
+		UserID:         learner.ID,
+		CourseID:       course.ID,
+		LearnerName:    displayName(learner),
+		CourseTitle:    course.Title,
+		InstructorName: instructorName,
+	}
+	if err := s.Certificates.Create(ctx, &certificate); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewCertificateIssuedEvent(certificate.ID, certificate.UserID, certificate.CourseID, certificate.Code)
+	_ = s.Events.Publish(ctx, event)
+
+	return &certificate, nil
+}
+
+type GetCertificateQuery struct {
+	Code string `json:"code"`
+}
+
+func (s *CertificateService) Get(ctx context.Context, query *GetCertificateQuery) (*domain.Certificate, error) {
+	code := strings.ToUpper(strings.TrimSpace(query.Code))
+	if code == "" {
+		return nil, errors.ErrNotFound
+	}
+
+	certificate, ok := s.Certificates.GetByCode(ctx, code)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	return certificate, nil
+}
+
+type GetCourseCertificateQuery struct {
+	CourseID int64 `json:"course_id"`
+	UserID   int64 `json:"user_id"`
+}
+
+func (s *CertificateService) GetForCourse(ctx context.Context, query *GetCourseCertificateQuery) (*domain.Certificate, error) {
+	certificate, ok := s.Certificates.GetByUserAndCourse(ctx, query.UserID, query.CourseID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	return certificate, nil
+}
+
+type ListCertificatesQuery struct {
+	UserID int64 `json:"user_id"`
+}
+
+func (s *CertificateService) List(ctx context.Context, query *ListCertificatesQuery) ([]domain.Certificate, error) {
+	return s.Certificates.ListByUser(ctx, query.UserID)
+}
+
+func generateCertificateCode() (string, error) {
+	b := make([]byte, 10)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	raw := base32.StdEncoding.EncodeToString(b)
+	parts := make([]string, 0, 4)
+	for i := 0; i < len(raw); i += 4 {
+		parts = append(parts, raw[i:i+4])
+	}
+
+	return strings.Join(parts, "-"), nil
+}
+
+func displayName(user *domain.User) string {
+	if user.Name != "" {
+		return user.Name
+	}
+	return user.Email
+}
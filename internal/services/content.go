@@ -5,12 +5,16 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/persistence"
 	"bytecourses/internal/infrastructure/storage"
 	"bytecourses/internal/pkg/errors"
 	"bytecourses/internal/pkg/events"
+	"bytecourses/internal/pkg/policy"
 	"bytecourses/internal/pkg/validation"
 )
 
@@ -20,48 +24,84 @@ var (
 	_ Command = (*DeleteContentCommand)(nil)
 	_ Command = (*PublishContentCommand)(nil)
 	_ Command = (*UnpublishContentCommand)(nil)
+	_ Command = (*SubmitQuizAttemptCommand)(nil)
 )
 
 var (
 	_ Query = (*ListContentQuery)(nil)
 	_ Query = (*GetContentQuery)(nil)
+	_ Query = (*ListQuizAttemptsQuery)(nil)
 )
 
 type ContentService struct {
-	Readings    persistence.ReadingRepository
-	Files       persistence.FileRepository
-	Modules     persistence.ModuleRepository
-	Courses     persistence.CourseRepository
-	Events      events.EventBus
-	FileStorage storage.FileStorage
+	Readings     persistence.ReadingRepository
+	Files        persistence.FileRepository
+	Quizzes      persistence.QuizRepository
+	QuizAttempts persistence.QuizAttemptRepository
+	Assignments  persistence.AssignmentRepository
+	Modules      persistence.ModuleRepository
+	Courses      persistence.CourseRepository
+	Enrollments  persistence.EnrollmentRepository
+	Progress     persistence.ProgressRepository
+	Authorizer   *policy.Authorizer
+	Events       events.EventBus
+	FileStorage  storage.FileStorage
 }
 
 func NewContentService(
 	readings persistence.ReadingRepository,
 	files persistence.FileRepository,
+	quizzes persistence.QuizRepository,
+	quizAttempts persistence.QuizAttemptRepository,
+	assignments persistence.AssignmentRepository,
 	modules persistence.ModuleRepository,
 	courses persistence.CourseRepository,
+	enrollments persistence.EnrollmentRepository,
+	progress persistence.ProgressRepository,
+	authorizer *policy.Authorizer,
 	eventBus events.EventBus,
 	fileStorage storage.FileStorage,
 ) *ContentService {
 	return &ContentService{
-		Readings:    readings,
-		Files:       files,
-		Modules:     modules,
-		Courses:     courses,
-		Events:      eventBus,
-		FileStorage: fileStorage,
+		Readings:     readings,
+		Files:        files,
+		Quizzes:      quizzes,
+		QuizAttempts: quizAttempts,
+		Assignments:  assignments,
+		Modules:      modules,
+		Courses:      courses,
+		Enrollments:  enrollments,
+		Progress:     progress,
+		Authorizer:   authorizer,
+		Events:       eventBus,
+		FileStorage:  fileStorage,
+	}
+}
+
+func (s *ContentService) gate() moduleGate {
+	return moduleGate{
+		Modules:     s.Modules,
+		Enrollments: s.Enrollments,
+		Progress:    s.Progress,
+		Readings:    s.Readings,
+		Files:       s.Files,
 	}
 }
 
 type CreateContentCommand struct {
-	Type     domain.ContentType `json:"type"`
-	ModuleID int64              `json:"module_id"`
-	Title    string             `json:"title"`
-	Order    int                `json:"order"`
-	Format   string             `json:"format"`
-	Content  string             `json:"content"`
-	UserID   int64              `json:"user_id"`
+	Type         domain.ContentType    `json:"type"`
+	ModuleID     int64                 `json:"module_id"`
+	Title        string                `json:"title"`
+	Order        int                   `json:"order"`
+	Format       string                `json:"format"`
+	Content      string                `json:"content"`
+	Questions    []domain.QuizQuestion `json:"questions"`
+	MaxAttempts  int                   `json:"max_attempts"`
+	PassingScore int                   `json:"passing_score"`
+	Instructions string                `json:"instructions"`
+	DueAt        *time.Time            `json:"due_at"`
+	MaxPoints    int                   `json:"max_points"`
+	UserID       int64                 `json:"user_id"`
 }
 
 func (c *CreateContentCommand) Validate(v *validation.Validator) {
@@ -76,6 +116,13 @@ func (c *CreateContentCommand) Validate(v *validation.Validator) {
 			v.Field(c.Content, "content").Required()
 		}
 	}
+	if c.Type == domain.ContentTypeQuiz {
+		validateQuiz(v, c.Questions, c.MaxAttempts, c.PassingScore)
+	}
+	if c.Type == domain.ContentTypeAssignment {
+		v.Field(c.Instructions, "instructions").Required()
+		v.Field(c.MaxPoints, "max_points").Min(1)
+	}
 }
 
 func (s *ContentService) Create(ctx context.Context, cmd *CreateContentCommand) (domain.ContentItem, error) {
@@ -86,6 +133,10 @@ func (s *ContentService) Create(ctx context.Context, cmd *CreateContentCommand)
 	switch cmd.Type {
 	case domain.ContentTypeReading:
 		return s.createReading(ctx, cmd)
+	case domain.ContentTypeQuiz:
+		return s.createQuiz(ctx, cmd)
+	case domain.ContentTypeAssignment:
+		return s.createAssignment(ctx, cmd)
 	default:
 		return nil, errors.ErrInvalidInput
 	}
@@ -123,7 +174,7 @@ func (s *ContentService) CreateFile(ctx context.Context, cmd *CreateFileCommand)
 	if !ok {
 		return nil, errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return nil, errors.ErrForbidden
 	}
 
@@ -165,7 +216,7 @@ func (s *ContentService) createReading(ctx context.Context, cmd *CreateContentCo
 	if !ok {
 		return nil, errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return nil, errors.ErrNotFound
 	}
 
@@ -187,6 +238,9 @@ func (s *ContentService) createReading(ctx context.Context, cmd *CreateContentCo
 	if err := s.Readings.Create(ctx, &reading); err != nil {
 		return nil, err
 	}
+	if err := s.recordRevision(ctx, &reading, cmd.UserID); err != nil {
+		return nil, err
+	}
 
 	event := domain.NewContentCreatedEvent(domain.ContentTypeReading, reading.ID, reading.ModuleID, module.CourseID, course.InstructorID)
 	_ = s.Events.Publish(ctx, event)
@@ -194,14 +248,94 @@ func (s *ContentService) createReading(ctx context.Context, cmd *CreateContentCo
 	return &reading, nil
 }
 
+func (s *ContentService) createQuiz(ctx context.Context, cmd *CreateContentCommand) (*domain.Quiz, error) {
+	module, ok := s.Modules.GetByID(ctx, cmd.ModuleID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
+		return nil, errors.ErrForbidden
+	}
+
+	if !isValidQuiz(cmd.Questions) {
+		return nil, errors.ErrInvalidInput
+	}
+
+	quiz := domain.Quiz{
+		BaseContentItem: domain.BaseContentItem{
+			ModuleID: cmd.ModuleID,
+			Title:    cmd.Title,
+			Order:    cmd.Order,
+			Status:   domain.ContentStatusDraft,
+		},
+		Questions:    cmd.Questions,
+		MaxAttempts:  cmd.MaxAttempts,
+		PassingScore: cmd.PassingScore,
+	}
+	if err := s.Quizzes.Create(ctx, &quiz); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewContentCreatedEvent(domain.ContentTypeQuiz, quiz.ID, quiz.ModuleID, module.CourseID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return &quiz, nil
+}
+
+func (s *ContentService) createAssignment(ctx context.Context, cmd *CreateContentCommand) (*domain.Assignment, error) {
+	module, ok := s.Modules.GetByID(ctx, cmd.ModuleID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
+		return nil, errors.ErrForbidden
+	}
+
+	assignment := domain.Assignment{
+		BaseContentItem: domain.BaseContentItem{
+			ModuleID: cmd.ModuleID,
+			Title:    cmd.Title,
+			Order:    cmd.Order,
+			Status:   domain.ContentStatusDraft,
+		},
+		Instructions: cmd.Instructions,
+		DueAt:        cmd.DueAt,
+		MaxPoints:    cmd.MaxPoints,
+	}
+	if err := s.Assignments.Create(ctx, &assignment); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewContentCreatedEvent(domain.ContentTypeAssignment, assignment.ID, assignment.ModuleID, module.CourseID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return &assignment, nil
+}
+
 type UpdateContentCommand struct {
-	Type      domain.ContentType `json:"type"`
-	ContentID int64              `json:"content_id"`
-	Title     string             `json:"title"`
-	Order     int                `json:"order"`
-	Format    string             `json:"format"`
-	Content   string             `json:"content"`
-	UserID    int64              `json:"user_id"`
+	Type         domain.ContentType    `json:"type"`
+	ContentID    int64                 `json:"content_id"`
+	Title        string                `json:"title"`
+	Order        int                   `json:"order"`
+	Format       string                `json:"format"`
+	Content      string                `json:"content"`
+	Questions    []domain.QuizQuestion `json:"questions"`
+	MaxAttempts  int                   `json:"max_attempts"`
+	PassingScore int                   `json:"passing_score"`
+	Instructions string                `json:"instructions"`
+	DueAt        *time.Time            `json:"due_at"`
+	MaxPoints    int                   `json:"max_points"`
+	UserID       int64                 `json:"user_id"`
 }
 
 func (c *UpdateContentCommand) Validate(v *validation.Validator) {
@@ -216,6 +350,13 @@ func (c *UpdateContentCommand) Validate(v *validation.Validator) {
 			v.Field(c.Content, "content").Required()
 		}
 	}
+	if c.Type == domain.ContentTypeQuiz {
+		validateQuiz(v, c.Questions, c.MaxAttempts, c.PassingScore)
+	}
+	if c.Type == domain.ContentTypeAssignment {
+		v.Field(c.Instructions, "instructions").Required()
+		v.Field(c.MaxPoints, "max_points").Min(1)
+	}
 }
 
 func (s *ContentService) Update(ctx context.Context, cmd *UpdateContentCommand) error {
@@ -228,6 +369,10 @@ func (s *ContentService) Update(ctx context.Context, cmd *UpdateContentCommand)
 		return s.updateReading(ctx, cmd)
 	case domain.ContentTypeFile:
 		return s.updateFile(ctx, cmd)
+	case domain.ContentTypeQuiz:
+		return s.updateQuiz(ctx, cmd)
+	case domain.ContentTypeAssignment:
+		return s.updateAssignment(ctx, cmd)
 	default:
 		return errors.ErrInvalidInput
 	}
@@ -248,7 +393,7 @@ func (s *ContentService) updateReading(ctx context.Context, cmd *UpdateContentCo
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrNotFound
 	}
 
@@ -257,6 +402,8 @@ func (s *ContentService) updateReading(ctx context.Context, cmd *UpdateContentCo
 		return errors.ErrInvalidInput
 	}
 
+	changed := reading.Format != format || reading.Content == nil || *reading.Content != cmd.Content
+
 	reading.Title = cmd.Title
 	reading.Order = cmd.Order
 	reading.Format = format
@@ -264,6 +411,11 @@ func (s *ContentService) updateReading(ctx context.Context, cmd *UpdateContentCo
 	if err := s.Readings.Update(ctx, reading); err != nil {
 		return err
 	}
+	if changed {
+		if err := s.recordRevision(ctx, reading, cmd.UserID); err != nil {
+			return err
+		}
+	}
 
 	event := domain.NewContentUpdatedEvent(domain.ContentTypeReading, reading.ID, reading.ModuleID, module.CourseID, course.InstructorID)
 	_ = s.Events.Publish(ctx, event)
@@ -286,7 +438,7 @@ func (s *ContentService) updateFile(ctx context.Context, cmd *UpdateContentComma
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrForbidden
 	}
 
@@ -302,6 +454,78 @@ func (s *ContentService) updateFile(ctx context.Context, cmd *UpdateContentComma
 	return nil
 }
 
+func (s *ContentService) updateQuiz(ctx context.Context, cmd *UpdateContentCommand) error {
+	quiz, ok := s.Quizzes.GetByID(ctx, cmd.ContentID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, quiz.ModuleID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
+		return errors.ErrForbidden
+	}
+
+	if !isValidQuiz(cmd.Questions) {
+		return errors.ErrInvalidInput
+	}
+
+	quiz.Title = cmd.Title
+	quiz.Order = cmd.Order
+	quiz.Questions = cmd.Questions
+	quiz.MaxAttempts = cmd.MaxAttempts
+	quiz.PassingScore = cmd.PassingScore
+	if err := s.Quizzes.Update(ctx, quiz); err != nil {
+		return err
+	}
+
+	event := domain.NewContentUpdatedEvent(domain.ContentTypeQuiz, quiz.ID, quiz.ModuleID, module.CourseID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+func (s *ContentService) updateAssignment(ctx context.Context, cmd *UpdateContentCommand) error {
+	assignment, ok := s.Assignments.GetByID(ctx, cmd.ContentID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, assignment.ModuleID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
+		return errors.ErrForbidden
+	}
+
+	assignment.Title = cmd.Title
+	assignment.Order = cmd.Order
+	assignment.Instructions = cmd.Instructions
+	assignment.DueAt = cmd.DueAt
+	assignment.MaxPoints = cmd.MaxPoints
+	if err := s.Assignments.Update(ctx, assignment); err != nil {
+		return err
+	}
+
+	event := domain.NewContentUpdatedEvent(domain.ContentTypeAssignment, assignment.ID, assignment.ModuleID, module.CourseID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
 type DeleteContentCommand struct {
 	Type      domain.ContentType `json:"type"`
 	ContentID int64              `json:"content_id"`
@@ -324,6 +548,10 @@ func (s *ContentService) Delete(ctx context.Context, cmd *DeleteContentCommand)
 		return s.deleteReading(ctx, cmd)
 	case domain.ContentTypeFile:
 		return s.deleteFile(ctx, cmd)
+	case domain.ContentTypeQuiz:
+		return s.deleteQuiz(ctx, cmd)
+	case domain.ContentTypeAssignment:
+		return s.deleteAssignment(ctx, cmd)
 	default:
 		return errors.ErrInvalidInput
 	}
@@ -344,7 +572,7 @@ func (s *ContentService) deleteReading(ctx context.Context, cmd *DeleteContentCo
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrNotFound
 	}
 
@@ -373,7 +601,7 @@ func (s *ContentService) deleteFile(ctx context.Context, cmd *DeleteContentComma
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrForbidden
 	}
 
@@ -389,6 +617,64 @@ func (s *ContentService) deleteFile(ctx context.Context, cmd *DeleteContentComma
 	return nil
 }
 
+func (s *ContentService) deleteQuiz(ctx context.Context, cmd *DeleteContentCommand) error {
+	quiz, ok := s.Quizzes.GetByID(ctx, cmd.ContentID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, quiz.ModuleID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
+		return errors.ErrForbidden
+	}
+
+	if err := s.Quizzes.DeleteByID(ctx, cmd.ContentID); err != nil {
+		return err
+	}
+
+	event := domain.NewContentDeletedEvent(domain.ContentTypeQuiz, quiz.ID, quiz.ModuleID, module.CourseID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+func (s *ContentService) deleteAssignment(ctx context.Context, cmd *DeleteContentCommand) error {
+	assignment, ok := s.Assignments.GetByID(ctx, cmd.ContentID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, assignment.ModuleID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
+		return errors.ErrForbidden
+	}
+
+	if err := s.Assignments.DeleteByID(ctx, cmd.ContentID); err != nil {
+		return err
+	}
+
+	event := domain.NewContentDeletedEvent(domain.ContentTypeAssignment, assignment.ID, assignment.ModuleID, module.CourseID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
 type PublishContentCommand struct {
 	Type      domain.ContentType `json:"type"`
 	ContentID int64              `json:"content_id"`
@@ -411,6 +697,10 @@ func (s *ContentService) Publish(ctx context.Context, cmd *PublishContentCommand
 		return s.publishReading(ctx, cmd)
 	case domain.ContentTypeFile:
 		return s.publishFile(ctx, cmd)
+	case domain.ContentTypeQuiz:
+		return s.publishQuiz(ctx, cmd)
+	case domain.ContentTypeAssignment:
+		return s.publishAssignment(ctx, cmd)
 	default:
 		return errors.ErrInvalidInput
 	}
@@ -431,7 +721,7 @@ func (s *ContentService) publishReading(ctx context.Context, cmd *PublishContent
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrNotFound
 	}
 	if reading.Status != domain.ContentStatusDraft {
@@ -464,7 +754,7 @@ func (s *ContentService) publishFile(ctx context.Context, cmd *PublishContentCom
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrForbidden
 	}
 	if file.Status != domain.ContentStatusDraft {
@@ -482,6 +772,72 @@ func (s *ContentService) publishFile(ctx context.Context, cmd *PublishContentCom
 	return nil
 }
 
+func (s *ContentService) publishQuiz(ctx context.Context, cmd *PublishContentCommand) error {
+	quiz, ok := s.Quizzes.GetByID(ctx, cmd.ContentID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, quiz.ModuleID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
+		return errors.ErrForbidden
+	}
+	if quiz.Status != domain.ContentStatusDraft {
+		return errors.ErrInvalidStatusTransition
+	}
+
+	quiz.Status = domain.ContentStatusPublished
+	if err := s.Quizzes.Update(ctx, quiz); err != nil {
+		return err
+	}
+
+	event := domain.NewContentPublishedEvent(domain.ContentTypeQuiz, quiz.ID, quiz.ModuleID, module.CourseID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+func (s *ContentService) publishAssignment(ctx context.Context, cmd *PublishContentCommand) error {
+	assignment, ok := s.Assignments.GetByID(ctx, cmd.ContentID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, assignment.ModuleID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
+		return errors.ErrForbidden
+	}
+	if assignment.Status != domain.ContentStatusDraft {
+		return errors.ErrInvalidStatusTransition
+	}
+
+	assignment.Status = domain.ContentStatusPublished
+	if err := s.Assignments.Update(ctx, assignment); err != nil {
+		return err
+	}
+
+	event := domain.NewContentPublishedEvent(domain.ContentTypeAssignment, assignment.ID, assignment.ModuleID, module.CourseID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
 type UnpublishContentCommand struct {
 	Type      domain.ContentType `json:"type"`
 	ContentID int64              `json:"content_id"`
@@ -504,6 +860,10 @@ func (s *ContentService) Unpublish(ctx context.Context, cmd *UnpublishContentCom
 		return s.unpublishReading(ctx, cmd)
 	case domain.ContentTypeFile:
 		return s.unpublishFile(ctx, cmd)
+	case domain.ContentTypeQuiz:
+		return s.unpublishQuiz(ctx, cmd)
+	case domain.ContentTypeAssignment:
+		return s.unpublishAssignment(ctx, cmd)
 	default:
 		return errors.ErrInvalidInput
 	}
@@ -524,7 +884,7 @@ func (s *ContentService) unpublishReading(ctx context.Context, cmd *UnpublishCon
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrNotFound
 	}
 	if reading.Status != domain.ContentStatusPublished {
@@ -557,7 +917,7 @@ func (s *ContentService) unpublishFile(ctx context.Context, cmd *UnpublishConten
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrForbidden
 	}
 	if file.Status != domain.ContentStatusPublished {
@@ -575,6 +935,72 @@ func (s *ContentService) unpublishFile(ctx context.Context, cmd *UnpublishConten
 	return nil
 }
 
+func (s *ContentService) unpublishQuiz(ctx context.Context, cmd *UnpublishContentCommand) error {
+	quiz, ok := s.Quizzes.GetByID(ctx, cmd.ContentID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, quiz.ModuleID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
+		return errors.ErrForbidden
+	}
+	if quiz.Status != domain.ContentStatusPublished {
+		return errors.ErrInvalidStatusTransition
+	}
+
+	quiz.Status = domain.ContentStatusDraft
+	if err := s.Quizzes.Update(ctx, quiz); err != nil {
+		return err
+	}
+
+	event := domain.NewContentUnpublishedEvent(domain.ContentTypeQuiz, quiz.ID, quiz.ModuleID, module.CourseID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+func (s *ContentService) unpublishAssignment(ctx context.Context, cmd *UnpublishContentCommand) error {
+	assignment, ok := s.Assignments.GetByID(ctx, cmd.ContentID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, assignment.ModuleID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
+		return errors.ErrForbidden
+	}
+	if assignment.Status != domain.ContentStatusPublished {
+		return errors.ErrInvalidStatusTransition
+	}
+
+	assignment.Status = domain.ContentStatusDraft
+	if err := s.Assignments.Update(ctx, assignment); err != nil {
+		return err
+	}
+
+	event := domain.NewContentUnpublishedEvent(domain.ContentTypeAssignment, assignment.ID, assignment.ModuleID, module.CourseID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
 type ListContentQuery struct {
 	ModuleID        int64             `json:"module_id"`
 	UserID          int64             `json:"user_id"`
@@ -593,11 +1019,15 @@ func (s *ContentService) List(ctx context.Context, query *ListContentQuery) ([]d
 		return nil, errors.ErrNotFound
 	}
 
-	if query.UserRole == domain.SystemRoleAdmin {
-	} else if course.InstructorID == query.UserID {
-	} else if query.EnrolledLearner {
-	} else {
-		return nil, errors.ErrForbidden
+	actor := policy.Actor{UserID: query.UserID, Role: query.UserRole}
+	staff := s.Authorizer.Can(ctx, actor, course, policy.ViewCourse)
+	if !staff {
+		if !query.EnrolledLearner {
+			return nil, errors.ErrForbidden
+		}
+		if err := s.gate().check(ctx, query.UserID, module); err != nil {
+			return nil, err
+		}
 	}
 
 	readings, err := s.Readings.ListByModuleID(ctx, query.ModuleID)
@@ -610,7 +1040,17 @@ func (s *ContentService) List(ctx context.Context, query *ListContentQuery) ([]d
 		return nil, err
 	}
 
-	items := make([]domain.ContentItem, 0, len(readings)+len(files))
+	quizzes, err := s.Quizzes.ListByModuleID(ctx, query.ModuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.Assignments.ListByModuleID(ctx, query.ModuleID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]domain.ContentItem, 0, len(readings)+len(files)+len(quizzes)+len(assignments))
 
 	for i := range readings {
 		if query.EnrolledLearner && readings[i].Status != domain.ContentStatusPublished {
@@ -626,6 +1066,24 @@ func (s *ContentService) List(ctx context.Context, query *ListContentQuery) ([]d
 		items = append(items, &files[i])
 	}
 
+	for i := range quizzes {
+		if query.EnrolledLearner && quizzes[i].Status != domain.ContentStatusPublished {
+			continue
+		}
+		if !staff {
+			items = append(items, quizzes[i].WithoutAnswers())
+			continue
+		}
+		items = append(items, &quizzes[i])
+	}
+
+	for i := range assignments {
+		if query.EnrolledLearner && assignments[i].Status != domain.ContentStatusPublished {
+			continue
+		}
+		items = append(items, &assignments[i])
+	}
+
 	sort.SliceStable(items, func(i, j int) bool {
 		return getOrder(items[i]) < getOrder(items[j])
 	})
@@ -639,6 +1097,10 @@ func getOrder(item domain.ContentItem) int {
 		return v.Order
 	case *domain.File:
 		return v.Order
+	case *domain.Quiz:
+		return v.Order
+	case *domain.Assignment:
+		return v.Order
 	default:
 		return 0
 	}
@@ -663,33 +1125,48 @@ func (s *ContentService) Get(ctx context.Context, query *GetContentQuery) (domai
 		return nil, errors.ErrNotFound
 	}
 
-	if query.UserRole == domain.SystemRoleAdmin {
-	} else if course.InstructorID == query.UserID {
-	} else if query.EnrolledLearner {
-	} else {
-		return nil, errors.ErrForbidden
+	actor := policy.Actor{UserID: query.UserID, Role: query.UserRole}
+	staff := s.Authorizer.Can(ctx, actor, course, policy.ViewCourse)
+	if !staff {
+		if !query.EnrolledLearner {
+			return nil, errors.ErrForbidden
+		}
+		if err := s.gate().check(ctx, query.UserID, module); err != nil {
+			return nil, err
+		}
 	}
 
-	if reading, ok := s.Readings.GetByID(ctx, query.ContentID); ok {
-		if reading.ModuleID != query.ModuleID {
-			return nil, errors.ErrNotFound
-		}
+	if reading, ok := s.Readings.GetByID(ctx, query.ContentID); ok && reading.ModuleID == query.ModuleID {
 		if query.EnrolledLearner && reading.Status != domain.ContentStatusPublished {
 			return nil, errors.ErrNotFound
 		}
 		return reading, nil
 	}
 
-	if file, ok := s.Files.GetByID(ctx, query.ContentID); ok {
-		if file.ModuleID != query.ModuleID {
-			return nil, errors.ErrNotFound
-		}
+	if file, ok := s.Files.GetByID(ctx, query.ContentID); ok && file.ModuleID == query.ModuleID {
 		if query.EnrolledLearner && file.Status != domain.ContentStatusPublished {
 			return nil, errors.ErrNotFound
 		}
 		return file, nil
 	}
 
+	if quiz, ok := s.Quizzes.GetByID(ctx, query.ContentID); ok && quiz.ModuleID == query.ModuleID {
+		if query.EnrolledLearner && quiz.Status != domain.ContentStatusPublished {
+			return nil, errors.ErrNotFound
+		}
+		if !staff {
+			return quiz.WithoutAnswers(), nil
+		}
+		return quiz, nil
+	}
+
+	if assignment, ok := s.Assignments.GetByID(ctx, query.ContentID); ok && assignment.ModuleID == query.ModuleID {
+		if query.EnrolledLearner && assignment.Status != domain.ContentStatusPublished {
+			return nil, errors.ErrNotFound
+		}
+		return assignment, nil
+	}
+
 	return nil, errors.ErrNotFound
 }
 
@@ -724,16 +1201,197 @@ func (s *ContentService) GetFileForDownload(ctx context.Context, query *GetFileF
 		return nil, errors.ErrNotFound
 	}
 
-	if query.UserRole == domain.SystemRoleAdmin {
-	} else if course.InstructorID == query.UserID {
-	} else if query.EnrolledLearner {
-	} else {
+	actor := policy.Actor{UserID: query.UserID, Role: query.UserRole}
+	if !query.EnrolledLearner && !s.Authorizer.Can(ctx, actor, course, policy.ViewCourse) {
 		return nil, errors.ErrForbidden
 	}
 
-	if query.EnrolledLearner && file.Status != domain.ContentStatusPublished {
-		return nil, errors.ErrNotFound
+	if query.EnrolledLearner {
+		if file.Status != domain.ContentStatusPublished {
+			return nil, errors.ErrNotFound
+		}
+		if err := s.gate().check(ctx, query.UserID, module); err != nil {
+			return nil, err
+		}
 	}
 
 	return file, nil
 }
+
+type SubmitQuizAttemptCommand struct {
+	QuizID   int64               `json:"quiz_id"`
+	ModuleID int64               `json:"module_id"`
+	UserID   int64               `json:"user_id"`
+	Answers  []domain.QuizAnswer `json:"answers"`
+}
+
+func (c *SubmitQuizAttemptCommand) Validate(v *validation.Validator) {
+	v.Field(c.QuizID, "quiz_id").EntityID()
+	v.Field(c.ModuleID, "module_id").EntityID()
+	v.Field(c.UserID, "user_id").EntityID()
+	v.Field(len(c.Answers), "answers").Min(1)
+}
+
+func (s *ContentService) SubmitQuizAttempt(ctx context.Context, cmd *SubmitQuizAttemptCommand) (*domain.QuizAttempt, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+
+	quiz, module, err := s.learnerQuiz(ctx, cmd.QuizID, cmd.ModuleID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cmd.Answers) != len(quiz.Questions) {
+		return nil, errors.ErrInvalidInput
+	}
+
+	score := gradeQuiz(quiz, cmd.Answers)
+	maxScore := quiz.TotalPoints()
+
+	attempt := domain.QuizAttempt{
+		QuizID:   quiz.ID,
+		UserID:   cmd.UserID,
+		Answers:  cmd.Answers,
+		Score:    score,
+		MaxScore: maxScore,
+		Passed:   maxScore > 0 && score*100 >= quiz.PassingScore*maxScore,
+	}
+	if err := s.QuizAttempts.Create(ctx, &attempt, quiz.MaxAttempts); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewQuizAttemptSubmittedEvent(attempt.ID, quiz.ID, quiz.ModuleID, module.CourseID, cmd.UserID, attempt.Score, attempt.MaxScore, attempt.Passed)
+	_ = s.Events.Publish(ctx, event)
+
+	return &attempt, nil
+}
+
+type ListQuizAttemptsQuery struct {
+	QuizID   int64 `json:"quiz_id"`
+	ModuleID int64 `json:"module_id"`
+	UserID   int64 `json:"user_id"`
+}
+
+func (s *ContentService) ListQuizAttempts(ctx context.Context, query *ListQuizAttemptsQuery) ([]domain.QuizAttempt, error) {
+	quiz, _, err := s.learnerQuiz(ctx, query.QuizID, query.ModuleID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.QuizAttempts.ListByQuizAndUser(ctx, quiz.ID, query.UserID)
+}
+
+// learnerQuiz loads a quiz for an enrolled learner, requiring it and its
+// module to be published and the module to be unlocked for them.
+func (s *ContentService) learnerQuiz(ctx context.Context, quizID, moduleID, userID int64) (*domain.Quiz, *domain.Module, error) {
+	quiz, ok := s.Quizzes.GetByID(ctx, quizID)
+	if !ok || quiz.ModuleID != moduleID {
+		return nil, nil, errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, quiz.ModuleID)
+	if !ok {
+		return nil, nil, errors.ErrNotFound
+	}
+
+	if _, ok := s.Enrollments.GetByUserAndCourse(ctx, userID, module.CourseID); !ok {
+		return nil, nil, errors.ErrForbidden
+	}
+	if module.Status != domain.ModuleStatusPublished || quiz.Status != domain.ContentStatusPublished {
+		return nil, nil, errors.ErrNotFound
+	}
+	if err := s.gate().check(ctx, userID, module); err != nil {
+		return nil, nil, err
+	}
+
+	return quiz, module, nil
+}
+
+func validateQuiz(v *validation.Validator, questions []domain.QuizQuestion, maxAttempts, passingScore int) {
+	v.Field(len(questions), "questions").Min(1)
+	v.Field(maxAttempts, "max_attempts").Min(0)
+	v.Field(passingScore, "passing_score").Min(0).Max(100)
+	for i, q := range questions {
+		field := "questions[" + strconv.Itoa(i) + "]"
+		v.Field(q.Prompt, field+".prompt").Required().MaxLength(2048).IsTrimmed()
+		v.Field(string(q.Type), field+".type").Required()
+		v.Field(q.Points, field+".points").Min(1)
+		for _, option := range q.Options {
+			v.Field(option, field+".options").Required().MaxLength(1024).IsTrimmed()
+		}
+		for _, answer := range q.AcceptedAnswers {
+			v.Field(answer, field+".accepted_answers").Required().MaxLength(1024).IsTrimmed()
+		}
+		switch q.Type {
+		case domain.QuestionTypeMultipleChoice:
+			v.Field(len(q.Options), field+".options").Min(2)
+			v.Field(len(q.CorrectOptions), field+".correct_options").Min(1).Max(1)
+		case domain.QuestionTypeMultiSelect:
+			v.Field(len(q.Options), field+".options").Min(2)
+			v.Field(len(q.CorrectOptions), field+".correct_options").Min(1)
+		case domain.QuestionTypeShortAnswer:
+			v.Field(len(q.AcceptedAnswers), field+".accepted_answers").Min(1)
+		}
+	}
+}
+
+func isValidQuiz(questions []domain.QuizQuestion) bool {
+	for _, q := range questions {
+		switch q.Type {
+		case domain.QuestionTypeMultipleChoice, domain.QuestionTypeMultiSelect:
+			seen := make(map[int]bool, len(q.CorrectOptions))
+			for _, idx := range q.CorrectOptions {
+				if idx < 0 || idx >= len(q.Options) || seen[idx] {
+					return false
+				}
+				seen[idx] = true
+			}
+		case domain.QuestionTypeShortAnswer:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func gradeQuiz(quiz *domain.Quiz, answers []domain.QuizAnswer) int {
+	score := 0
+	for i, question := range quiz.Questions {
+		if i < len(answers) && isCorrectAnswer(question, answers[i]) {
+			score += question.Points
+		}
+	}
+	return score
+}
+
+func isCorrectAnswer(question domain.QuizQuestion, answer domain.QuizAnswer) bool {
+	switch question.Type {
+	case domain.QuestionTypeMultipleChoice, domain.QuestionTypeMultiSelect:
+		selected := make(map[int]bool, len(answer.SelectedOptions))
+		for _, idx := range answer.SelectedOptions {
+			selected[idx] = true
+		}
+		if len(selected) != len(question.CorrectOptions) {
+			return false
+		}
+		for _, idx := range question.CorrectOptions {
+			if !selected[idx] {
+				return false
+			}
+		}
+		return true
+	case domain.QuestionTypeShortAnswer:
+		given := normalizeAnswer(answer.Text)
+		for _, accepted := range question.AcceptedAnswers {
+			if normalizeAnswer(accepted) == given {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func normalizeAnswer(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
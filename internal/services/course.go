@@ -2,11 +2,19 @@ package services
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"time"
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/infrastructure/storage"
 	"bytecourses/internal/pkg/errors"
 	"bytecourses/internal/pkg/events"
+	"bytecourses/internal/pkg/policy"
 	"bytecourses/internal/pkg/validation"
 )
 
@@ -14,29 +22,59 @@ var (
 	_ Command = (*CreateCourseFromProposalCommand)(nil)
 	_ Command = (*UpdateCourseCommand)(nil)
 	_ Command = (*PublishCourseCommand)(nil)
+	_ Query   = (*ListCoursesQuery)(nil)
+)
+
+const (
+	defaultCoursePageSize = 24
+	maxCoursePageSize     = 100
 )
 
 type CourseService struct {
-	Courses   persistence.CourseRepository
-	Proposals persistence.ProposalRepository
-	Events    events.EventBus
+	Courses     persistence.CourseRepository
+	Proposals   persistence.ProposalRepository
+	Modules     persistence.ModuleRepository
+	Readings    persistence.ReadingRepository
+	Files       persistence.FileRepository
+	Members     persistence.CourseMemberRepository
+	Users       persistence.UserRepository
+	Authorizer  *policy.Authorizer
+	Events      events.EventBus
+	FileStorage storage.FileStorage
 }
 
 func NewCourseService(
 	courses persistence.CourseRepository,
 	proposals persistence.ProposalRepository,
+	modules persistence.ModuleRepository,
+	readings persistence.ReadingRepository,
+	files persistence.FileRepository,
+	members persistence.CourseMemberRepository,
+	users persistence.UserRepository,
+	authorizer *policy.Authorizer,
 	eventBus events.EventBus,
+	fileStorage storage.FileStorage,
 ) *CourseService {
 	return &CourseService{
-		Courses:   courses,
-		Proposals: proposals,
-		Events:    eventBus,
+		Courses:     courses,
+		Proposals:   proposals,
+		Modules:     modules,
+		Readings:    readings,
+		Files:       files,
+		Members:     members,
+		Users:       users,
+		Authorizer:  authorizer,
+		Events:      eventBus,
+		FileStorage: fileStorage,
 	}
 }
 
 type CreateCourseFromProposalCommand struct {
 	ProposalID int64 `json:"proposal_id"`
-	UserID     int64 `json:"user_id"`
+	// CopyAttachments carries the proposal's attachments over into the course
+	// as draft files.
+	CopyAttachments bool  `json:"copy_attachments"`
+	UserID          int64 `json:"user_id"`
 }
 
 func (c *CreateCourseFromProposalCommand) Validate(v *validation.Validator) {
@@ -72,12 +110,148 @@ func (s *CourseService) CreateFromProposal(ctx context.Context, cmd *CreateCours
 		return nil, err
 	}
 
+	owner := domain.CourseMember{
+		CourseID: course.ID,
+		UserID:   course.InstructorID,
+		Role:     domain.CourseRoleOwner,
+	}
+	if err := s.Members.AddMember(ctx, &owner); err != nil {
+		return nil, err
+	}
+
+	if err := s.scaffoldOutline(ctx, course, proposal.OutlineModules); err != nil {
+		return nil, err
+	}
+
+	if cmd.CopyAttachments {
+		s.copyAttachments(ctx, course, proposal, len(proposal.OutlineModules)+1)
+	}
+
 	event := domain.NewCourseCreatedEvent(course.ID, cmd.UserID)
 	_ = s.Events.Publish(ctx, event)
 
 	return course, nil
 }
 
+// scaffoldOutline creates a draft module for each module in the proposal's
+// outline, holding a placeholder reading for each planned item. The course
+// created event that follows reindexes the whole course, so no per-module
+// events are published.
+func (s *CourseService) scaffoldOutline(ctx context.Context, course *domain.Course, outline []domain.OutlineModule) error {
+	for i, planned := range outline {
+		module := domain.Module{
+			CourseID:    course.ID,
+			Title:       planned.Title,
+			Description: planned.Description,
+			Order:       i + 1,
+			Status:      domain.ModuleStatusDraft,
+			UnlockRule:  domain.ModuleUnlockImmediately,
+		}
+		if err := s.Modules.Create(ctx, &module); err != nil {
+			return err
+		}
+
+		for j, item := range planned.Items {
+			content := "# " + item + "\n"
+			reading := domain.Reading{
+				BaseContentItem: domain.BaseContentItem{
+					ModuleID: module.ID,
+					Title:    item,
+					Order:    j + 1,
+					Status:   domain.ContentStatusDraft,
+				},
+				Format:  domain.ReadingFormatMarkdown,
+				Content: &content,
+			}
+			if err := s.Readings.Create(ctx, &reading); err != nil {
+				return err
+			}
+
+			revision := domain.ReadingRevision{
+				ReadingID: reading.ID,
+				AuthorID:  course.InstructorID,
+				Format:    reading.Format,
+				Content:   content,
+			}
+			if err := s.Readings.CreateRevision(ctx, &revision); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// copyAttachments adds a draft module at the given position holding a draft
+// file for each of the proposal's attachments. Nothing is created when the
+// proposal has no attachments. Copying is best effort: the course already
+// exists, so failures are logged and the affected files skipped.
+func (s *CourseService) copyAttachments(ctx context.Context, course *domain.Course, proposal *domain.Proposal, order int) {
+	attachments, err := s.Proposals.ListAttachments(ctx, proposal.ID)
+	if err != nil {
+		slog.Warn("failed to list proposal attachments", "proposal_id", proposal.ID, "course_id", course.ID, "error", err)
+		return
+	}
+	if len(attachments) == 0 {
+		return
+	}
+
+	module := domain.Module{
+		CourseID:    course.ID,
+		Title:       "Proposal Materials",
+		Description: "Files attached to the course proposal.",
+		Order:       order,
+		Status:      domain.ModuleStatusDraft,
+		UnlockRule:  domain.ModuleUnlockImmediately,
+	}
+	if err := s.Modules.Create(ctx, &module); err != nil {
+		slog.Warn("failed to create proposal materials module", "proposal_id", proposal.ID, "course_id", course.ID, "error", err)
+		return
+	}
+
+	copied := 0
+	for _, attachment := range attachments {
+		if err := s.copyAttachment(ctx, course, &module, &attachment, copied+1); err != nil {
+			slog.Warn("failed to copy proposal attachment", "attachment_id", attachment.ID, "course_id", course.ID, "error", err)
+			continue
+		}
+		copied++
+	}
+}
+
+func (s *CourseService) copyAttachment(ctx context.Context, course *domain.Course, module *domain.Module, attachment *domain.ProposalAttachment, order int) error {
+	content, err := s.FileStorage.Read(ctx, attachment.StoragePath)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	storageName := fmt.Sprintf("%d/%d_%d%s", module.ID, time.Now().UnixNano(), course.InstructorID, filepath.Ext(attachment.FileName))
+	storagePath, err := s.FileStorage.Save(ctx, storageName, content)
+	if err != nil {
+		return err
+	}
+
+	file := domain.File{
+		BaseContentItem: domain.BaseContentItem{
+			ModuleID: module.ID,
+			Title:    attachment.FileName,
+			Order:    order,
+			Status:   domain.ContentStatusDraft,
+		},
+		FileName:    storageName,
+		FileSize:    attachment.FileSize,
+		MimeType:    attachment.MimeType,
+		StoragePath: storagePath,
+	}
+	if err := s.Files.Create(ctx, &file); err != nil {
+		s.FileStorage.Delete(ctx, storagePath)
+		return err
+	}
+
+	return nil
+}
+
 type UpdateCourseCommand struct {
 	CourseID             int64  `json:"course_id"`
 	Title                string `json:"title"`
@@ -107,7 +281,7 @@ func (s *CourseService) Update(ctx context.Context, cmd *UpdateCourseCommand) er
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrNotFound
 	}
 
@@ -145,7 +319,7 @@ func (s *CourseService) Publish(ctx context.Context, cmd *PublishCourseCommand)
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrNotFound
 	}
 	if course.Status != domain.CourseStatusDraft {
@@ -178,19 +352,95 @@ func (s *CourseService) Get(ctx context.Context, query *GetCourseQuery) (*domain
 		return course, nil
 	}
 
-	if query.UserRole == domain.SystemRoleAdmin {
+	actor := policy.Actor{UserID: query.UserID, Role: query.UserRole}
+	if s.Authorizer.Can(ctx, actor, course, policy.ViewCourse) {
 		return course, nil
 	}
 
-	if course.InstructorID == query.UserID {
-		return course, nil
+	return nil, errors.ErrNotFound
+}
+
+type ListCoursesQuery struct {
+	Sort         domain.CourseSort `json:"sort"`
+	InstructorID int64             `json:"instructor_id"`
+	Enrolled     bool              `json:"enrolled"`
+	Cursor       string            `json:"cursor"`
+	Limit        int               `json:"limit"`
+	UserID       int64             `json:"user_id"`
+}
+
+func (q *ListCoursesQuery) Validate(v *validation.Validator) {
+	v.Field(q.Limit, "limit").Min(0).Max(maxCoursePageSize)
+	v.Field(q.Cursor, "cursor").MaxLength(512)
+}
+
+type CoursePage struct {
+	Courses    []domain.Course `json:"courses"`
+	NextCursor string          `json:"next_cursor"`
+}
+
+func (s *CourseService) List(ctx context.Context, query *ListCoursesQuery) (*CoursePage, error) {
+	if err := validation.Validate(query); err != nil {
+		return nil, err
 	}
 
-	return nil, errors.ErrNotFound
+	switch query.Sort {
+	case "":
+		query.Sort = domain.CourseSortNewest
+	case domain.CourseSortNewest, domain.CourseSortMostEnrolled, domain.CourseSortTitle:
+	default:
+		return nil, errors.ErrInvalidInput
+	}
+
+	filter := domain.CourseFilter{
+		Sort:         query.Sort,
+		InstructorID: query.InstructorID,
+		Limit:        query.Limit,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultCoursePageSize
+	}
+	if query.Enrolled {
+		if query.UserID == 0 {
+			return nil, errors.ErrUnauthorized
+		}
+		filter.EnrolledUserID = query.UserID
+	}
+	if query.Cursor != "" {
+		cursor, err := decodeCourseCursor(query.Cursor)
+		if err != nil || cursor.Sort != query.Sort {
+			return nil, errors.ErrInvalidInput
+		}
+		filter.After = cursor
+	}
+
+	courses, next, err := s.Courses.ListLive(ctx, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := CoursePage{Courses: courses}
+	if next != nil {
+		page.NextCursor = encodeCourseCursor(next)
+	}
+	return &page, nil
+}
+
+func encodeCourseCursor(cursor *domain.CourseCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
 }
 
-func (s *CourseService) List(ctx context.Context) ([]domain.Course, error) {
-	return s.Courses.ListAllLive(ctx)
+func decodeCourseCursor(s string) (*domain.CourseCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var cursor domain.CourseCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
 }
 
 func (s *CourseService) GetByProposalID(ctx context.Context, proposalID int64) (*domain.Course, bool) {
@@ -0,0 +1,282 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/policy"
+	"bytecourses/internal/pkg/validation"
+)
+
+var (
+	_ Command = (*AddCourseMemberCommand)(nil)
+	_ Command = (*UpdateCourseMemberCommand)(nil)
+	_ Command = (*RemoveCourseMemberCommand)(nil)
+)
+
+type CourseMemberView struct {
+	UserID    int64             `json:"user_id"`
+	Name      string            `json:"name"`
+	Email     string            `json:"email"`
+	Role      domain.CourseRole `json:"role"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+type ListCourseMembersQuery struct {
+	CourseID int64             `json:"course_id"`
+	UserID   int64             `json:"user_id"`
+	UserRole domain.SystemRole `json:"user_role"`
+}
+
+func (s *CourseService) ListMembers(ctx context.Context, query *ListCourseMembersQuery) ([]CourseMemberView, error) {
+	course, ok := s.Courses.GetByID(ctx, query.CourseID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	actor := policy.Actor{UserID: query.UserID, Role: query.UserRole}
+	if !s.Authorizer.Can(ctx, actor, course, policy.ViewCourse) {
+		return nil, errors.ErrNotFound
+	}
+
+	members, err := s.Members.ListMembers(ctx, course.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Courses created before memberships existed have no owner row.
+	hasOwner := false
+	for i := range members {
+		if members[i].UserID == course.InstructorID {
+			hasOwner = true
+			break
+		}
+	}
+	if !hasOwner {
+		owner := domain.CourseMember{
+			CourseID:  course.ID,
+			UserID:    course.InstructorID,
+			Role:      domain.CourseRoleOwner,
+			CreatedAt: course.CreatedAt,
+		}
+		members = append([]domain.CourseMember{owner}, members...)
+	}
+
+	views := make([]CourseMemberView, 0, len(members))
+	for _, m := range members {
+		view := CourseMemberView{
+			UserID:    m.UserID,
+			Role:      m.Role,
+			CreatedAt: m.CreatedAt,
+		}
+		if user, ok := s.Users.GetByID(ctx, m.UserID); ok {
+			view.Name = user.Name
+			view.Email = user.Email
+		}
+		views = append(views, view)
+	}
+
+	return views, nil
+}
+
+type ListTeachingQuery struct {
+	UserID int64 `json:"user_id"`
+}
+
+type TeachingCourse struct {
+	Course domain.Course     `json:"course"`
+	Role   domain.CourseRole `json:"role"`
+}
+
+func (s *CourseService) ListTeaching(ctx context.Context, query *ListTeachingQuery) ([]TeachingCourse, error) {
+	memberships, err := s.Members.ListMembershipsByUser(ctx, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	courses := make([]TeachingCourse, 0, len(memberships))
+	for _, m := range memberships {
+		course, ok := s.Courses.GetByID(ctx, m.CourseID)
+		if !ok {
+			continue
+		}
+		courses = append(courses, TeachingCourse{Course: *course, Role: m.Role})
+	}
+
+	return courses, nil
+}
+
+type AddCourseMemberCommand struct {
+	CourseID int64             `json:"course_id"`
+	Email    string            `json:"email"`
+	Role     domain.CourseRole `json:"role"`
+	UserID   int64             `json:"user_id"`
+	UserRole domain.SystemRole `json:"user_role"`
+}
+
+func (c *AddCourseMemberCommand) Validate(v *validation.Validator) {
+	v.Field(c.CourseID, "course_id").EntityID()
+	v.Field(c.Email, "email").Required().Email()
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+func (s *CourseService) AddMember(ctx context.Context, cmd *AddCourseMemberCommand) (*CourseMemberView, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+	if err := validateAssignableCourseRole(cmd.Role); err != nil {
+		return nil, err
+	}
+
+	course, err := s.memberManagedCourse(ctx, cmd.CourseID, cmd.UserID, cmd.UserRole)
+	if err != nil {
+		return nil, err
+	}
+
+	user, ok := s.Users.GetByEmail(ctx, cmd.Email)
+	if !ok {
+		errs := errors.NewValidationErrors()
+		errs.Add("email", "does not belong to a ByteCourses account")
+		return nil, errs
+	}
+	if user.ID == course.InstructorID {
+		return nil, errors.ErrConflict
+	}
+
+	member := domain.CourseMember{
+		CourseID: course.ID,
+		UserID:   user.ID,
+		Role:     cmd.Role,
+	}
+	if err := s.Members.AddMember(ctx, &member); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewCourseMemberAddedEvent(course.ID, user.ID, member.Role, cmd.UserID)
+	_ = s.Events.Publish(ctx, event)
+
+	return &CourseMemberView{
+		UserID:    user.ID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt,
+	}, nil
+}
+
+type UpdateCourseMemberCommand struct {
+	CourseID int64             `json:"course_id"`
+	MemberID int64             `json:"member_id"`
+	Role     domain.CourseRole `json:"role"`
+	UserID   int64             `json:"user_id"`
+	UserRole domain.SystemRole `json:"user_role"`
+}
+
+func (c *UpdateCourseMemberCommand) Validate(v *validation.Validator) {
+	v.Field(c.CourseID, "course_id").EntityID()
+	v.Field(c.MemberID, "member_id").EntityID()
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+func (s *CourseService) UpdateMember(ctx context.Context, cmd *UpdateCourseMemberCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+	if err := validateAssignableCourseRole(cmd.Role); err != nil {
+		return err
+	}
+
+	course, err := s.memberManagedCourse(ctx, cmd.CourseID, cmd.UserID, cmd.UserRole)
+	if err != nil {
+		return err
+	}
+	if cmd.MemberID == course.InstructorID {
+		return errors.ErrForbidden
+	}
+
+	if err := s.Members.UpdateMemberRole(ctx, course.ID, cmd.MemberID, cmd.Role); err != nil {
+		return err
+	}
+
+	event := domain.NewCourseMemberUpdatedEvent(course.ID, cmd.MemberID, cmd.Role, cmd.UserID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+type RemoveCourseMemberCommand struct {
+	CourseID int64             `json:"course_id"`
+	MemberID int64             `json:"member_id"`
+	UserID   int64             `json:"user_id"`
+	UserRole domain.SystemRole `json:"user_role"`
+}
+
+func (c *RemoveCourseMemberCommand) Validate(v *validation.Validator) {
+	v.Field(c.CourseID, "course_id").EntityID()
+	v.Field(c.MemberID, "member_id").EntityID()
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+// RemoveMember takes a co-instructor or TA off the course. Members may also
+// remove themselves; the owner cannot be removed.
+func (s *CourseService) RemoveMember(ctx context.Context, cmd *RemoveCourseMemberCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	var course *domain.Course
+	if cmd.MemberID == cmd.UserID {
+		c, ok := s.Courses.GetByID(ctx, cmd.CourseID)
+		if !ok {
+			return errors.ErrNotFound
+		}
+		course = c
+	} else {
+		c, err := s.memberManagedCourse(ctx, cmd.CourseID, cmd.UserID, cmd.UserRole)
+		if err != nil {
+			return err
+		}
+		course = c
+	}
+	if cmd.MemberID == course.InstructorID {
+		return errors.ErrForbidden
+	}
+
+	if err := s.Members.RemoveMember(ctx, course.ID, cmd.MemberID); err != nil {
+		return err
+	}
+
+	event := domain.NewCourseMemberRemovedEvent(course.ID, cmd.MemberID, cmd.UserID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+func (s *CourseService) memberManagedCourse(ctx context.Context, courseID, userID int64, role domain.SystemRole) (*domain.Course, error) {
+	course, ok := s.Courses.GetByID(ctx, courseID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	actor := policy.Actor{UserID: userID, Role: role}
+	if !s.Authorizer.Can(ctx, actor, course, policy.ManageCourseMembers) {
+		if s.Authorizer.Can(ctx, actor, course, policy.ViewCourse) {
+			return nil, errors.ErrForbidden
+		}
+		return nil, errors.ErrNotFound
+	}
+
+	return course, nil
+}
+
+func validateAssignableCourseRole(role domain.CourseRole) error {
+	if role == domain.CourseRoleCoInstructor || role == domain.CourseRoleTA {
+		return nil
+	}
+
+	errs := errors.NewValidationErrors()
+	errs.Add("role", "must be co_instructor or ta")
+	return errs
+}
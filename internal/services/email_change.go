@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/auth"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/validation"
+)
+
+var (
+	_ Command = (*RequestEmailChangeCommand)(nil)
+	_ Command = (*ConfirmEmailChangeCommand)(nil)
+)
+
+const emailChangeTokenTTL = 24 * time.Hour
+
+type RequestEmailChangeCommand struct {
+	UserID          int64  `json:"-"`
+	NewEmail        string `json:"new_email"`
+	CurrentPassword string `json:"current_password"`
+	BaseURL         string `json:"-"`
+}
+
+func (c *RequestEmailChangeCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.NewEmail, "new_email").Required().Email()
+	v.Field(c.CurrentPassword, "current_password").Required()
+}
+
+func (s *AuthService) RequestEmailChange(ctx context.Context, cmd *RequestEmailChangeCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if err := s.checkCurrentPassword(ctx, user, cmd.CurrentPassword); err != nil {
+		return err
+	}
+	if strings.EqualFold(cmd.NewEmail, user.Email) {
+		errs := errors.NewValidationErrors()
+		errs.Add("new_email", "must differ from your current email address")
+		return errs
+	}
+	if _, found := s.Users.GetByEmail(ctx, cmd.NewEmail); found {
+		return errors.ErrConflict
+	}
+
+	token, err := auth.GenerateToken()
+	if err != nil {
+		return err
+	}
+
+	tokenHash := auth.HashToken(token)
+	expiresAt := time.Now().Add(emailChangeTokenTTL)
+	if err := s.EmailChanges.CreateEmailChangeToken(ctx, user.ID, cmd.NewEmail, tokenHash[:], expiresAt); err != nil {
+		return err
+	}
+
+	confirmURL := cmd.BaseURL + "/confirm-email-change"
+	event := domain.NewEmailChangeRequestedEvent(user.ID, user.Name, user.Email, cmd.NewEmail, confirmURL, token)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+type ConfirmEmailChangeCommand struct {
+	Token string `json:"token"`
+}
+
+func (c *ConfirmEmailChangeCommand) Validate(v *validation.Validator) {
+	v.Field(c.Token, "token").Required().IsTrimmed()
+}
+
+func (s *AuthService) ConfirmEmailChange(ctx context.Context, cmd *ConfirmEmailChangeCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	hash := auth.HashToken(cmd.Token)
+
+	userID, newEmail, ok := s.EmailChanges.ConsumeEmailChangeToken(ctx, hash[:], time.Now())
+	if !ok {
+		return errors.ErrInvalidToken
+	}
+	user, ok := s.Users.GetByID(ctx, userID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	// The address may have been registered since the change was requested.
+	if _, found := s.Users.GetByEmail(ctx, newEmail); found {
+		return errors.ErrConflict
+	}
+
+	// Following the link proves ownership of the new address.
+	oldEmail := user.Email
+	now := time.Now()
+	user.Email = newEmail
+	user.VerifiedAt = &now
+	if err := s.Users.Update(ctx, user); err != nil {
+		return err
+	}
+
+	event := domain.NewEmailChangedEvent(user.ID, user.Name, oldEmail, newEmail)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
@@ -13,29 +13,58 @@ import (
 var (
 	_ Command = (*EnrollCommand)(nil)
 	_ Command = (*UnenrollCommand)(nil)
+	_ Command = (*MarkContentCompleteCommand)(nil)
+	_ Command = (*MarkContentIncompleteCommand)(nil)
+)
+
+var (
+	_ Query = (*GetCourseProgressQuery)(nil)
+	_ Query = (*ListCompletionsQuery)(nil)
 )
 
 type EnrollmentService struct {
 	Enrollments persistence.EnrollmentRepository
+	Progress    persistence.ProgressRepository
 	Courses     persistence.CourseRepository
+	Modules     persistence.ModuleRepository
+	Readings    persistence.ReadingRepository
+	Files       persistence.FileRepository
 	Users       persistence.UserRepository
 	Events      events.EventBus
 }
 
 func NewEnrollmentService(
 	enrollments persistence.EnrollmentRepository,
+	progress persistence.ProgressRepository,
 	courses persistence.CourseRepository,
+	modules persistence.ModuleRepository,
+	readings persistence.ReadingRepository,
+	files persistence.FileRepository,
 	users persistence.UserRepository,
 	eventBus events.EventBus,
 ) *EnrollmentService {
 	return &EnrollmentService{
 		Enrollments: enrollments,
+		Progress:    progress,
 		Courses:     courses,
+		Modules:     modules,
+		Readings:    readings,
+		Files:       files,
 		Users:       users,
 		Events:      eventBus,
 	}
 }
 
+func (s *EnrollmentService) gate() moduleGate {
+	return moduleGate{
+		Modules:     s.Modules,
+		Enrollments: s.Enrollments,
+		Progress:    s.Progress,
+		Readings:    s.Readings,
+		Files:       s.Files,
+	}
+}
+
 type EnrollCommand struct {
 	CourseID int64 `json:"course_id"`
 	UserID   int64 `json:"user_id"`
@@ -140,3 +169,196 @@ type ListEnrollmentsByCourseQuery struct {
 func (s *EnrollmentService) ListByCourse(ctx context.Context, query *ListEnrollmentsByCourseQuery) ([]domain.Enrollment, error) {
 	return s.Enrollments.ListByCourse(ctx, query.CourseID)
 }
+
+type MarkContentCompleteCommand struct {
+	CourseID    int64              `json:"course_id"`
+	ModuleID    int64              `json:"module_id"`
+	ContentID   int64              `json:"content_id"`
+	ContentType domain.ContentType `json:"content_type"`
+	UserID      int64              `json:"user_id"`
+}
+
+func (c *MarkContentCompleteCommand) Validate(v *validation.Validator) {
+	v.Field(c.CourseID, "course_id").EntityID()
+	v.Field(c.ModuleID, "module_id").EntityID()
+	v.Field(c.ContentID, "content_id").EntityID()
+	v.Field(string(c.ContentType), "content_type").Required()
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+func (s *EnrollmentService) MarkComplete(ctx context.Context, cmd *MarkContentCompleteCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	if _, ok := s.Enrollments.GetByUserAndCourse(ctx, cmd.UserID, cmd.CourseID); !ok {
+		return errors.ErrForbidden
+	}
+
+	module, ok := s.Modules.GetByID(ctx, cmd.ModuleID)
+	if !ok || module.CourseID != cmd.CourseID || module.Status != domain.ModuleStatusPublished {
+		return errors.ErrNotFound
+	}
+	if err := s.gate().check(ctx, cmd.UserID, module); err != nil {
+		return err
+	}
+
+	switch cmd.ContentType {
+	case domain.ContentTypeReading:
+		reading, ok := s.Readings.GetByID(ctx, cmd.ContentID)
+		if !ok || reading.ModuleID != module.ID || reading.Status != domain.ContentStatusPublished {
+			return errors.ErrNotFound
+		}
+	case domain.ContentTypeFile:
+		file, ok := s.Files.GetByID(ctx, cmd.ContentID)
+		if !ok || file.ModuleID != module.ID || file.Status != domain.ContentStatusPublished {
+			return errors.ErrNotFound
+		}
+	default:
+		return errors.ErrInvalidInput
+	}
+
+	if _, ok := s.Progress.Get(ctx, cmd.UserID, cmd.ContentType, cmd.ContentID); ok {
+		return nil
+	}
+
+	completion := &domain.ContentCompletion{
+		UserID:      cmd.UserID,
+		CourseID:    cmd.CourseID,
+		ModuleID:    module.ID,
+		ContentID:   cmd.ContentID,
+		ContentType: cmd.ContentType,
+	}
+	if err := s.Progress.Create(ctx, completion); err != nil {
+		if err == errors.ErrConflict {
+			return nil
+		}
+		return err
+	}
+
+	event := domain.NewContentCompletedEvent(cmd.UserID, cmd.CourseID, module.ID, cmd.ContentID, cmd.ContentType)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+type MarkContentIncompleteCommand struct {
+	CourseID    int64              `json:"course_id"`
+	ContentID   int64              `json:"content_id"`
+	ContentType domain.ContentType `json:"content_type"`
+	UserID      int64              `json:"user_id"`
+}
+
+func (c *MarkContentIncompleteCommand) Validate(v *validation.Validator) {
+	v.Field(c.CourseID, "course_id").EntityID()
+	v.Field(c.ContentID, "content_id").EntityID()
+	v.Field(string(c.ContentType), "content_type").Required()
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+func (s *EnrollmentService) MarkIncomplete(ctx context.Context, cmd *MarkContentIncompleteCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	completion, ok := s.Progress.Get(ctx, cmd.UserID, cmd.ContentType, cmd.ContentID)
+	if !ok || completion.CourseID != cmd.CourseID {
+		return errors.ErrNotFound
+	}
+
+	return s.Progress.Delete(ctx, cmd.UserID, cmd.ContentType, cmd.ContentID)
+}
+
+type ListCompletionsQuery struct {
+	CourseID int64 `json:"course_id"`
+	UserID   int64 `json:"user_id"`
+}
+
+func (s *EnrollmentService) ListCompletions(ctx context.Context, query *ListCompletionsQuery) ([]domain.ContentCompletion, error) {
+	return s.Progress.ListByUserAndCourse(ctx, query.UserID, query.CourseID)
+}
+
+type GetCourseProgressQuery struct {
+	CourseID int64 `json:"course_id"`
+	UserID   int64 `json:"user_id"`
+}
+
+func (s *EnrollmentService) GetCourseProgress(ctx context.Context, query *GetCourseProgressQuery) (*domain.CourseProgress, error) {
+	if _, ok := s.Courses.GetByID(ctx, query.CourseID); !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	if _, ok := s.Enrollments.GetByUserAndCourse(ctx, query.UserID, query.CourseID); !ok {
+		return nil, errors.ErrForbidden
+	}
+
+	modules, err := s.Modules.ListByCourseID(ctx, query.CourseID)
+	if err != nil {
+		return nil, err
+	}
+
+	return courseProgress(ctx, s.Progress, s.Readings, s.Files, query.UserID, query.CourseID, modules)
+}
+
+func courseProgress(
+	ctx context.Context,
+	progress persistence.ProgressRepository,
+	readings persistence.ReadingRepository,
+	files persistence.FileRepository,
+	userID, courseID int64,
+	modules []domain.Module,
+) (*domain.CourseProgress, error) {
+	completions, err := progress.ListByUserAndCourse(ctx, userID, courseID)
+	if err != nil {
+		return nil, err
+	}
+
+	completed := make(map[domain.ContentType]map[int64]bool)
+	for _, c := range completions {
+		if completed[c.ContentType] == nil {
+			completed[c.ContentType] = make(map[int64]bool)
+		}
+		completed[c.ContentType][c.ContentID] = true
+	}
+
+	moduleProgress := make([]domain.ModuleProgress, 0, len(modules))
+	for _, module := range modules {
+		if module.Status != domain.ModuleStatusPublished {
+			continue
+		}
+
+		moduleReadings, err := readings.ListByModuleID(ctx, module.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		moduleFiles, err := files.ListByModuleID(ctx, module.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		done, total := 0, 0
+		for _, reading := range moduleReadings {
+			if reading.Status != domain.ContentStatusPublished {
+				continue
+			}
+			total++
+			if completed[domain.ContentTypeReading][reading.ID] {
+				done++
+			}
+		}
+		for _, file := range moduleFiles {
+			if file.Status != domain.ContentStatusPublished {
+				continue
+			}
+			total++
+			if completed[domain.ContentTypeFile][file.ID] {
+				done++
+			}
+		}
+
+		moduleProgress = append(moduleProgress, domain.NewModuleProgress(module.ID, done, total))
+	}
+
+	return domain.NewCourseProgress(courseID, moduleProgress), nil
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/auth"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/validation"
+)
+
+// throttleWindow is how long a key must stay quiet before its failure count
+// starts over.
+const throttleWindow = time.Hour
+
+var (
+	_ Command = (*UnlockUserCommand)(nil)
+)
+
+// After freeAttempts failures each retry waits an exponentially growing
+// delay; reaching lockoutAfter (if non-zero) blocks the key for lockoutFor.
+type throttlePolicy struct {
+	prefix       string
+	freeAttempts int
+	baseDelay    time.Duration
+	maxDelay     time.Duration
+	lockoutAfter int
+	lockoutFor   time.Duration
+}
+
+var (
+	loginEmailThrottle = throttlePolicy{
+		prefix:       "login:email:",
+		freeAttempts: 3,
+		baseDelay:    time.Second,
+		maxDelay:     5 * time.Minute,
+		lockoutAfter: 10,
+		lockoutFor:   15 * time.Minute,
+	}
+	loginIPThrottle = throttlePolicy{
+		prefix:       "login:ip:",
+		freeAttempts: 20,
+		baseDelay:    time.Second,
+		maxDelay:     5 * time.Minute,
+		lockoutAfter: 100,
+		lockoutFor:   15 * time.Minute,
+	}
+	resetEmailThrottle = throttlePolicy{
+		prefix:       "reset:email:",
+		freeAttempts: 3,
+		baseDelay:    time.Minute,
+		maxDelay:     time.Hour,
+	}
+	resetIPThrottle = throttlePolicy{
+		prefix:       "reset:ip:",
+		freeAttempts: 10,
+		baseDelay:    time.Minute,
+		maxDelay:     time.Hour,
+	}
+)
+
+func (p throttlePolicy) key(value string) string {
+	return p.prefix + strings.ToLower(strings.TrimSpace(value))
+}
+
+func (p throttlePolicy) retryAt(t *domain.LoginThrottle) time.Time {
+	var retryAt time.Time
+	if t.Failures >= p.freeAttempts {
+		delay := p.maxDelay
+		if shift := t.Failures - p.freeAttempts; shift < 32 {
+			delay = min(p.baseDelay<<shift, p.maxDelay)
+		}
+		retryAt = t.LastFailureAt.Add(delay)
+	}
+	if t.LockedUntil != nil && t.LockedUntil.After(retryAt) {
+		retryAt = *t.LockedUntil
+	}
+	return retryAt
+}
+
+func (s *AuthService) checkThrottle(ctx context.Context, p throttlePolicy, value string, now time.Time) error {
+	if value == "" {
+		return nil
+	}
+
+	t, ok := s.Throttles.GetThrottle(ctx, p.key(value))
+	if ok && now.Before(p.retryAt(t)) {
+		return errors.ErrTooManyRequests
+	}
+
+	return nil
+}
+
+// recordThrottleFailure counts a failure against the key and locks it once
+// the policy's lockout threshold is reached. It returns the lock expiry when
+// this failure caused a new lockout.
+func (s *AuthService) recordThrottleFailure(ctx context.Context, p throttlePolicy, value string, now time.Time) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	key := p.key(value)
+	t, err := s.Throttles.RecordFailure(ctx, key, now, throttleWindow)
+	if err != nil {
+		return nil, err
+	}
+	if p.lockoutAfter == 0 || t.Failures < p.lockoutAfter || t.IsLocked(now) {
+		return nil, nil
+	}
+
+	lockedUntil := now.Add(p.lockoutFor)
+	if err := s.Throttles.LockThrottle(ctx, key, lockedUntil); err != nil {
+		return nil, err
+	}
+
+	return &lockedUntil, nil
+}
+
+func (s *AuthService) checkLoginThrottle(ctx context.Context, email, ip string, now time.Time) error {
+	if err := s.checkThrottle(ctx, loginEmailThrottle, email, now); err != nil {
+		return err
+	}
+	return s.checkThrottle(ctx, loginIPThrottle, ip, now)
+}
+
+func (s *AuthService) recordLoginFailure(ctx context.Context, email, ip string, user *domain.User, now time.Time) error {
+	lockedUntil, err := s.recordThrottleFailure(ctx, loginEmailThrottle, email, now)
+	if err != nil {
+		return err
+	}
+	if lockedUntil != nil && user != nil {
+		event := domain.NewUserLockedOutEvent(user.ID, user.Email, *lockedUntil)
+		_ = s.Events.Publish(ctx, event)
+	}
+
+	_, err = s.recordThrottleFailure(ctx, loginIPThrottle, ip, now)
+	return err
+}
+
+func (s *AuthService) resetLoginThrottle(ctx context.Context, email string) error {
+	return s.Throttles.ResetThrottle(ctx, loginEmailThrottle.key(email))
+}
+
+// checkCurrentPassword re-verifies a signed-in user's password. Failures count
+// against the same per-user throttle as Login, so a hijacked session cannot be
+// used to guess the password faster than the login form allows. A wrong
+// password is reported as a field error, since a 401 would end the caller's
+// session in the browser.
+func (s *AuthService) checkCurrentPassword(ctx context.Context, user *domain.User, password string) error {
+	now := time.Now()
+	if err := s.checkThrottle(ctx, loginEmailThrottle, user.Email, now); err != nil {
+		return err
+	}
+
+	if err := auth.CheckPassword(user.PasswordHash, password); err != nil {
+		if err := s.recordLoginFailure(ctx, user.Email, "", user, now); err != nil {
+			return err
+		}
+		errs := errors.NewValidationErrors()
+		errs.Add("current_password", "is incorrect")
+		return errs
+	}
+
+	return s.resetLoginThrottle(ctx, user.Email)
+}
+
+// allowPasswordReset counts every reset request, successful or not, so that
+// a single address or client cannot be used to flood inboxes.
+func (s *AuthService) allowPasswordReset(ctx context.Context, email, ip string, now time.Time) (bool, error) {
+	if err := s.checkThrottle(ctx, resetEmailThrottle, email, now); err != nil {
+		return false, nil
+	}
+	if err := s.checkThrottle(ctx, resetIPThrottle, ip, now); err != nil {
+		return false, nil
+	}
+
+	if _, err := s.recordThrottleFailure(ctx, resetEmailThrottle, email, now); err != nil {
+		return false, err
+	}
+	if _, err := s.recordThrottleFailure(ctx, resetIPThrottle, ip, now); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+type UnlockUserCommand struct {
+	UserID  int64 `json:"-"`
+	AdminID int64 `json:"-"`
+}
+
+func (c *UnlockUserCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.AdminID, "admin_id").Required().EntityID()
+}
+
+func (s *AuthService) UnlockUser(ctx context.Context, cmd *UnlockUserCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	if err := s.resetLoginThrottle(ctx, user.Email); err != nil {
+		return err
+	}
+
+	event := domain.NewUserUnlockedEvent(user.ID, cmd.AdminID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
@@ -2,11 +2,13 @@ package services
 
 import (
 	"context"
+	"time"
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/persistence"
 	"bytecourses/internal/pkg/errors"
 	"bytecourses/internal/pkg/events"
+	"bytecourses/internal/pkg/policy"
 	"bytecourses/internal/pkg/validation"
 )
 
@@ -23,29 +25,57 @@ var (
 )
 
 type ModuleService struct {
-	Modules persistence.ModuleRepository
-	Courses persistence.CourseRepository
-	Events  events.EventBus
+	Modules     persistence.ModuleRepository
+	Courses     persistence.CourseRepository
+	Enrollments persistence.EnrollmentRepository
+	Progress    persistence.ProgressRepository
+	Readings    persistence.ReadingRepository
+	Files       persistence.FileRepository
+	Authorizer  *policy.Authorizer
+	Events      events.EventBus
 }
 
 func NewModuleService(
 	modules persistence.ModuleRepository,
 	courses persistence.CourseRepository,
+	enrollments persistence.EnrollmentRepository,
+	progress persistence.ProgressRepository,
+	readings persistence.ReadingRepository,
+	files persistence.FileRepository,
+	authorizer *policy.Authorizer,
 	eventBus events.EventBus,
 ) *ModuleService {
 	return &ModuleService{
-		Modules: modules,
-		Courses: courses,
-		Events:  eventBus,
+		Modules:     modules,
+		Courses:     courses,
+		Enrollments: enrollments,
+		Progress:    progress,
+		Readings:    readings,
+		Files:       files,
+		Authorizer:  authorizer,
+		Events:      eventBus,
+	}
+}
+
+func (s *ModuleService) gate() moduleGate {
+	return moduleGate{
+		Modules:     s.Modules,
+		Enrollments: s.Enrollments,
+		Progress:    s.Progress,
+		Readings:    s.Readings,
+		Files:       s.Files,
 	}
 }
 
 type CreateModuleCommand struct {
-	CourseID    int64  `json:"course_id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Order       int    `json:"order"`
-	UserID      int64  `json:"user_id"`
+	CourseID    int64                   `json:"course_id"`
+	Title       string                  `json:"title"`
+	Description string                  `json:"description"`
+	Order       int                     `json:"order"`
+	UnlockRule  domain.ModuleUnlockRule `json:"unlock_rule"`
+	UnlockDays  int                     `json:"unlock_days"`
+	UnlockAt    *time.Time              `json:"unlock_at"`
+	UserID      int64                   `json:"user_id"`
 }
 
 func (c *CreateModuleCommand) Validate(v *validation.Validator) {
@@ -53,18 +83,22 @@ func (c *CreateModuleCommand) Validate(v *validation.Validator) {
 	v.Field(c.Title, "title").Required().MinLength(1).MaxLength(255).IsTrimmed()
 	v.Field(c.Description, "description").MaxLength(2048).IsTrimmed()
 	v.Field(c.UserID, "user_id").EntityID()
+	validateUnlockRule(v, c.UnlockRule, c.UnlockDays)
 }
 
 func (s *ModuleService) Create(ctx context.Context, cmd *CreateModuleCommand) (*domain.Module, error) {
 	if err := validation.Validate(cmd); err != nil {
 		return nil, err
 	}
+	if !isValidUnlockRule(cmd.UnlockRule, cmd.UnlockAt) {
+		return nil, errors.ErrInvalidInput
+	}
 
 	course, ok := s.Courses.GetByID(ctx, cmd.CourseID)
 	if !ok {
 		return nil, errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return nil, errors.ErrNotFound
 	}
 
@@ -75,6 +109,7 @@ func (s *ModuleService) Create(ctx context.Context, cmd *CreateModuleCommand) (*
 		Order:       cmd.Order,
 		Status:      domain.ModuleStatusDraft,
 	}
+	setUnlockRule(&module, cmd.UnlockRule, cmd.UnlockDays, cmd.UnlockAt)
 	if err := s.Modules.Create(ctx, &module); err != nil {
 		return nil, err
 	}
@@ -86,11 +121,14 @@ func (s *ModuleService) Create(ctx context.Context, cmd *CreateModuleCommand) (*
 }
 
 type UpdateModuleCommand struct {
-	ModuleID    int64  `json:"module_id"`
-	Title       string `json:"title"`
-	Description string `json:"description"`
-	Order       int    `json:"order"`
-	UserID      int64  `json:"user_id"`
+	ModuleID    int64                   `json:"module_id"`
+	Title       string                  `json:"title"`
+	Description string                  `json:"description"`
+	Order       int                     `json:"order"`
+	UnlockRule  domain.ModuleUnlockRule `json:"unlock_rule"`
+	UnlockDays  int                     `json:"unlock_days"`
+	UnlockAt    *time.Time              `json:"unlock_at"`
+	UserID      int64                   `json:"user_id"`
 }
 
 func (c *UpdateModuleCommand) Validate(v *validation.Validator) {
@@ -98,12 +136,16 @@ func (c *UpdateModuleCommand) Validate(v *validation.Validator) {
 	v.Field(c.Title, "title").Required().MinLength(1).MaxLength(255).IsTrimmed()
 	v.Field(c.Description, "description").MaxLength(2048).IsTrimmed()
 	v.Field(c.UserID, "user_id").EntityID()
+	validateUnlockRule(v, c.UnlockRule, c.UnlockDays)
 }
 
 func (s *ModuleService) Update(ctx context.Context, cmd *UpdateModuleCommand) error {
 	if err := validation.Validate(cmd); err != nil {
 		return err
 	}
+	if !isValidUnlockRule(cmd.UnlockRule, cmd.UnlockAt) {
+		return errors.ErrInvalidInput
+	}
 
 	module, ok := s.Modules.GetByID(ctx, cmd.ModuleID)
 	if !ok {
@@ -114,13 +156,14 @@ func (s *ModuleService) Update(ctx context.Context, cmd *UpdateModuleCommand) er
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrNotFound
 	}
 
 	module.Title = cmd.Title
 	module.Description = cmd.Description
 	module.Order = cmd.Order
+	setUnlockRule(module, cmd.UnlockRule, cmd.UnlockDays, cmd.UnlockAt)
 	if err := s.Modules.Update(ctx, module); err != nil {
 		return err
 	}
@@ -155,7 +198,7 @@ func (s *ModuleService) Delete(ctx context.Context, cmd *DeleteModuleCommand) er
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrNotFound
 	}
 
@@ -193,7 +236,7 @@ func (s *ModuleService) Publish(ctx context.Context, cmd *PublishModuleCommand)
 	if !ok {
 		return errors.ErrNotFound
 	}
-	if course.InstructorID != cmd.UserID {
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.EditCourse) {
 		return errors.ErrNotFound
 	}
 	if module.Status != domain.ModuleStatusDraft {
@@ -224,13 +267,8 @@ func (s *ModuleService) List(ctx context.Context, query *ListModulesQuery) ([]do
 		return nil, errors.ErrNotFound
 	}
 
-	if query.UserRole == domain.SystemRoleAdmin {
-
-	} else if course.InstructorID == query.UserID {
-
-	} else if query.EnrolledLearner {
-
-	} else {
+	actor := policy.Actor{UserID: query.UserID, Role: query.UserRole}
+	if !query.EnrolledLearner && !s.Authorizer.Can(ctx, actor, course, policy.ViewCourse) {
 		return nil, errors.ErrForbidden
 	}
 
@@ -247,6 +285,10 @@ func (s *ModuleService) List(ctx context.Context, query *ListModulesQuery) ([]do
 			}
 		}
 		modules = filtered
+
+		if err := s.gate().apply(ctx, query.UserID, query.CourseID, modules); err != nil {
+			return nil, err
+		}
 	}
 
 	return modules, nil
@@ -266,13 +308,8 @@ func (s *ModuleService) Get(ctx context.Context, query *GetModuleQuery) (*domain
 		return nil, errors.ErrNotFound
 	}
 
-	if query.UserRole == domain.SystemRoleAdmin {
-
-	} else if course.InstructorID == query.UserID {
-
-	} else if query.EnrolledLearner {
-
-	} else {
+	actor := policy.Actor{UserID: query.UserID, Role: query.UserRole}
+	if !query.EnrolledLearner && !s.Authorizer.Can(ctx, actor, course, policy.ViewCourse) {
 		return nil, errors.ErrForbidden
 	}
 
@@ -285,9 +322,47 @@ func (s *ModuleService) Get(ctx context.Context, query *GetModuleQuery) (*domain
 		return nil, errors.ErrNotFound
 	}
 
-	if query.EnrolledLearner && module.Status != domain.ModuleStatusPublished {
-		return nil, errors.ErrNotFound
+	if query.EnrolledLearner {
+		if module.Status != domain.ModuleStatusPublished {
+			return nil, errors.ErrNotFound
+		}
+		if err := s.gate().check(ctx, query.UserID, module); err != nil {
+			return nil, err
+		}
 	}
 
 	return module, nil
 }
+
+func validateUnlockRule(v *validation.Validator, rule domain.ModuleUnlockRule, days int) {
+	if rule == domain.ModuleUnlockAfterDays {
+		v.Field(days, "unlock_days").Min(1).Max(3650)
+	}
+}
+
+func isValidUnlockRule(rule domain.ModuleUnlockRule, at *time.Time) bool {
+	if rule == "" {
+		return true
+	}
+	if !rule.IsValid() {
+		return false
+	}
+	return rule != domain.ModuleUnlockOnDate || at != nil
+}
+
+func setUnlockRule(module *domain.Module, rule domain.ModuleUnlockRule, days int, at *time.Time) {
+	if rule == "" {
+		rule = domain.ModuleUnlockImmediately
+	}
+
+	module.UnlockRule = rule
+	module.UnlockDays = 0
+	module.UnlockAt = nil
+
+	switch rule {
+	case domain.ModuleUnlockAfterDays:
+		module.UnlockDays = days
+	case domain.ModuleUnlockOnDate:
+		module.UnlockAt = at
+	}
+}
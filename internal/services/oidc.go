@@ -0,0 +1,245 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/auth"
+	"bytecourses/internal/infrastructure/oidc"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/validation"
+)
+
+var (
+	_ Command = (*CompleteOIDCLoginCommand)(nil)
+)
+
+// OIDCAuthorization is the state the caller must keep, typically in a
+// short-lived cookie, until the provider redirects back.
+type OIDCAuthorization struct {
+	URL          string
+	State        string
+	Nonce        string
+	CodeVerifier string
+}
+
+func (s *AuthService) OIDCEnabled() bool {
+	return s.OIDC != nil
+}
+
+func (s *AuthService) OIDCProviderName() string {
+	if s.OIDC == nil {
+		return ""
+	}
+	return s.OIDC.Name()
+}
+
+func (s *AuthService) BeginOIDCLogin(ctx context.Context) (*OIDCAuthorization, error) {
+	if s.OIDC == nil {
+		return nil, errors.ErrNotFound
+	}
+
+	var values [3]string
+	for i := range values {
+		value, err := auth.GenerateToken()
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	state, nonce, codeVerifier := values[0], values[1], values[2]
+
+	authURL, err := s.OIDC.AuthCodeURL(ctx, state, nonce, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OIDCAuthorization{
+		URL:          authURL,
+		State:        state,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+	}, nil
+}
+
+type CompleteOIDCLoginCommand struct {
+	Code          string `json:"-"`
+	State         string `json:"-"`
+	ExpectedState string `json:"-"`
+	Nonce         string `json:"-"`
+	CodeVerifier  string `json:"-"`
+	BaseURL       string `json:"-"`
+}
+
+func (c *CompleteOIDCLoginCommand) Validate(v *validation.Validator) {
+	v.Field(c.Code, "code").Required()
+	v.Field(c.State, "state").Required()
+	v.Field(c.ExpectedState, "expected_state").Required()
+	v.Field(c.Nonce, "nonce").Required()
+	v.Field(c.CodeVerifier, "code_verifier").Required()
+}
+
+func (s *AuthService) CompleteOIDCLogin(ctx context.Context, cmd *CompleteOIDCLoginCommand) (*LoginResult, error) {
+	if s.OIDC == nil {
+		return nil, errors.ErrNotFound
+	}
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(cmd.State), []byte(cmd.ExpectedState)) != 1 {
+		return nil, errors.ErrInvalidToken
+	}
+
+	claims, err := s.OIDC.Exchange(ctx, cmd.Code, cmd.CodeVerifier, cmd.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrInvalidLogin, err)
+	}
+
+	user, err := s.resolveOIDCUser(ctx, claims, cmd.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.completeLogin(ctx, user)
+}
+
+// resolveOIDCUser finds the local account for an external identity, linking
+// it by verified email or creating a new account on first sign-in.
+func (s *AuthService) resolveOIDCUser(ctx context.Context, claims *oidc.Claims, baseURL string) (*domain.User, error) {
+	if identity, ok := s.Identities.GetIdentity(ctx, claims.Issuer, claims.Subject); ok {
+		user, ok := s.Users.GetByID(ctx, identity.UserID)
+		if !ok {
+			return nil, errors.ErrNotFound
+		}
+		return user, nil
+	}
+
+	email := strings.TrimSpace(claims.Email)
+	if email == "" {
+		return nil, errors.ErrInvalidLogin
+	}
+
+	if user, ok := s.Users.GetByEmail(ctx, email); ok {
+		// Only a provider-verified address proves ownership of an existing
+		// account; otherwise anyone could claim it at the provider.
+		if !claims.EmailVerified {
+			return nil, errors.ErrConflict
+		}
+		if !user.IsVerified() {
+			if err := s.claimUnverifiedUser(ctx, user); err != nil {
+				return nil, err
+			}
+		}
+		if err := s.linkIdentity(ctx, user, claims, false); err != nil {
+			return nil, err
+		}
+		return user, nil
+	}
+
+	return s.createOIDCUser(ctx, claims, email, baseURL)
+}
+
+// claimUnverifiedUser hands an account that was registered but never
+// verified to the provider-verified owner of its address. Whoever registered
+// it may not own the address, so their password, second factor and sessions
+// are discarded.
+func (s *AuthService) claimUnverifiedUser(ctx context.Context, user *domain.User) error {
+	passwordHash, err := unusablePasswordHash()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	user.PasswordHash = passwordHash
+	user.TOTPEnabled = false
+	user.TOTPSecret = ""
+	user.TOTPLastStep = 0
+	user.VerifiedAt = &now
+	if err := s.Users.Update(ctx, user); err != nil {
+		return err
+	}
+
+	if err := s.RecoveryCodes.DeleteRecoveryCodes(ctx, user.ID); err != nil {
+		return err
+	}
+	if err := s.Sessions.DeleteByUserID(user.ID); err != nil {
+		return err
+	}
+
+	event := domain.NewEmailVerifiedEvent(user.ID, user.Email, user.Name)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+func (s *AuthService) createOIDCUser(ctx context.Context, claims *oidc.Claims, email string, baseURL string) (*domain.User, error) {
+	passwordHash, err := unusablePasswordHash()
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(claims.Name)
+	if len(name) < 2 {
+		name, _, _ = strings.Cut(email, "@")
+	}
+
+	user := domain.User{
+		Name:         name,
+		Email:        email,
+		PasswordHash: passwordHash,
+		Role:         domain.SystemRoleUser,
+	}
+	if claims.EmailVerified {
+		now := time.Now()
+		user.VerifiedAt = &now
+	}
+	if err := s.Users.Create(ctx, &user); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewUserRegisteredEvent(user.ID, user.Email, user.Name)
+	_ = s.Events.Publish(ctx, event)
+
+	if err := s.linkIdentity(ctx, &user, claims, true); err != nil {
+		return nil, err
+	}
+
+	if !user.IsVerified() {
+		if err := s.requestVerification(ctx, &user, baseURL); err != nil {
+			return nil, err
+		}
+	}
+
+	return &user, nil
+}
+
+func (s *AuthService) linkIdentity(ctx context.Context, user *domain.User, claims *oidc.Claims, created bool) error {
+	identity := domain.UserIdentity{
+		UserID:  user.ID,
+		Issuer:  claims.Issuer,
+		Subject: claims.Subject,
+		Email:   claims.Email,
+	}
+	if err := s.Identities.CreateIdentity(ctx, &identity); err != nil {
+		return err
+	}
+
+	event := domain.NewUserIdentityLinkedEvent(user.ID, identity.Issuer, identity.Subject, created)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+// unusablePasswordHash hashes a random secret nobody knows, so that accounts
+// created through single sign-on can only set a password via reset.
+func unusablePasswordHash() ([]byte, error) {
+	secret, err := auth.GenerateToken()
+	if err != nil {
+		return nil, err
+	}
+	return auth.HashPassword(secret)
+}
@@ -2,29 +2,43 @@ package services
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
 	"bytecourses/internal/domain"
 	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/infrastructure/storage"
 	"bytecourses/internal/pkg/errors"
 	"bytecourses/internal/pkg/events"
+	"bytecourses/internal/pkg/policy"
 	"bytecourses/internal/pkg/validation"
 )
 
 type ProposalService struct {
-	Proposals persistence.ProposalRepository
-	Users     persistence.UserRepository
-	Events    events.EventBus
+	Proposals   persistence.ProposalRepository
+	Assignments persistence.ProposalAssignmentRepository
+	Users       persistence.UserRepository
+	Quorum      domain.ReviewQuorum
+	Events      events.EventBus
+	FileStorage storage.FileStorage
 }
 
 func NewProposalService(
 	proposalRepo persistence.ProposalRepository,
+	assignmentRepo persistence.ProposalAssignmentRepository,
 	userRepo persistence.UserRepository,
+	quorum domain.ReviewQuorum,
 	eventBus events.EventBus,
+	fileStorage storage.FileStorage,
 ) *ProposalService {
 	return &ProposalService{
-		Proposals: proposalRepo,
-		Users:     userRepo,
-		Events:    eventBus,
+		Proposals:   proposalRepo,
+		Assignments: assignmentRepo,
+		Users:       userRepo,
+		Quorum:      quorum,
+		Events:      eventBus,
+		FileStorage: fileStorage,
 	}
 }
 
@@ -35,22 +49,25 @@ var (
 	_ Command = (*WithdrawProposalCommand)(nil)
 	_ Command = (*ReviewProposalCommand)(nil)
 	_ Command = (*DeleteProposalCommand)(nil)
+	_ Command = (*AddProposalCommentCommand)(nil)
 )
 
 var (
 	_ Query = (*GetProposalQuery)(nil)
 	_ Query = (*ListProposalsQuery)(nil)
+	_ Query = (*ListProposalCommentsQuery)(nil)
 )
 
 type CreateProposalCommand struct {
-	AuthorID             int64  `json:"author_id"`
-	Title                string `json:"title"`
-	Summary              string `json:"summary"`
-	Qualifications       string `json:"qualifications"`
-	TargetAudience       string `json:"target_audience"`
-	LearningObjectives   string `json:"learning_objectives"`
-	Outline              string `json:"outline"`
-	AssumedPrerequisites string `json:"assumed_prerequisites"`
+	AuthorID             int64                  `json:"author_id"`
+	Title                string                 `json:"title"`
+	Summary              string                 `json:"summary"`
+	Qualifications       string                 `json:"qualifications"`
+	TargetAudience       string                 `json:"target_audience"`
+	LearningObjectives   string                 `json:"learning_objectives"`
+	Outline              string                 `json:"outline"`
+	OutlineModules       []domain.OutlineModule `json:"outline_modules"`
+	AssumedPrerequisites string                 `json:"assumed_prerequisites"`
 }
 
 func (c *CreateProposalCommand) Validate(v *validation.Validator) {
@@ -62,6 +79,7 @@ func (c *CreateProposalCommand) Validate(v *validation.Validator) {
 	v.Field(c.LearningObjectives, "learning_objectives").MaxLength(2048).IsTrimmed()
 	v.Field(c.Outline, "outline").MaxLength(2048).IsTrimmed()
 	v.Field(c.AssumedPrerequisites, "assumed_prerequisites").MaxLength(2048).IsTrimmed()
+	validateOutlineModules(v, c.OutlineModules)
 }
 
 func (s *ProposalService) Create(ctx context.Context, cmd *CreateProposalCommand) (*domain.Proposal, error) {
@@ -77,6 +95,7 @@ func (s *ProposalService) Create(ctx context.Context, cmd *CreateProposalCommand
 		TargetAudience:       cmd.TargetAudience,
 		LearningObjectives:   cmd.LearningObjectives,
 		Outline:              cmd.Outline,
+		OutlineModules:       cmd.OutlineModules,
 		AssumedPrerequisites: cmd.AssumedPrerequisites,
 		Status:               domain.ProposalStatusDraft,
 	}
@@ -91,15 +110,16 @@ func (s *ProposalService) Create(ctx context.Context, cmd *CreateProposalCommand
 }
 
 type UpdateProposalCommand struct {
-	ProposalID           int64  `json:"proposal_id"`
-	Title                string `json:"title"`
-	Summary              string `json:"summary"`
-	Qualifications       string `json:"qualifications"`
-	TargetAudience       string `json:"target_audience"`
-	LearningObjectives   string `json:"learning_objectives"`
-	Outline              string `json:"outline"`
-	AssumedPrerequisites string `json:"assumed_prerequisites"`
-	UserID               int64  `json:"user_id"`
+	ProposalID           int64                  `json:"proposal_id"`
+	Title                string                 `json:"title"`
+	Summary              string                 `json:"summary"`
+	Qualifications       string                 `json:"qualifications"`
+	TargetAudience       string                 `json:"target_audience"`
+	LearningObjectives   string                 `json:"learning_objectives"`
+	Outline              string                 `json:"outline"`
+	OutlineModules       []domain.OutlineModule `json:"outline_modules"`
+	AssumedPrerequisites string                 `json:"assumed_prerequisites"`
+	UserID               int64                  `json:"user_id"`
 }
 
 func (c *UpdateProposalCommand) Validate(v *validation.Validator) {
@@ -111,6 +131,7 @@ func (c *UpdateProposalCommand) Validate(v *validation.Validator) {
 	v.Field(c.LearningObjectives, "learning_objectives").MaxLength(2048).IsTrimmed()
 	v.Field(c.Outline, "outline").MaxLength(2048).IsTrimmed()
 	v.Field(c.AssumedPrerequisites, "assumed_prerequisites").MaxLength(2048).IsTrimmed()
+	validateOutlineModules(v, c.OutlineModules)
 	v.Field(c.UserID, "user_id").EntityID()
 }
 
@@ -136,6 +157,7 @@ func (s *ProposalService) Update(ctx context.Context, cmd *UpdateProposalCommand
 	proposal.TargetAudience = cmd.TargetAudience
 	proposal.LearningObjectives = cmd.LearningObjectives
 	proposal.Outline = cmd.Outline
+	proposal.OutlineModules = cmd.OutlineModules
 	proposal.AssumedPrerequisites = cmd.AssumedPrerequisites
 	if err := s.Proposals.Update(ctx, proposal); err != nil {
 		return err
@@ -174,11 +196,28 @@ func (s *ProposalService) Submit(ctx context.Context, cmd *SubmitProposalCommand
 		return errors.ErrInvalidStatusTransition
 	}
 
+	now := time.Now()
+	resubmitted := proposal.Status == domain.ProposalStatusChangesRequested
 	proposal.Status = domain.ProposalStatusSubmitted
+	proposal.SubmittedAt = &now
 	if err := s.Proposals.Update(ctx, proposal); err != nil {
 		return err
 	}
 
+	if err := s.Proposals.CreateVersion(ctx, domain.NewProposalVersion(proposal)); err != nil {
+		return err
+	}
+
+	// Reviewers stay on a resubmitted proposal but vote afresh.
+	if resubmitted {
+		if err := s.Assignments.StartRound(ctx, proposal.ID, now); err != nil {
+			return err
+		}
+	}
+	if _, err := s.assignRoundRobin(ctx, proposal, s.Quorum.Votes, nil); err != nil {
+		return err
+	}
+
 	event := domain.NewProposalSubmittedEvent(cmd.ProposalID, cmd.UserID, proposal.Title)
 	_ = s.Events.Publish(ctx, event)
 
@@ -223,9 +262,10 @@ func (s *ProposalService) Withdraw(ctx context.Context, cmd *WithdrawProposalCom
 }
 
 type ReviewProposalCommand struct {
-	ProposalID  int64  `json:"proposal_id"`
-	ReviewNotes string `json:"review_notes"`
-	ReviewerID  int64  `json:"reviewer_id"`
+	ProposalID   int64             `json:"proposal_id"`
+	ReviewNotes  string            `json:"review_notes"`
+	ReviewerID   int64             `json:"reviewer_id"`
+	ReviewerRole domain.SystemRole `json:"reviewer_role"`
 }
 
 func (c *ReviewProposalCommand) Validate(v *validation.Validator) {
@@ -234,10 +274,27 @@ func (c *ReviewProposalCommand) Validate(v *validation.Validator) {
 	v.Field(c.ReviewerID, "reviewer_id").EntityID()
 }
 
+// Approve, Reject and RequestChanges record the reviewer's vote. The
+// proposal only moves to its final status once the quorum has voted.
 func (s *ProposalService) Approve(ctx context.Context, cmd *ReviewProposalCommand) error {
+	return s.vote(ctx, cmd, domain.ProposalVoteApprove)
+}
+
+func (s *ProposalService) Reject(ctx context.Context, cmd *ReviewProposalCommand) error {
+	return s.vote(ctx, cmd, domain.ProposalVoteReject)
+}
+
+func (s *ProposalService) RequestChanges(ctx context.Context, cmd *ReviewProposalCommand) error {
+	return s.vote(ctx, cmd, domain.ProposalVoteRequestChanges)
+}
+
+func (s *ProposalService) vote(ctx context.Context, cmd *ReviewProposalCommand, vote domain.ProposalVote) error {
 	if err := validation.Validate(cmd); err != nil {
 		return err
 	}
+	if !policy.Allows(cmd.ReviewerRole, policy.ReviewProposals) {
+		return errors.ErrForbidden
+	}
 
 	proposal, ok := s.Proposals.GetByID(ctx, cmd.ProposalID)
 	if !ok {
@@ -246,75 +303,126 @@ func (s *ProposalService) Approve(ctx context.Context, cmd *ReviewProposalComman
 	if proposal.Status != domain.ProposalStatusSubmitted {
 		return errors.ErrInvalidStatusTransition
 	}
+	if err := s.requireAssignment(ctx, proposal, cmd.ReviewerID); err != nil {
+		return err
+	}
 
-	proposal.Status = domain.ProposalStatusApproved
-	proposal.ReviewerID = &cmd.ReviewerID
-	proposal.ReviewNotes = cmd.ReviewNotes
-	if err := s.Proposals.Update(ctx, proposal); err != nil {
+	if err := s.Assignments.RecordVote(ctx, proposal.ID, cmd.ReviewerID, vote, cmd.ReviewNotes, time.Now()); err != nil {
 		return err
 	}
 
-	author, ok := s.Users.GetByID(ctx, proposal.AuthorID)
-	authorEmail := ""
-	if ok {
-		authorEmail = author.Email
+	decided, err := s.decide(ctx, proposal, cmd.ReviewerID)
+	if err != nil {
+		return err
+	}
+
+	// The review entry keeps each vote's notes once later rounds replace
+	// ReviewNotes.
+	comment := domain.ProposalComment{
+		ProposalID: proposal.ID,
+		AuthorID:   cmd.ReviewerID,
+		Kind:       domain.ProposalCommentKindReview,
+		Body:       cmd.ReviewNotes,
+		Vote:       vote,
+		Status:     proposal.Status,
+	}
+	if err := s.Proposals.CreateComment(ctx, &comment); err != nil {
+		return err
+	}
+
+	_ = s.Events.Publish(ctx, domain.NewProposalVoteRecordedEvent(proposal.ID, cmd.ReviewerID, vote))
+	if decided {
+		s.publishDecision(ctx, proposal, cmd.ReviewerID)
 	}
-	event := domain.NewProposalApprovedEvent(cmd.ProposalID, proposal.AuthorID, cmd.ReviewerID, authorEmail, proposal.Title)
-	_ = s.Events.Publish(ctx, event)
 
 	return nil
 }
 
-func (s *ProposalService) Reject(ctx context.Context, cmd *ReviewProposalCommand) error {
-	if err := validation.Validate(cmd); err != nil {
-		return err
+// requireAssignment checks that the reviewer is assigned to the proposal,
+// claiming it for them when it has no reviewers yet.
+func (s *ProposalService) requireAssignment(ctx context.Context, proposal *domain.Proposal, reviewerID int64) error {
+	if _, ok := s.Assignments.GetAssignment(ctx, proposal.ID, reviewerID); ok {
+		return nil
 	}
 
-	proposal, ok := s.Proposals.GetByID(ctx, cmd.ProposalID)
-	if !ok {
-		return errors.ErrNotFound
+	claimable, err := s.claimable(ctx, proposal, reviewerID)
+	if err != nil {
+		return err
 	}
-	if proposal.Status != domain.ProposalStatusSubmitted {
-		return errors.ErrInvalidStatusTransition
+	if !claimable {
+		return errors.ErrForbidden
 	}
 
-	proposal.Status = domain.ProposalStatusRejected
-	proposal.ReviewerID = &cmd.ReviewerID
-	proposal.ReviewNotes = cmd.ReviewNotes
-	if err := s.Proposals.Update(ctx, proposal); err != nil {
-		return err
+	return s.Assignments.AssignReviewer(ctx, &domain.ProposalAssignment{
+		ProposalID: proposal.ID,
+		ReviewerID: reviewerID,
+		AssignedBy: &reviewerID,
+	})
+}
+
+// claimable reports whether any reviewer may take the proposal. Proposals
+// submitted before reviewers were assigned, or when nobody was eligible,
+// have no reviewers at all; the first reviewer to vote claims them.
+func (s *ProposalService) claimable(ctx context.Context, proposal *domain.Proposal, reviewerID int64) (bool, error) {
+	if reviewerID == proposal.AuthorID {
+		return false, nil
 	}
 
-	event := domain.NewProposalRejectedEvent(cmd.ProposalID, proposal.AuthorID, cmd.ReviewerID, proposal.Title, proposal.ReviewNotes)
-	_ = s.Events.Publish(ctx, event)
+	assignments, err := s.Assignments.ListAssignments(ctx, proposal.ID)
+	if err != nil {
+		return false, err
+	}
 
-	return nil
+	return len(assignments) == 0, nil
 }
 
-func (s *ProposalService) RequestChanges(ctx context.Context, cmd *ReviewProposalCommand) error {
-	if err := validation.Validate(cmd); err != nil {
-		return err
+// decide applies the quorum to the current round's votes and, once it is
+// met, moves the proposal to its final status.
+func (s *ProposalService) decide(ctx context.Context, proposal *domain.Proposal, reviewerID int64) (bool, error) {
+	assignments, err := s.Assignments.ListAssignments(ctx, proposal.ID)
+	if err != nil {
+		return false, err
 	}
 
-	proposal, ok := s.Proposals.GetByID(ctx, cmd.ProposalID)
+	status, ok := s.Quorum.Decide(assignments)
 	if !ok {
-		return errors.ErrNotFound
+		return false, nil
 	}
-	if proposal.Status != domain.ProposalStatusSubmitted {
-		return errors.ErrInvalidStatusTransition
+
+	notes := make([]string, 0, len(assignments))
+	for i := range assignments {
+		if assignments[i].HasVoted() && assignments[i].Notes != "" {
+			notes = append(notes, assignments[i].Notes)
+		}
 	}
 
-	proposal.Status = domain.ProposalStatusChangesRequested
-	proposal.ReviewerID = &cmd.ReviewerID
-	proposal.ReviewNotes = cmd.ReviewNotes
+	proposal.Status = status
+	proposal.ReviewerID = &reviewerID
+	proposal.ReviewNotes = strings.Join(notes, "\n\n")
 	if err := s.Proposals.Update(ctx, proposal); err != nil {
-		return err
+		return false, err
 	}
 
-	event := domain.NewProposalChangesRequestedEvent(cmd.ProposalID, proposal.AuthorID, cmd.ReviewerID, proposal.Title, proposal.ReviewNotes)
-	_ = s.Events.Publish(ctx, event)
+	return true, nil
+}
 
-	return nil
+func (s *ProposalService) publishDecision(ctx context.Context, proposal *domain.Proposal, reviewerID int64) {
+	switch proposal.Status {
+	case domain.ProposalStatusApproved:
+		author, ok := s.Users.GetByID(ctx, proposal.AuthorID)
+		authorEmail := ""
+		if ok {
+			authorEmail = author.Email
+		}
+		event := domain.NewProposalApprovedEvent(proposal.ID, proposal.AuthorID, reviewerID, authorEmail, proposal.Title)
+		_ = s.Events.Publish(ctx, event)
+	case domain.ProposalStatusRejected:
+		event := domain.NewProposalRejectedEvent(proposal.ID, proposal.AuthorID, reviewerID, proposal.Title, proposal.ReviewNotes)
+		_ = s.Events.Publish(ctx, event)
+	case domain.ProposalStatusChangesRequested:
+		event := domain.NewProposalChangesRequestedEvent(proposal.ID, proposal.AuthorID, reviewerID, proposal.Title, proposal.ReviewNotes)
+		_ = s.Events.Publish(ctx, event)
+	}
 }
 
 type DeleteProposalCommand struct {
@@ -340,9 +448,17 @@ func (s *ProposalService) Delete(ctx context.Context, cmd *DeleteProposalCommand
 		return errors.ErrNotFound
 	}
 
+	attachments, err := s.Proposals.ListAttachments(ctx, proposal.ID)
+	if err != nil {
+		return err
+	}
+
 	if err := s.Proposals.DeleteByID(ctx, cmd.ProposalID); err != nil {
 		return err
 	}
+	for _, a := range attachments {
+		s.FileStorage.Delete(ctx, a.StoragePath)
+	}
 
 	event := domain.NewProposalDeletedEvent(proposal.ID, proposal.AuthorID)
 	_ = s.Events.Publish(ctx, event)
@@ -362,11 +478,8 @@ func (s *ProposalService) Get(ctx context.Context, query *GetProposalQuery) (*do
 		return nil, errors.ErrNotFound
 	}
 
-	if query.UserRole == domain.SystemRoleAdmin {
-		if proposal.Status != domain.ProposalStatusSubmitted &&
-			proposal.Status != domain.ProposalStatusApproved &&
-			proposal.Status != domain.ProposalStatusRejected &&
-			proposal.Status != domain.ProposalStatusChangesRequested {
+	if policy.Allows(query.UserRole, policy.ReviewProposals) {
+		if !proposal.WasSubmitted() {
 			return nil, errors.ErrNotFound
 		}
 	} else {
@@ -387,7 +500,7 @@ func (s *ProposalService) List(ctx context.Context, query *ListProposalsQuery) (
 	proposals := make([]domain.Proposal, 0)
 	var err error
 
-	if query.UserRole == domain.SystemRoleAdmin {
+	if policy.Allows(query.UserRole, policy.ReviewProposals) {
 		proposals, err = s.Proposals.ListAllSubmitted(ctx)
 	} else {
 		proposals, err = s.Proposals.ListByAuthorID(ctx, query.UserID)
@@ -395,3 +508,21 @@ func (s *ProposalService) List(ctx context.Context, query *ListProposalsQuery) (
 
 	return proposals, err
 }
+
+const (
+	maxOutlineModules = 50
+	maxOutlineItems   = 50
+)
+
+func validateOutlineModules(v *validation.Validator, modules []domain.OutlineModule) {
+	v.Field(len(modules), "outline_modules").Max(maxOutlineModules)
+	for i, m := range modules {
+		field := "outline_modules[" + strconv.Itoa(i) + "]"
+		v.Field(m.Title, field+".title").Required().MaxLength(255).IsTrimmed()
+		v.Field(m.Description, field+".description").MaxLength(2048).IsTrimmed()
+		v.Field(len(m.Items), field+".items").Max(maxOutlineItems)
+		for _, item := range m.Items {
+			v.Field(item, field+".items").Required().MaxLength(255).IsTrimmed()
+		}
+	}
+}
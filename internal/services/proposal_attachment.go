@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/validation"
+)
+
+var (
+	_ Command = (*AddProposalAttachmentCommand)(nil)
+	_ Command = (*DeleteProposalAttachmentCommand)(nil)
+)
+
+var (
+	_ Query = (*ListProposalAttachmentsQuery)(nil)
+	_ Query = (*GetProposalAttachmentQuery)(nil)
+)
+
+const (
+	maxProposalAttachments     = 10
+	maxProposalAttachmentBytes = 50 << 20 // 50 MB across all of a proposal's attachments
+)
+
+type AddProposalAttachmentCommand struct {
+	ProposalID  int64  `json:"proposal_id"`
+	FileName    string `json:"file_name"`
+	FileSize    int64  `json:"file_size"`
+	MimeType    string `json:"mime_type"`
+	StorageName string `json:"storage_name"`
+	UserID      int64  `json:"user_id"`
+	Content     io.Reader
+}
+
+func (c *AddProposalAttachmentCommand) Validate(v *validation.Validator) {
+	v.Field(c.ProposalID, "proposal_id").EntityID()
+	v.Field(c.UserID, "user_id").EntityID()
+	v.Field(c.FileName, "file_name").Required().MaxLength(255)
+	v.Field(c.StorageName, "storage_name").Required()
+}
+
+// AddAttachment stores a file on a proposal the author can still amend.
+// Each proposal is limited in how many attachments it holds and in their
+// combined size.
+func (s *ProposalService) AddAttachment(ctx context.Context, cmd *AddProposalAttachmentCommand) (*domain.ProposalAttachment, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+
+	proposal, ok := s.Proposals.GetByID(ctx, cmd.ProposalID)
+	if !ok || proposal.AuthorID != cmd.UserID {
+		return nil, errors.ErrNotFound
+	}
+	if !proposal.IsAmendable() {
+		return nil, errors.ErrInvalidStatusTransition
+	}
+
+	existing, err := s.Proposals.ListAttachments(ctx, proposal.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAttachmentLimits(existing, cmd.FileSize); err != nil {
+		return nil, err
+	}
+
+	storagePath, err := s.FileStorage.Save(ctx, cmd.StorageName, cmd.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	attachment := domain.ProposalAttachment{
+		ProposalID:  proposal.ID,
+		FileName:    cmd.FileName,
+		FileSize:    cmd.FileSize,
+		MimeType:    cmd.MimeType,
+		StoragePath: storagePath,
+	}
+	if err := s.Proposals.CreateAttachment(ctx, &attachment, maxProposalAttachments, maxProposalAttachmentBytes); err != nil {
+		s.FileStorage.Delete(ctx, storagePath)
+		if err == errors.ErrAttachmentLimitReached {
+			// Another upload got in first; report the limit it used up.
+			if existing, listErr := s.Proposals.ListAttachments(ctx, proposal.ID); listErr == nil {
+				if limitErr := checkAttachmentLimits(existing, cmd.FileSize); limitErr != nil {
+					return nil, limitErr
+				}
+			}
+		}
+		return nil, err
+	}
+
+	return &attachment, nil
+}
+
+// checkAttachmentLimits reports which limit adding a file of the given size to
+// existing would exceed. The repository enforces the same limits atomically.
+func checkAttachmentLimits(existing []domain.ProposalAttachment, size int64) error {
+	if len(existing) >= maxProposalAttachments {
+		errs := errors.NewValidationErrors()
+		errs.Add("file", "a proposal can have at most "+strconv.Itoa(maxProposalAttachments)+" attachments")
+		return errs
+	}
+	total := size
+	for _, a := range existing {
+		total += a.FileSize
+	}
+	if total > maxProposalAttachmentBytes {
+		errs := errors.NewValidationErrors()
+		errs.Add("file", "attachments can total at most "+strconv.Itoa(maxProposalAttachmentBytes>>20)+" MB per proposal")
+		return errs
+	}
+	return nil
+}
+
+type DeleteProposalAttachmentCommand struct {
+	ProposalID   int64 `json:"proposal_id"`
+	AttachmentID int64 `json:"attachment_id"`
+	UserID       int64 `json:"user_id"`
+}
+
+func (c *DeleteProposalAttachmentCommand) Validate(v *validation.Validator) {
+	v.Field(c.ProposalID, "proposal_id").EntityID()
+	v.Field(c.AttachmentID, "attachment_id").EntityID()
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+func (s *ProposalService) DeleteAttachment(ctx context.Context, cmd *DeleteProposalAttachmentCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	proposal, ok := s.Proposals.GetByID(ctx, cmd.ProposalID)
+	if !ok || proposal.AuthorID != cmd.UserID {
+		return errors.ErrNotFound
+	}
+	if !proposal.IsAmendable() {
+		return errors.ErrInvalidStatusTransition
+	}
+
+	attachment, ok := s.Proposals.GetAttachment(ctx, proposal.ID, cmd.AttachmentID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	if err := s.Proposals.DeleteAttachment(ctx, proposal.ID, attachment.ID); err != nil {
+		return err
+	}
+	s.FileStorage.Delete(ctx, attachment.StoragePath)
+
+	return nil
+}
+
+type ListProposalAttachmentsQuery struct {
+	ProposalID int64             `json:"proposal_id"`
+	UserID     int64             `json:"user_id"`
+	UserRole   domain.SystemRole `json:"user_role"`
+}
+
+// ListAttachments returns the proposal's attachments in upload order to
+// anyone who can view the proposal.
+func (s *ProposalService) ListAttachments(ctx context.Context, query *ListProposalAttachmentsQuery) ([]domain.ProposalAttachment, error) {
+	proposal, err := s.Get(ctx, &GetProposalQuery{
+		ProposalID: query.ProposalID,
+		UserID:     query.UserID,
+		UserRole:   query.UserRole,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Proposals.ListAttachments(ctx, proposal.ID)
+}
+
+type GetProposalAttachmentQuery struct {
+	ProposalID   int64
+	AttachmentID int64
+	UserID       int64
+	UserRole     domain.SystemRole
+}
+
+func (s *ProposalService) GetAttachmentForDownload(ctx context.Context, query *GetProposalAttachmentQuery) (*domain.ProposalAttachment, error) {
+	proposal, err := s.Get(ctx, &GetProposalQuery{
+		ProposalID: query.ProposalID,
+		UserID:     query.UserID,
+		UserRole:   query.UserRole,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attachment, ok := s.Proposals.GetAttachment(ctx, proposal.ID, query.AttachmentID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	return attachment, nil
+}
+
+func (s *ProposalService) GetAttachmentContent(ctx context.Context, attachment *domain.ProposalAttachment) (io.ReadCloser, error) {
+	return s.FileStorage.Read(ctx, attachment.StoragePath)
+}
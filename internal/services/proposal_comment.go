@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/validation"
+)
+
+type ProposalCommentView struct {
+	ID         int64                      `json:"id"`
+	AuthorID   int64                      `json:"author_id"`
+	AuthorName string                     `json:"author_name"`
+	Kind       domain.ProposalCommentKind `json:"kind"`
+	Body       string                     `json:"body"`
+	Vote       domain.ProposalVote        `json:"vote,omitempty"`
+	Status     domain.ProposalStatus      `json:"status"`
+	CreatedAt  time.Time                  `json:"created_at"`
+}
+
+type ListProposalCommentsQuery struct {
+	ProposalID int64             `json:"proposal_id"`
+	UserID     int64             `json:"user_id"`
+	UserRole   domain.SystemRole `json:"user_role"`
+}
+
+// ListComments returns the proposal's review conversation, oldest first.
+// It is visible to whoever can see the proposal itself.
+func (s *ProposalService) ListComments(ctx context.Context, query *ListProposalCommentsQuery) ([]ProposalCommentView, error) {
+	proposal, err := s.Get(ctx, &GetProposalQuery{
+		ProposalID: query.ProposalID,
+		UserID:     query.UserID,
+		UserRole:   query.UserRole,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := s.Proposals.ListComments(ctx, proposal.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[int64]string)
+	views := make([]ProposalCommentView, 0, len(comments))
+	for _, c := range comments {
+		name, ok := names[c.AuthorID]
+		if !ok {
+			if user, found := s.Users.GetByID(ctx, c.AuthorID); found {
+				name = user.Name
+				if name == "" {
+					name = user.Email
+				}
+			}
+			names[c.AuthorID] = name
+		}
+		views = append(views, ProposalCommentView{
+			ID:         c.ID,
+			AuthorID:   c.AuthorID,
+			AuthorName: name,
+			Kind:       c.Kind,
+			Body:       c.Body,
+			Vote:       c.Vote,
+			Status:     c.Status,
+			CreatedAt:  c.CreatedAt,
+		})
+	}
+
+	return views, nil
+}
+
+type AddProposalCommentCommand struct {
+	ProposalID int64             `json:"proposal_id"`
+	Body       string            `json:"body"`
+	UserID     int64             `json:"user_id"`
+	UserRole   domain.SystemRole `json:"user_role"`
+}
+
+func (c *AddProposalCommentCommand) Validate(v *validation.Validator) {
+	v.Field(c.ProposalID, "proposal_id").EntityID()
+	v.Field(c.Body, "body").Required().MaxLength(4096).IsTrimmed()
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+// AddComment posts a reply to the review conversation. Replies do not change
+// the proposal's status; decisions go through Approve, Reject and
+// RequestChanges.
+func (s *ProposalService) AddComment(ctx context.Context, cmd *AddProposalCommentCommand) (*domain.ProposalComment, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+
+	proposal, err := s.Get(ctx, &GetProposalQuery{
+		ProposalID: cmd.ProposalID,
+		UserID:     cmd.UserID,
+		UserRole:   cmd.UserRole,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !proposal.WasSubmitted() {
+		return nil, errors.ErrInvalidStatusTransition
+	}
+
+	comment := domain.ProposalComment{
+		ProposalID: proposal.ID,
+		AuthorID:   cmd.UserID,
+		Kind:       domain.ProposalCommentKindReply,
+		Body:       cmd.Body,
+		Status:     proposal.Status,
+	}
+	if err := s.Proposals.CreateComment(ctx, &comment); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewProposalCommentedEvent(proposal.ID, comment.ID, cmd.UserID)
+	_ = s.Events.Publish(ctx, event)
+
+	return &comment, nil
+}
@@ -0,0 +1,177 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/policy"
+	"bytecourses/internal/pkg/validation"
+)
+
+var (
+	_ Query = (*ListProposalQueueQuery)(nil)
+)
+
+const (
+	defaultProposalPageSize = 25
+	maxProposalPageSize     = 100
+	maxProposalWaitingDays  = 365
+)
+
+type ListProposalQueueQuery struct {
+	Statuses []domain.ProposalStatus `json:"statuses"`
+	Author   string                  `json:"author"`
+	Sort     domain.ProposalSort     `json:"sort"`
+	Order    string                  `json:"order"`
+	// WaitingDays flags submitted proposals that have waited longer than
+	// this many days for a decision. Zero disables the highlighting.
+	WaitingDays int               `json:"waiting_days"`
+	Cursor      string            `json:"cursor"`
+	Limit       int               `json:"limit"`
+	UserID      int64             `json:"user_id"`
+	UserRole    domain.SystemRole `json:"user_role"`
+}
+
+func (q *ListProposalQueueQuery) Validate(v *validation.Validator) {
+	v.Field(len(q.Statuses), "status").Max(len(domain.ReviewableProposalStatuses))
+	v.Field(q.Author, "author").MaxLength(255)
+	v.Field(q.WaitingDays, "waiting_days").Min(0).Max(maxProposalWaitingDays)
+	v.Field(q.Limit, "limit").Min(0).Max(maxProposalPageSize)
+	v.Field(q.Cursor, "cursor").MaxLength(512)
+}
+
+// ProposalQueueItem is a proposal as shown in the review queue, with its
+// author and how long it has been waiting since it was last submitted.
+type ProposalQueueItem struct {
+	domain.Proposal
+	AuthorName  string `json:"author_name"`
+	AuthorEmail string `json:"author_email"`
+	WaitingDays int    `json:"waiting_days"`
+	Overdue     bool   `json:"overdue"`
+}
+
+type ProposalQueuePage struct {
+	Proposals  []ProposalQueueItem           `json:"proposals"`
+	NextCursor string                        `json:"next_cursor"`
+	Counts     map[domain.ProposalStatus]int `json:"counts"`
+}
+
+func (s *ProposalService) ListQueue(ctx context.Context, query *ListProposalQueueQuery) (*ProposalQueuePage, error) {
+	if err := validation.Validate(query); err != nil {
+		return nil, err
+	}
+
+	if !policy.Allows(query.UserRole, policy.ReviewProposals) {
+		return nil, errors.ErrForbidden
+	}
+
+	for _, status := range query.Statuses {
+		if !slices.Contains(domain.ReviewableProposalStatuses, status) {
+			return nil, errors.ErrInvalidInput
+		}
+	}
+
+	switch query.Sort {
+	case "":
+		query.Sort = domain.ProposalSortUpdated
+	case domain.ProposalSortUpdated, domain.ProposalSortSubmitted:
+	default:
+		return nil, errors.ErrInvalidInput
+	}
+
+	var ascending bool
+	switch query.Order {
+	case "", "desc":
+	case "asc":
+		ascending = true
+	default:
+		return nil, errors.ErrInvalidInput
+	}
+
+	filter := domain.ProposalFilter{
+		Statuses:  query.Statuses,
+		Author:    query.Author,
+		Sort:      query.Sort,
+		Ascending: ascending,
+		Limit:     query.Limit,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultProposalPageSize
+	}
+	if query.Cursor != "" {
+		cursor, err := decodeProposalCursor(query.Cursor)
+		if err != nil || cursor.Sort != query.Sort || cursor.Ascending != ascending {
+			return nil, errors.ErrInvalidInput
+		}
+		filter.After = cursor
+	}
+
+	proposals, next, err := s.Proposals.ListQueue(ctx, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.Proposals.CountByStatus(ctx, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := ProposalQueuePage{
+		Proposals: make([]ProposalQueueItem, 0, len(proposals)),
+		Counts:    make(map[domain.ProposalStatus]int, len(domain.ReviewableProposalStatuses)),
+	}
+	for _, status := range domain.ReviewableProposalStatuses {
+		page.Counts[status] = counts[status]
+	}
+
+	now := time.Now()
+	authors := make(map[int64]*domain.User)
+	for _, p := range proposals {
+		item := ProposalQueueItem{Proposal: p}
+
+		author, ok := authors[p.AuthorID]
+		if !ok {
+			author, _ = s.Users.GetByID(ctx, p.AuthorID)
+			authors[p.AuthorID] = author
+		}
+		if author != nil {
+			item.AuthorName = author.Name
+			item.AuthorEmail = author.Email
+		}
+
+		waiting := now.Sub(p.QueuedAt())
+		item.WaitingDays = int(waiting / (24 * time.Hour))
+		item.Overdue = query.WaitingDays > 0 &&
+			p.Status == domain.ProposalStatusSubmitted &&
+			waiting > time.Duration(query.WaitingDays)*24*time.Hour
+
+		page.Proposals = append(page.Proposals, item)
+	}
+	if next != nil {
+		page.NextCursor = encodeProposalCursor(next)
+	}
+
+	return &page, nil
+}
+
+func encodeProposalCursor(cursor *domain.ProposalCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeProposalCursor(s string) (*domain.ProposalCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var cursor domain.ProposalCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
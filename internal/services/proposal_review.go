@@ -0,0 +1,407 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/policy"
+	"bytecourses/internal/pkg/validation"
+)
+
+var (
+	_ Command = (*AssignReviewerCommand)(nil)
+	_ Command = (*AutoAssignReviewerCommand)(nil)
+	_ Command = (*UnassignReviewerCommand)(nil)
+)
+
+var (
+	_ Query = (*ListProposalReviewersQuery)(nil)
+	_ Query = (*ListReviewQueueQuery)(nil)
+)
+
+// assignRoundRobin tops the proposal up to want reviewers, picking whoever
+// was assigned least recently. Reviewers who have never been assigned go
+// first, then the lowest user ID.
+func (s *ProposalService) assignRoundRobin(ctx context.Context, proposal *domain.Proposal, want int, assignedBy *int64) ([]int64, error) {
+	if want < 1 {
+		want = 1
+	}
+
+	current, err := s.Assignments.ListAssignments(ctx, proposal.ID)
+	if err != nil {
+		return nil, err
+	}
+	need := want - len(current)
+	if need <= 0 {
+		return nil, nil
+	}
+
+	assigned := make(map[int64]bool, len(current))
+	for i := range current {
+		assigned[current[i].ReviewerID] = true
+	}
+
+	candidates := make([]domain.User, 0)
+	for _, role := range policy.RolesAllowing(policy.ReviewProposals) {
+		users, _, err := s.Users.List(ctx, &domain.UserFilter{Role: role})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range users {
+			if u.ID == proposal.AuthorID || u.IsSuspended() || assigned[u.ID] {
+				continue
+			}
+			candidates = append(candidates, u)
+		}
+	}
+
+	last, err := s.Assignments.LastAssignedAt(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		a, b := last[candidates[i].ID], last[candidates[j].ID]
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+
+	if need > len(candidates) {
+		need = len(candidates)
+	}
+	reviewerIDs := make([]int64, 0, need)
+	for _, u := range candidates[:need] {
+		assignment := domain.ProposalAssignment{
+			ProposalID: proposal.ID,
+			ReviewerID: u.ID,
+			AssignedBy: assignedBy,
+		}
+		if err := s.Assignments.AssignReviewer(ctx, &assignment); err != nil {
+			return nil, err
+		}
+		reviewerIDs = append(reviewerIDs, u.ID)
+
+		event := domain.NewProposalReviewerAssignedEvent(proposal.ID, u.ID, assignedBy, proposal.Title)
+		_ = s.Events.Publish(ctx, event)
+	}
+
+	return reviewerIDs, nil
+}
+
+// isUnderReview reports whether reviewers can still be changed. Reviewers
+// stay assigned while the author works on requested changes.
+func isUnderReview(p *domain.Proposal) bool {
+	return p.Status == domain.ProposalStatusSubmitted ||
+		p.Status == domain.ProposalStatusChangesRequested
+}
+
+type AssignReviewerCommand struct {
+	ProposalID int64             `json:"proposal_id"`
+	ReviewerID int64             `json:"reviewer_id"`
+	UserID     int64             `json:"user_id"`
+	UserRole   domain.SystemRole `json:"user_role"`
+}
+
+func (c *AssignReviewerCommand) Validate(v *validation.Validator) {
+	v.Field(c.ProposalID, "proposal_id").EntityID()
+	v.Field(c.ReviewerID, "reviewer_id").Required().EntityID()
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+func (s *ProposalService) AssignReviewer(ctx context.Context, cmd *AssignReviewerCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+	if !policy.Allows(cmd.UserRole, policy.AssignReviewers) {
+		return errors.ErrForbidden
+	}
+
+	proposal, ok := s.Proposals.GetByID(ctx, cmd.ProposalID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !isUnderReview(proposal) {
+		return errors.ErrInvalidStatusTransition
+	}
+
+	reviewer, ok := s.Users.GetByID(ctx, cmd.ReviewerID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !policy.Allows(reviewer.Role, policy.ReviewProposals) || reviewer.IsSuspended() {
+		errs := errors.NewValidationErrors()
+		errs.Add("reviewer_id", "must be an active reviewer or admin")
+		return errs
+	}
+	if reviewer.ID == proposal.AuthorID {
+		errs := errors.NewValidationErrors()
+		errs.Add("reviewer_id", "cannot review their own proposal")
+		return errs
+	}
+
+	assignment := domain.ProposalAssignment{
+		ProposalID: proposal.ID,
+		ReviewerID: reviewer.ID,
+		AssignedBy: &cmd.UserID,
+	}
+	if err := s.Assignments.AssignReviewer(ctx, &assignment); err != nil {
+		return err
+	}
+
+	event := domain.NewProposalReviewerAssignedEvent(proposal.ID, reviewer.ID, &cmd.UserID, proposal.Title)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+type AutoAssignReviewerCommand struct {
+	ProposalID int64             `json:"proposal_id"`
+	UserID     int64             `json:"user_id"`
+	UserRole   domain.SystemRole `json:"user_role"`
+}
+
+func (c *AutoAssignReviewerCommand) Validate(v *validation.Validator) {
+	v.Field(c.ProposalID, "proposal_id").EntityID()
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+// AutoAssignReviewer adds the next reviewer in the round-robin rotation.
+func (s *ProposalService) AutoAssignReviewer(ctx context.Context, cmd *AutoAssignReviewerCommand) (int64, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return 0, err
+	}
+	if !policy.Allows(cmd.UserRole, policy.AssignReviewers) {
+		return 0, errors.ErrForbidden
+	}
+
+	proposal, ok := s.Proposals.GetByID(ctx, cmd.ProposalID)
+	if !ok {
+		return 0, errors.ErrNotFound
+	}
+	if !isUnderReview(proposal) {
+		return 0, errors.ErrInvalidStatusTransition
+	}
+
+	current, err := s.Assignments.ListAssignments(ctx, proposal.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	reviewerIDs, err := s.assignRoundRobin(ctx, proposal, len(current)+1, &cmd.UserID)
+	if err != nil {
+		return 0, err
+	}
+	if len(reviewerIDs) == 0 {
+		return 0, errors.ErrConflict
+	}
+
+	return reviewerIDs[0], nil
+}
+
+type UnassignReviewerCommand struct {
+	ProposalID int64             `json:"proposal_id"`
+	ReviewerID int64             `json:"reviewer_id"`
+	UserID     int64             `json:"user_id"`
+	UserRole   domain.SystemRole `json:"user_role"`
+}
+
+func (c *UnassignReviewerCommand) Validate(v *validation.Validator) {
+	v.Field(c.ProposalID, "proposal_id").EntityID()
+	v.Field(c.ReviewerID, "reviewer_id").EntityID()
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+// UnassignReviewer takes a reviewer off the proposal along with their vote.
+// Fewer reviewers can lower the quorum, so the votes already cast may now
+// decide the proposal.
+func (s *ProposalService) UnassignReviewer(ctx context.Context, cmd *UnassignReviewerCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+	if !policy.Allows(cmd.UserRole, policy.AssignReviewers) {
+		return errors.ErrForbidden
+	}
+
+	proposal, ok := s.Proposals.GetByID(ctx, cmd.ProposalID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !isUnderReview(proposal) {
+		return errors.ErrInvalidStatusTransition
+	}
+
+	if err := s.Assignments.RemoveAssignment(ctx, proposal.ID, cmd.ReviewerID); err != nil {
+		return err
+	}
+
+	event := domain.NewProposalReviewerUnassignedEvent(proposal.ID, cmd.ReviewerID, cmd.UserID)
+	_ = s.Events.Publish(ctx, event)
+
+	if proposal.Status != domain.ProposalStatusSubmitted {
+		return nil
+	}
+	decided, err := s.decide(ctx, proposal, cmd.UserID)
+	if err != nil {
+		return err
+	}
+	if decided {
+		s.publishDecision(ctx, proposal, cmd.UserID)
+	}
+
+	return nil
+}
+
+type ProposalReviewerView struct {
+	ReviewerID   int64               `json:"reviewer_id"`
+	Name         string              `json:"name"`
+	Email        string              `json:"email"`
+	Vote         domain.ProposalVote `json:"vote,omitempty"`
+	Notes        string              `json:"notes"`
+	AssignedAt   time.Time           `json:"assigned_at"`
+	VotedAt      *time.Time          `json:"voted_at,omitempty"`
+	PendingSince time.Time           `json:"pending_since"`
+}
+
+type ProposalReviewers struct {
+	Reviewers     []ProposalReviewerView `json:"reviewers"`
+	VotesRequired int                    `json:"votes_required"`
+}
+
+type ListProposalReviewersQuery struct {
+	ProposalID int64             `json:"proposal_id"`
+	UserID     int64             `json:"user_id"`
+	UserRole   domain.SystemRole `json:"user_role"`
+}
+
+func (s *ProposalService) ListReviewers(ctx context.Context, query *ListProposalReviewersQuery) (*ProposalReviewers, error) {
+	if !policy.Allows(query.UserRole, policy.ReviewProposals) {
+		return nil, errors.ErrForbidden
+	}
+
+	proposal, err := s.Get(ctx, &GetProposalQuery{
+		ProposalID: query.ProposalID,
+		UserID:     query.UserID,
+		UserRole:   query.UserRole,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.Assignments.ListAssignments(ctx, proposal.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	views := make([]ProposalReviewerView, 0, len(assignments))
+	for _, a := range assignments {
+		view := ProposalReviewerView{
+			ReviewerID:   a.ReviewerID,
+			Vote:         a.Vote,
+			Notes:        a.Notes,
+			AssignedAt:   a.AssignedAt,
+			VotedAt:      a.VotedAt,
+			PendingSince: a.PendingSince,
+		}
+		if user, ok := s.Users.GetByID(ctx, a.ReviewerID); ok {
+			view.Name = user.Name
+			view.Email = user.Email
+		}
+		views = append(views, view)
+	}
+
+	required := s.Quorum.Votes
+	if required < 1 {
+		required = 1
+	}
+	if len(views) > 0 && required > len(views) {
+		required = len(views)
+	}
+
+	return &ProposalReviewers{Reviewers: views, VotesRequired: required}, nil
+}
+
+// ReviewerAssignment returns the user's assignment on the proposal, if any,
+// and whether they may vote on it now.
+func (s *ProposalService) ReviewerAssignment(ctx context.Context, proposal *domain.Proposal, userID int64) (*domain.ProposalAssignment, bool) {
+	if proposal.Status != domain.ProposalStatusSubmitted {
+		return nil, false
+	}
+	if assignment, ok := s.Assignments.GetAssignment(ctx, proposal.ID, userID); ok {
+		return assignment, true
+	}
+
+	claimable, err := s.claimable(ctx, proposal, userID)
+	return nil, err == nil && claimable
+}
+
+type ReviewQueueItem struct {
+	Proposal     domain.Proposal     `json:"proposal"`
+	Vote         domain.ProposalVote `json:"vote,omitempty"`
+	PendingSince time.Time           `json:"pending_since"`
+}
+
+type ListReviewQueueQuery struct {
+	UserID int64 `json:"user_id"`
+}
+
+// ListReviewQueue returns the submitted proposals assigned to the reviewer,
+// those still waiting for their vote first and the oldest at the top.
+func (s *ProposalService) ListReviewQueue(ctx context.Context, query *ListReviewQueueQuery) ([]ReviewQueueItem, error) {
+	assignments, err := s.Assignments.ListAssignmentsByReviewer(ctx, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]ReviewQueueItem, 0)
+	voted := make([]ReviewQueueItem, 0)
+	for _, a := range assignments {
+		proposal, ok := s.Proposals.GetByID(ctx, a.ProposalID)
+		if !ok || proposal.Status != domain.ProposalStatusSubmitted {
+			continue
+		}
+		item := ReviewQueueItem{
+			Proposal:     *proposal,
+			Vote:         a.Vote,
+			PendingSince: a.PendingSince,
+		}
+		if a.HasVoted() {
+			voted = append(voted, item)
+		} else {
+			pending = append(pending, item)
+		}
+	}
+
+	return append(pending, voted...), nil
+}
+
+// RemindStaleReviews nudges reviewers who have not voted on a submitted
+// proposal within staleAfter, then waits another staleAfter before
+// reminding them again. It returns how many reminders were sent.
+func (s *ProposalService) RemindStaleReviews(ctx context.Context, staleAfter time.Duration) (int, error) {
+	now := time.Now()
+	stale, err := s.Assignments.ListPendingSince(ctx, now.Add(-staleAfter))
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, a := range stale {
+		proposal, ok := s.Proposals.GetByID(ctx, a.ProposalID)
+		if !ok || proposal.Status != domain.ProposalStatusSubmitted {
+			continue
+		}
+		if err := s.Assignments.MarkReminded(ctx, a.ProposalID, a.ReviewerID, now); err != nil {
+			return sent, err
+		}
+
+		event := domain.NewProposalReviewReminderEvent(a.ProposalID, a.ReviewerID, proposal.Title, a.PendingSince)
+		_ = s.Events.Publish(ctx, event)
+		sent++
+	}
+
+	return sent, nil
+}
@@ -0,0 +1,130 @@
+package services
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/pkg/diff"
+	"bytecourses/internal/pkg/errors"
+)
+
+var (
+	_ Query = (*ListProposalVersionsQuery)(nil)
+	_ Query = (*DiffProposalVersionsQuery)(nil)
+)
+
+type ProposalFieldDiff struct {
+	Field   string `json:"field"`
+	Changed bool   `json:"changed"`
+	Diff    string `json:"diff"`
+}
+
+type ProposalVersionDiff struct {
+	ProposalID int64               `json:"proposal_id"`
+	From       int                 `json:"from"`
+	To         int                 `json:"to"`
+	Fields     []ProposalFieldDiff `json:"fields"`
+}
+
+type ListProposalVersionsQuery struct {
+	ProposalID int64             `json:"proposal_id"`
+	UserID     int64             `json:"user_id"`
+	UserRole   domain.SystemRole `json:"user_role"`
+}
+
+// ListVersions returns the proposal's submitted versions, newest first.
+func (s *ProposalService) ListVersions(ctx context.Context, query *ListProposalVersionsQuery) ([]domain.ProposalVersion, error) {
+	proposal, err := s.Get(ctx, &GetProposalQuery{
+		ProposalID: query.ProposalID,
+		UserID:     query.UserID,
+		UserRole:   query.UserRole,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Proposals.ListVersions(ctx, proposal.ID)
+}
+
+type DiffProposalVersionsQuery struct {
+	ProposalID int64             `json:"proposal_id"`
+	From       int               `json:"from"`
+	To         int               `json:"to"`
+	UserID     int64             `json:"user_id"`
+	UserRole   domain.SystemRole `json:"user_role"`
+}
+
+// DiffVersions compares two submitted versions field by field, so reviewers
+// can check that the changes they asked for were made.
+func (s *ProposalService) DiffVersions(ctx context.Context, query *DiffProposalVersionsQuery) (*ProposalVersionDiff, error) {
+	proposal, err := s.Get(ctx, &GetProposalQuery{
+		ProposalID: query.ProposalID,
+		UserID:     query.UserID,
+		UserRole:   query.UserRole,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	from, ok := s.Proposals.GetVersion(ctx, proposal.ID, query.From)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	to, ok := s.Proposals.GetVersion(ctx, proposal.ID, query.To)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	fromName := "version " + strconv.Itoa(from.Number)
+	toName := "version " + strconv.Itoa(to.Number)
+	fields := []struct {
+		name     string
+		from, to string
+	}{
+		{"title", from.Title, to.Title},
+		{"summary", from.Summary, to.Summary},
+		{"qualifications", from.Qualifications, to.Qualifications},
+		{"target_audience", from.TargetAudience, to.TargetAudience},
+		{"learning_objectives", from.LearningObjectives, to.LearningObjectives},
+		{"outline", from.Outline, to.Outline},
+		{"outline_modules", outlineText(from.OutlineModules), outlineText(to.OutlineModules)},
+		{"assumed_prerequisites", from.AssumedPrerequisites, to.AssumedPrerequisites},
+	}
+
+	result := &ProposalVersionDiff{
+		ProposalID: proposal.ID,
+		From:       from.Number,
+		To:         to.Number,
+		Fields:     make([]ProposalFieldDiff, 0, len(fields)),
+	}
+	for _, f := range fields {
+		d := diff.Unified(fromName+" "+f.name, toName+" "+f.name, f.from, f.to)
+		result.Fields = append(result.Fields, ProposalFieldDiff{
+			Field:   f.name,
+			Changed: d != "",
+			Diff:    d,
+		})
+	}
+
+	return result, nil
+}
+
+// outlineText renders a structured outline one line per module, description
+// and item, so that it can be diffed like the free-text fields.
+func outlineText(modules []domain.OutlineModule) string {
+	var b strings.Builder
+	for i, m := range modules {
+		b.WriteString(strconv.Itoa(i+1) + ". " + m.Title + "\n")
+		if m.Description != "" {
+			for _, line := range strings.Split(m.Description, "\n") {
+				b.WriteString("   " + line + "\n")
+			}
+		}
+		for _, item := range m.Items {
+			b.WriteString("   - " + item + "\n")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,165 @@
+package services
+
+import (
+	"context"
+	"strconv"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/pkg/diff"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/policy"
+	"bytecourses/internal/pkg/validation"
+)
+
+var (
+	_ Command = (*RestoreReadingRevisionCommand)(nil)
+)
+
+var (
+	_ Query = (*ListReadingRevisionsQuery)(nil)
+	_ Query = (*DiffReadingRevisionsQuery)(nil)
+)
+
+type ReadingRevisionDiff struct {
+	ReadingID int64  `json:"reading_id"`
+	From      int    `json:"from"`
+	To        int    `json:"to"`
+	Diff      string `json:"diff"`
+}
+
+type ListReadingRevisionsQuery struct {
+	ReadingID int64 `json:"reading_id"`
+	ModuleID  int64 `json:"module_id"`
+	UserID    int64 `json:"user_id"`
+}
+
+func (s *ContentService) ListRevisions(ctx context.Context, query *ListReadingRevisionsQuery) ([]domain.ReadingRevision, error) {
+	if _, _, err := s.instructorReading(ctx, query.ReadingID, query.ModuleID, query.UserID); err != nil {
+		return nil, err
+	}
+
+	return s.Readings.ListRevisions(ctx, query.ReadingID)
+}
+
+type DiffReadingRevisionsQuery struct {
+	ReadingID int64 `json:"reading_id"`
+	ModuleID  int64 `json:"module_id"`
+	From      int   `json:"from"`
+	To        int   `json:"to"`
+	UserID    int64 `json:"user_id"`
+}
+
+func (s *ContentService) DiffRevisions(ctx context.Context, query *DiffReadingRevisionsQuery) (*ReadingRevisionDiff, error) {
+	if _, _, err := s.instructorReading(ctx, query.ReadingID, query.ModuleID, query.UserID); err != nil {
+		return nil, err
+	}
+
+	from, ok := s.Readings.GetRevision(ctx, query.ReadingID, query.From)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	to, ok := s.Readings.GetRevision(ctx, query.ReadingID, query.To)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	return &ReadingRevisionDiff{
+		ReadingID: query.ReadingID,
+		From:      from.Number,
+		To:        to.Number,
+		Diff: diff.Unified(
+			"revision "+strconv.Itoa(from.Number),
+			"revision "+strconv.Itoa(to.Number),
+			from.Content,
+			to.Content,
+		),
+	}, nil
+}
+
+type RestoreReadingRevisionCommand struct {
+	ReadingID int64 `json:"reading_id"`
+	ModuleID  int64 `json:"module_id"`
+	Number    int   `json:"number"`
+	UserID    int64 `json:"user_id"`
+}
+
+func (c *RestoreReadingRevisionCommand) Validate(v *validation.Validator) {
+	v.Field(c.ReadingID, "reading_id").EntityID()
+	v.Field(c.ModuleID, "module_id").EntityID()
+	v.Field(c.Number, "number").Min(1)
+	v.Field(c.UserID, "user_id").EntityID()
+}
+
+func (s *ContentService) RestoreRevision(ctx context.Context, cmd *RestoreReadingRevisionCommand) (*domain.ReadingRevision, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+
+	reading, course, err := s.instructorReading(ctx, cmd.ReadingID, cmd.ModuleID, cmd.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	old, ok := s.Readings.GetRevision(ctx, cmd.ReadingID, cmd.Number)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	content := old.Content
+	reading.Format = old.Format
+	reading.Content = &content
+	if err := s.Readings.Update(ctx, reading); err != nil {
+		return nil, err
+	}
+
+	revision := domain.ReadingRevision{
+		ReadingID:    reading.ID,
+		AuthorID:     cmd.UserID,
+		Format:       old.Format,
+		Content:      old.Content,
+		RestoredFrom: &old.Number,
+	}
+	if err := s.Readings.CreateRevision(ctx, &revision); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewContentUpdatedEvent(domain.ContentTypeReading, reading.ID, reading.ModuleID, course.ID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return &revision, nil
+}
+
+func (s *ContentService) instructorReading(ctx context.Context, readingID, moduleID, userID int64) (*domain.Reading, *domain.Course, error) {
+	reading, ok := s.Readings.GetByID(ctx, readingID)
+	if !ok || reading.ModuleID != moduleID {
+		return nil, nil, errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, reading.ModuleID)
+	if !ok {
+		return nil, nil, errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return nil, nil, errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: userID}, course, policy.EditCourse) {
+		return nil, nil, errors.ErrNotFound
+	}
+
+	return reading, course, nil
+}
+
+func (s *ContentService) recordRevision(ctx context.Context, reading *domain.Reading, authorID int64) error {
+	revision := domain.ReadingRevision{
+		ReadingID: reading.ID,
+		AuthorID:  authorID,
+		Format:    reading.Format,
+	}
+	if reading.Content != nil {
+		revision.Content = *reading.Content
+	}
+
+	return s.Readings.CreateRevision(ctx, &revision)
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/pkg/search"
+	"bytecourses/internal/pkg/validation"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 50
+	snippetLength      = 200
+)
+
+var (
+	_ Query = (*SearchQuery)(nil)
+)
+
+type SearchService struct {
+	Index persistence.SearchRepository
+}
+
+func NewSearchService(index persistence.SearchRepository) *SearchService {
+	return &SearchService{
+		Index: index,
+	}
+}
+
+type SearchQuery struct {
+	Query string `json:"q"`
+	Limit int    `json:"limit"`
+}
+
+func (q *SearchQuery) Validate(v *validation.Validator) {
+	v.Field(q.Query, "q").MaxLength(200)
+	v.Field(q.Limit, "limit").Min(0).Max(maxSearchLimit)
+}
+
+func (s *SearchService) Search(ctx context.Context, query *SearchQuery) ([]domain.SearchResult, error) {
+	query.Query = strings.TrimSpace(query.Query)
+	if err := validation.Validate(query); err != nil {
+		return nil, err
+	}
+
+	terms := search.Terms(query.Query)
+	if len(terms) == 0 {
+		return []domain.SearchResult{}, nil
+	}
+
+	limit := query.Limit
+	if limit == 0 {
+		limit = defaultSearchLimit
+	}
+
+	results, err := s.Index.Search(ctx, query.Query, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		results[i].URL = searchResultURL(&results[i])
+		results[i].Snippet = search.Snippet(results[i].Text, terms, snippetLength)
+	}
+
+	return results, nil
+}
+
+func searchResultURL(result *domain.SearchResult) string {
+	switch result.Type {
+	case domain.SearchResultModule:
+		return fmt.Sprintf("/courses/%d/modules/%d", result.CourseID, result.ModuleID)
+	case domain.SearchResultReading:
+		return fmt.Sprintf("/courses/%d/modules/%d/content/%d", result.CourseID, result.ModuleID, result.ID)
+	default:
+		return fmt.Sprintf("/courses/%d", result.CourseID)
+	}
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+
+	"bytecourses/internal/infrastructure/auth"
+	"bytecourses/internal/pkg/validation"
+)
+
+var (
+	_ Query   = (*ListSessionsQuery)(nil)
+	_ Command = (*RevokeSessionCommand)(nil)
+	_ Command = (*RevokeOtherSessionsCommand)(nil)
+)
+
+type ListSessionsQuery struct {
+	UserID           int64  `json:"-"`
+	CurrentSessionID string `json:"-"`
+}
+
+func (s *AuthService) ListSessions(ctx context.Context, query *ListSessionsQuery) ([]auth.SessionInfo, error) {
+	sessions, err := s.Sessions.ListByUserID(query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentID := auth.SessionPublicID(query.CurrentSessionID)
+	for i := range sessions {
+		sessions[i].Current = sessions[i].ID == currentID
+	}
+
+	return sessions, nil
+}
+
+type RevokeSessionCommand struct {
+	UserID    int64  `json:"-"`
+	SessionID string `json:"-"`
+}
+
+func (c *RevokeSessionCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.SessionID, "session_id").Required().MaxLength(64)
+}
+
+func (s *AuthService) RevokeSession(ctx context.Context, cmd *RevokeSessionCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	return s.Sessions.DeleteByPublicID(cmd.UserID, cmd.SessionID)
+}
+
+type RevokeOtherSessionsCommand struct {
+	UserID           int64  `json:"-"`
+	CurrentSessionID string `json:"-"`
+}
+
+func (c *RevokeOtherSessionsCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.CurrentSessionID, "current_session_id").Required()
+}
+
+func (s *AuthService) RevokeOtherSessions(ctx context.Context, cmd *RevokeOtherSessionsCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	return s.Sessions.DeleteOthersByUserID(cmd.UserID, cmd.CurrentSessionID)
+}
@@ -0,0 +1,301 @@
+package services
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/persistence"
+	"bytecourses/internal/infrastructure/storage"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/events"
+	"bytecourses/internal/pkg/policy"
+	"bytecourses/internal/pkg/validation"
+)
+
+var (
+	_ Command = (*CreateSubmissionCommand)(nil)
+	_ Command = (*GradeSubmissionCommand)(nil)
+)
+
+var (
+	_ Query = (*ListSubmissionsQuery)(nil)
+	_ Query = (*GetSubmissionFileQuery)(nil)
+)
+
+type SubmissionService struct {
+	Submissions persistence.SubmissionRepository
+	Assignments persistence.AssignmentRepository
+	Modules     persistence.ModuleRepository
+	Courses     persistence.CourseRepository
+	Enrollments persistence.EnrollmentRepository
+	Progress    persistence.ProgressRepository
+	Readings    persistence.ReadingRepository
+	Files       persistence.FileRepository
+	Authorizer  *policy.Authorizer
+	Events      events.EventBus
+	FileStorage storage.FileStorage
+}
+
+func NewSubmissionService(
+	submissions persistence.SubmissionRepository,
+	assignments persistence.AssignmentRepository,
+	modules persistence.ModuleRepository,
+	courses persistence.CourseRepository,
+	enrollments persistence.EnrollmentRepository,
+	progress persistence.ProgressRepository,
+	readings persistence.ReadingRepository,
+	files persistence.FileRepository,
+	authorizer *policy.Authorizer,
+	eventBus events.EventBus,
+	fileStorage storage.FileStorage,
+) *SubmissionService {
+	return &SubmissionService{
+		Submissions: submissions,
+		Assignments: assignments,
+		Modules:     modules,
+		Courses:     courses,
+		Enrollments: enrollments,
+		Progress:    progress,
+		Readings:    readings,
+		Files:       files,
+		Authorizer:  authorizer,
+		Events:      eventBus,
+		FileStorage: fileStorage,
+	}
+}
+
+func (s *SubmissionService) gate() moduleGate {
+	return moduleGate{
+		Modules:     s.Modules,
+		Enrollments: s.Enrollments,
+		Progress:    s.Progress,
+		Readings:    s.Readings,
+		Files:       s.Files,
+	}
+}
+
+type CreateSubmissionCommand struct {
+	AssignmentID int64  `json:"assignment_id"`
+	ModuleID     int64  `json:"module_id"`
+	UserID       int64  `json:"user_id"`
+	Text         string `json:"text"`
+	FileName     string `json:"file_name"`
+	FileSize     int64  `json:"file_size"`
+	MimeType     string `json:"mime_type"`
+	StorageName  string `json:"storage_name"`
+	Content      io.Reader
+}
+
+func (c *CreateSubmissionCommand) Validate(v *validation.Validator) {
+	v.Field(c.AssignmentID, "assignment_id").EntityID()
+	v.Field(c.ModuleID, "module_id").EntityID()
+	v.Field(c.UserID, "user_id").EntityID()
+	v.Field(c.Text, "text").MaxLength(65536)
+	if c.Content == nil {
+		v.Field(strings.TrimSpace(c.Text), "text").Required()
+	} else {
+		v.Field(c.FileName, "file_name").Required().MaxLength(255)
+		v.Field(c.StorageName, "storage_name").Required()
+	}
+}
+
+func (s *SubmissionService) Create(ctx context.Context, cmd *CreateSubmissionCommand) (*domain.Submission, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+
+	assignment, ok := s.Assignments.GetByID(ctx, cmd.AssignmentID)
+	if !ok || assignment.ModuleID != cmd.ModuleID {
+		return nil, errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, assignment.ModuleID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	if _, ok := s.Enrollments.GetByUserAndCourse(ctx, cmd.UserID, course.ID); !ok {
+		return nil, errors.ErrForbidden
+	}
+	if module.Status != domain.ModuleStatusPublished || assignment.Status != domain.ContentStatusPublished {
+		return nil, errors.ErrNotFound
+	}
+	if err := s.gate().check(ctx, cmd.UserID, module); err != nil {
+		return nil, err
+	}
+
+	submission := domain.Submission{
+		AssignmentID: assignment.ID,
+		UserID:       cmd.UserID,
+		Text:         cmd.Text,
+		Late:         assignment.IsPastDue(time.Now()),
+		Status:       domain.SubmissionStatusSubmitted,
+	}
+
+	if cmd.Content != nil {
+		storagePath, err := s.FileStorage.Save(ctx, cmd.StorageName, cmd.Content)
+		if err != nil {
+			return nil, err
+		}
+		submission.FileName = cmd.FileName
+		submission.FileSize = cmd.FileSize
+		submission.MimeType = cmd.MimeType
+		submission.StoragePath = storagePath
+	}
+
+	if err := s.Submissions.Create(ctx, &submission); err != nil {
+		if submission.HasFile() {
+			s.FileStorage.Delete(ctx, submission.StoragePath)
+		}
+		return nil, err
+	}
+
+	event := domain.NewSubmissionCreatedEvent(submission.ID, assignment.ID, module.ID, course.ID, cmd.UserID, course.InstructorID)
+	_ = s.Events.Publish(ctx, event)
+
+	return &submission, nil
+}
+
+type ListSubmissionsQuery struct {
+	AssignmentID int64             `json:"assignment_id"`
+	ModuleID     int64             `json:"module_id"`
+	UserID       int64             `json:"user_id"`
+	UserRole     domain.SystemRole `json:"user_role"`
+}
+
+func (s *SubmissionService) List(ctx context.Context, query *ListSubmissionsQuery) ([]domain.Submission, error) {
+	assignment, ok := s.Assignments.GetByID(ctx, query.AssignmentID)
+	if !ok || assignment.ModuleID != query.ModuleID {
+		return nil, errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, assignment.ModuleID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	actor := policy.Actor{UserID: query.UserID, Role: query.UserRole}
+	if s.Authorizer.Can(ctx, actor, course, policy.ViewSubmissions) {
+		return s.Submissions.ListByAssignmentID(ctx, assignment.ID)
+	}
+
+	return s.Submissions.ListByAssignmentAndUser(ctx, assignment.ID, query.UserID)
+}
+
+type GradeSubmissionCommand struct {
+	SubmissionID int64  `json:"submission_id"`
+	AssignmentID int64  `json:"assignment_id"`
+	UserID       int64  `json:"user_id"`
+	Grade        int    `json:"grade"`
+	Feedback     string `json:"feedback"`
+}
+
+func (c *GradeSubmissionCommand) Validate(v *validation.Validator) {
+	v.Field(c.SubmissionID, "submission_id").EntityID()
+	v.Field(c.AssignmentID, "assignment_id").EntityID()
+	v.Field(c.UserID, "user_id").EntityID()
+	v.Field(c.Grade, "grade").Min(0)
+	v.Field(c.Feedback, "feedback").MaxLength(8192).IsTrimmed()
+}
+
+func (s *SubmissionService) Grade(ctx context.Context, cmd *GradeSubmissionCommand) (*domain.Submission, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+
+	submission, ok := s.Submissions.GetByID(ctx, cmd.SubmissionID)
+	if !ok || submission.AssignmentID != cmd.AssignmentID {
+		return nil, errors.ErrNotFound
+	}
+
+	assignment, ok := s.Assignments.GetByID(ctx, submission.AssignmentID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, assignment.ModuleID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	if !s.Authorizer.Can(ctx, policy.Actor{UserID: cmd.UserID}, course, policy.GradeSubmissions) {
+		return nil, errors.ErrForbidden
+	}
+
+	if cmd.Grade > assignment.MaxPoints {
+		return nil, errors.ErrInvalidInput
+	}
+
+	now := time.Now()
+	grade := cmd.Grade
+	graderID := cmd.UserID
+	submission.Grade = &grade
+	submission.Feedback = cmd.Feedback
+	submission.GradedBy = &graderID
+	submission.GradedAt = &now
+	submission.Status = domain.SubmissionStatusGraded
+	if err := s.Submissions.Update(ctx, submission); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewSubmissionGradedEvent(submission.ID, assignment.ID, module.ID, course.ID, submission.UserID, cmd.UserID, grade, assignment.MaxPoints, submission.Feedback)
+	_ = s.Events.Publish(ctx, event)
+
+	return submission, nil
+}
+
+type GetSubmissionFileQuery struct {
+	SubmissionID int64
+	UserID       int64
+	UserRole     domain.SystemRole
+}
+
+func (s *SubmissionService) GetFileForDownload(ctx context.Context, query *GetSubmissionFileQuery) (*domain.Submission, error) {
+	submission, ok := s.Submissions.GetByID(ctx, query.SubmissionID)
+	if !ok || !submission.HasFile() {
+		return nil, errors.ErrNotFound
+	}
+
+	assignment, ok := s.Assignments.GetByID(ctx, submission.AssignmentID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	module, ok := s.Modules.GetByID(ctx, assignment.ModuleID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	course, ok := s.Courses.GetByID(ctx, module.CourseID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	actor := policy.Actor{UserID: query.UserID, Role: query.UserRole}
+	if submission.UserID != query.UserID && !s.Authorizer.Can(ctx, actor, course, policy.ViewSubmissions) {
+		return nil, errors.ErrForbidden
+	}
+
+	return submission, nil
+}
+
+func (s *SubmissionService) GetFileContent(ctx context.Context, submission *domain.Submission) (io.ReadCloser, error) {
+	return s.FileStorage.Read(ctx, submission.StoragePath)
+}
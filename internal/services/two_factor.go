@@ -0,0 +1,454 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/auth"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/qr"
+	"bytecourses/internal/pkg/totp"
+	"bytecourses/internal/pkg/validation"
+)
+
+const (
+	totpIssuer         = "ByteCourses"
+	recoveryCodeCount  = 10
+	recoveryCodeLength = 10
+	recoveryCodeChars  = "abcdefghijklmnopqrstuvwxyz234567"
+)
+
+var (
+	_ Command = (*BeginTwoFactorSetupCommand)(nil)
+	_ Command = (*BeginPendingTwoFactorSetupCommand)(nil)
+	_ Command = (*ConfirmTwoFactorCommand)(nil)
+	_ Command = (*VerifyTwoFactorCommand)(nil)
+	_ Command = (*RegenerateRecoveryCodesCommand)(nil)
+	_ Command = (*DisableTwoFactorCommand)(nil)
+	_ Command = (*UpdateRolePolicyCommand)(nil)
+	_ Query   = (*GetRolePolicyQuery)(nil)
+)
+
+type TwoFactorSetup struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+	QRCode          string `json:"qr_code"`
+}
+
+type BeginTwoFactorSetupCommand struct {
+	UserID int64 `json:"-"`
+}
+
+func (c *BeginTwoFactorSetupCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+}
+
+func (s *AuthService) BeginTwoFactorSetup(ctx context.Context, cmd *BeginTwoFactorSetupCommand) (*TwoFactorSetup, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	return s.beginTwoFactorSetup(ctx, user)
+}
+
+type BeginPendingTwoFactorSetupCommand struct {
+	PendingSessionID string `json:"-"`
+}
+
+func (c *BeginPendingTwoFactorSetupCommand) Validate(v *validation.Validator) {
+	v.Field(c.PendingSessionID, "pending_session_id").Required()
+}
+
+func (s *AuthService) BeginPendingTwoFactorSetup(ctx context.Context, cmd *BeginPendingTwoFactorSetupCommand) (*TwoFactorSetup, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+
+	userID, ok := s.PendingSessions.Get(cmd.PendingSessionID)
+	if !ok {
+		return nil, errors.ErrInvalidToken
+	}
+	user, ok := s.Users.GetByID(ctx, userID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	return s.beginTwoFactorSetup(ctx, user)
+}
+
+func (s *AuthService) beginTwoFactorSetup(ctx context.Context, user *domain.User) (*TwoFactorSetup, error) {
+	if user.TOTPEnabled {
+		return nil, errors.ErrConflict
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	user.TOTPSecret = secret
+	user.TOTPLastStep = 0
+	if err := s.Users.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	uri := totp.ProvisioningURI(totpIssuer, user.Email, secret)
+	qrCode, err := qr.SVG(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TwoFactorSetup{
+		Secret:          secret,
+		ProvisioningURI: uri,
+		QRCode:          qrCode,
+	}, nil
+}
+
+type ConfirmTwoFactorCommand struct {
+	UserID int64  `json:"-"`
+	Code   string `json:"code"`
+}
+
+func (c *ConfirmTwoFactorCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.Code, "code").Required().IsTrimmed().MaxLength(10)
+}
+
+func (s *AuthService) ConfirmTwoFactor(ctx context.Context, cmd *ConfirmTwoFactorCommand) ([]string, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	if user.TOTPEnabled {
+		return nil, errors.ErrConflict
+	}
+
+	return s.enableTwoFactor(ctx, user, cmd.Code)
+}
+
+func (s *AuthService) enableTwoFactor(ctx context.Context, user *domain.User, code string) ([]string, error) {
+	if user.TOTPSecret == "" {
+		return nil, errors.ErrInvalidStatusTransition
+	}
+
+	step, ok := totp.Validate(user.TOTPSecret, code, time.Now())
+	if !ok {
+		return nil, errors.ErrInvalidTwoFactorCode
+	}
+
+	user.TOTPEnabled = true
+	user.TOTPLastStep = step
+	if err := s.Users.Update(ctx, user); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := s.replaceRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	event := domain.NewTwoFactorEnabledEvent(user.ID)
+	_ = s.Events.Publish(ctx, event)
+
+	return recoveryCodes, nil
+}
+
+type VerifyTwoFactorCommand struct {
+	PendingSessionID string `json:"-"`
+	Code             string `json:"code"`
+	RecoveryCode     string `json:"recovery_code"`
+}
+
+func (c *VerifyTwoFactorCommand) Validate(v *validation.Validator) {
+	v.Field(c.PendingSessionID, "pending_session_id").Required()
+	v.Field(c.Code, "code").IsTrimmed().MaxLength(10)
+	v.Field(c.RecoveryCode, "recovery_code").IsTrimmed().MaxLength(32)
+}
+
+type TwoFactorLoginResult struct {
+	SessionID     string   `json:"-"`
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+}
+
+func (s *AuthService) VerifyTwoFactor(ctx context.Context, cmd *VerifyTwoFactorCommand) (*TwoFactorLoginResult, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+	if (cmd.Code == "") == (cmd.RecoveryCode == "") {
+		return nil, errors.ErrInvalidInput
+	}
+
+	userID, ok := s.PendingSessions.Get(cmd.PendingSessionID)
+	if !ok {
+		return nil, errors.ErrInvalidToken
+	}
+	user, ok := s.Users.GetByID(ctx, userID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+
+	now := time.Now()
+	if err := s.checkThrottle(ctx, loginEmailThrottle, user.Email, now); err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := s.verifySecondFactor(ctx, user, cmd)
+	if err == errors.ErrInvalidTwoFactorCode {
+		if err := s.recordLoginFailure(ctx, user.Email, "", user, now); err != nil {
+			return nil, err
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := s.resetLoginThrottle(ctx, user.Email); err != nil {
+		return nil, err
+	}
+
+	_ = s.PendingSessions.Delete(cmd.PendingSessionID)
+
+	sessionID, err := s.Sessions.Create(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TwoFactorLoginResult{
+		SessionID:     sessionID,
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (s *AuthService) verifySecondFactor(ctx context.Context, user *domain.User, cmd *VerifyTwoFactorCommand) ([]string, error) {
+	switch {
+	case !user.TOTPEnabled:
+		if cmd.Code == "" {
+			return nil, errors.ErrInvalidInput
+		}
+		return s.enableTwoFactor(ctx, user, cmd.Code)
+
+	case cmd.Code != "":
+		return nil, s.checkTwoFactorCode(ctx, user, cmd.Code)
+
+	default:
+		codeHash := auth.HashToken(normalizeRecoveryCode(cmd.RecoveryCode))
+		if !s.RecoveryCodes.ConsumeRecoveryCode(ctx, user.ID, codeHash[:], time.Now()) {
+			return nil, errors.ErrInvalidTwoFactorCode
+		}
+
+		remaining, err := s.RecoveryCodes.CountRecoveryCodes(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		event := domain.NewRecoveryCodeUsedEvent(user.ID, remaining)
+		_ = s.Events.Publish(ctx, event)
+		return nil, nil
+	}
+}
+
+type RegenerateRecoveryCodesCommand struct {
+	UserID int64  `json:"-"`
+	Code   string `json:"code"`
+}
+
+func (c *RegenerateRecoveryCodesCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.Code, "code").Required().IsTrimmed().MaxLength(10)
+}
+
+func (s *AuthService) RegenerateRecoveryCodes(ctx context.Context, cmd *RegenerateRecoveryCodesCommand) ([]string, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	if !user.TOTPEnabled {
+		return nil, errors.ErrInvalidStatusTransition
+	}
+	if err := s.checkTwoFactorCode(ctx, user, cmd.Code); err != nil {
+		return nil, err
+	}
+
+	return s.replaceRecoveryCodes(ctx, user.ID)
+}
+
+type DisableTwoFactorCommand struct {
+	UserID int64  `json:"-"`
+	Code   string `json:"code"`
+}
+
+func (c *DisableTwoFactorCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.Code, "code").Required().IsTrimmed().MaxLength(10)
+}
+
+func (s *AuthService) DisableTwoFactor(ctx context.Context, cmd *DisableTwoFactorCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !user.TOTPEnabled {
+		return errors.ErrInvalidStatusTransition
+	}
+	if s.requiresTwoFactor(ctx, user) {
+		return errors.ErrForbidden
+	}
+	if err := s.checkTwoFactorCode(ctx, user, cmd.Code); err != nil {
+		return err
+	}
+
+	user.TOTPEnabled = false
+	user.TOTPSecret = ""
+	user.TOTPLastStep = 0
+	if err := s.Users.Update(ctx, user); err != nil {
+		return err
+	}
+	if err := s.RecoveryCodes.DeleteRecoveryCodes(ctx, user.ID); err != nil {
+		return err
+	}
+
+	event := domain.NewTwoFactorDisabledEvent(user.ID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+type GetRolePolicyQuery struct {
+	Role domain.SystemRole `json:"role"`
+}
+
+func (s *AuthService) GetRolePolicy(ctx context.Context, query *GetRolePolicyQuery) (*domain.RolePolicy, error) {
+	if !domain.IsValidSystemRole(query.Role) {
+		return nil, errors.ErrNotFound
+	}
+
+	policy, ok := s.RolePolicies.GetRolePolicy(ctx, query.Role)
+	if !ok {
+		return &domain.RolePolicy{Role: query.Role}, nil
+	}
+
+	return policy, nil
+}
+
+type UpdateRolePolicyCommand struct {
+	Role             domain.SystemRole `json:"-"`
+	RequireTwoFactor bool              `json:"require_two_factor"`
+	UserID           int64             `json:"-"`
+}
+
+func (c *UpdateRolePolicyCommand) Validate(v *validation.Validator) {
+	v.Field(string(c.Role), "role").Required()
+	v.Field(c.UserID, "user_id").Required().EntityID()
+}
+
+func (s *AuthService) UpdateRolePolicy(ctx context.Context, cmd *UpdateRolePolicyCommand) (*domain.RolePolicy, error) {
+	if err := validation.Validate(cmd); err != nil {
+		return nil, err
+	}
+	if !domain.IsValidSystemRole(cmd.Role) {
+		return nil, errors.ErrNotFound
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return nil, errors.ErrNotFound
+	}
+	if !user.IsAdmin() {
+		return nil, errors.ErrForbidden
+	}
+	// Requiring 2FA for your own role without having it would lock you out.
+	if cmd.RequireTwoFactor && user.Role == cmd.Role && !user.TOTPEnabled {
+		return nil, errors.ErrInvalidStatusTransition
+	}
+
+	policy := domain.RolePolicy{
+		Role:             cmd.Role,
+		RequireTwoFactor: cmd.RequireTwoFactor,
+	}
+	if err := s.RolePolicies.SaveRolePolicy(ctx, &policy); err != nil {
+		return nil, err
+	}
+
+	event := domain.NewRolePolicyUpdatedEvent(policy.Role, policy.RequireTwoFactor, user.ID)
+	_ = s.Events.Publish(ctx, event)
+
+	return &policy, nil
+}
+
+func (s *AuthService) requiresTwoFactor(ctx context.Context, user *domain.User) bool {
+	policy, ok := s.RolePolicies.GetRolePolicy(ctx, user.Role)
+	return ok && policy.RequireTwoFactor
+}
+
+func (s *AuthService) checkTwoFactorCode(ctx context.Context, user *domain.User, code string) error {
+	step, ok := totp.Validate(user.TOTPSecret, code, time.Now())
+	if !ok || step <= user.TOTPLastStep {
+		return errors.ErrInvalidTwoFactorCode
+	}
+
+	user.TOTPLastStep = step
+	return s.Users.Update(ctx, user)
+}
+
+func (s *AuthService) replaceRecoveryCodes(ctx context.Context, userID int64) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([][]byte, recoveryCodeCount)
+	for i := range codes {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		hash := auth.HashToken(normalizeRecoveryCode(code))
+		codes[i] = code
+		hashes[i] = hash[:]
+	}
+
+	if err := s.RecoveryCodes.ReplaceRecoveryCodes(ctx, userID, hashes); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+func generateRecoveryCode() (string, error) {
+	b := make([]byte, recoveryCodeLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	var sb strings.Builder
+	for i, c := range b {
+		if i == recoveryCodeLength/2 {
+			sb.WriteByte('-')
+		}
+		sb.WriteByte(recoveryCodeChars[int(c)%len(recoveryCodeChars)])
+	}
+
+	return sb.String(), nil
+}
+
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
@@ -0,0 +1,288 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"bytecourses/internal/domain"
+	"bytecourses/internal/infrastructure/auth"
+	"bytecourses/internal/pkg/errors"
+	"bytecourses/internal/pkg/validation"
+)
+
+const (
+	defaultUserPageSize = 25
+	maxUserPageSize     = 100
+
+	// forcedResetTokenTTL is longer than a self-service reset because the
+	// user did not ask for the email and may not see it straight away.
+	forcedResetTokenTTL = 24 * time.Hour
+)
+
+var (
+	_ Command = (*ChangeUserRoleCommand)(nil)
+	_ Command = (*SuspendUserCommand)(nil)
+	_ Command = (*ReactivateUserCommand)(nil)
+	_ Command = (*ForcePasswordResetCommand)(nil)
+)
+
+var (
+	_ Query = (*ListUsersQuery)(nil)
+)
+
+type ListUsersQuery struct {
+	Search string            `json:"search"`
+	Role   domain.SystemRole `json:"role"`
+	Cursor string            `json:"cursor"`
+	Limit  int               `json:"limit"`
+}
+
+func (q *ListUsersQuery) Validate(v *validation.Validator) {
+	v.Field(q.Search, "search").MaxLength(100)
+	v.Field(q.Cursor, "cursor").MaxLength(512)
+	v.Field(q.Limit, "limit").Min(0).Max(maxUserPageSize)
+}
+
+type UserPage struct {
+	Users      []domain.User `json:"users"`
+	NextCursor string        `json:"next_cursor"`
+}
+
+func (s *AuthService) ListUsers(ctx context.Context, query *ListUsersQuery) (*UserPage, error) {
+	if err := validation.Validate(query); err != nil {
+		return nil, err
+	}
+	if query.Role != "" && !domain.IsValidSystemRole(query.Role) {
+		return nil, errors.ErrInvalidInput
+	}
+
+	filter := domain.UserFilter{
+		Search: query.Search,
+		Role:   query.Role,
+		Limit:  query.Limit,
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultUserPageSize
+	}
+	if query.Cursor != "" {
+		cursor, err := decodeUserCursor(query.Cursor)
+		if err != nil {
+			return nil, errors.ErrInvalidInput
+		}
+		filter.After = cursor
+	}
+
+	users, next, err := s.Users.List(ctx, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page := UserPage{Users: users}
+	if next != nil {
+		page.NextCursor = encodeUserCursor(next)
+	}
+	return &page, nil
+}
+
+func encodeUserCursor(cursor *domain.UserCursor) string {
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeUserCursor(s string) (*domain.UserCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	var cursor domain.UserCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
+
+type ChangeUserRoleCommand struct {
+	UserID  int64             `json:"-"`
+	Role    domain.SystemRole `json:"role"`
+	AdminID int64             `json:"-"`
+}
+
+func (c *ChangeUserRoleCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.AdminID, "admin_id").Required().EntityID()
+}
+
+func (s *AuthService) ChangeUserRole(ctx context.Context, cmd *ChangeUserRoleCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+	if !domain.IsValidSystemRole(cmd.Role) {
+		errs := errors.NewValidationErrors()
+		errs.Add("role", "must be user, reviewer or admin")
+		return errs
+	}
+	// Admins cannot demote themselves, so there is always one left.
+	if cmd.UserID == cmd.AdminID {
+		return errors.ErrForbidden
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if user.Role == cmd.Role {
+		return nil
+	}
+
+	oldRole := user.Role
+	user.Role = cmd.Role
+	if err := s.Users.Update(ctx, user); err != nil {
+		return err
+	}
+
+	event := domain.NewUserRoleChangedEvent(user.ID, oldRole, user.Role, cmd.AdminID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+type SuspendUserCommand struct {
+	UserID  int64 `json:"-"`
+	AdminID int64 `json:"-"`
+}
+
+func (c *SuspendUserCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.AdminID, "admin_id").Required().EntityID()
+}
+
+// SuspendUser blocks the account from logging in and ends its sessions.
+// Requests made with the user's API tokens are refused while suspended.
+func (s *AuthService) SuspendUser(ctx context.Context, cmd *SuspendUserCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+	if cmd.UserID == cmd.AdminID {
+		return errors.ErrForbidden
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if user.IsSuspended() {
+		return errors.ErrConflict
+	}
+
+	now := time.Now()
+	user.SuspendedAt = &now
+	if err := s.Users.Update(ctx, user); err != nil {
+		return err
+	}
+
+	if err := s.Sessions.DeleteByUserID(user.ID); err != nil {
+		return err
+	}
+	if err := s.PendingSessions.DeleteByUserID(user.ID); err != nil {
+		return err
+	}
+
+	event := domain.NewUserSuspendedEvent(user.ID, cmd.AdminID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+type ReactivateUserCommand struct {
+	UserID  int64 `json:"-"`
+	AdminID int64 `json:"-"`
+}
+
+func (c *ReactivateUserCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.AdminID, "admin_id").Required().EntityID()
+}
+
+func (s *AuthService) ReactivateUser(ctx context.Context, cmd *ReactivateUserCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+	if !user.IsSuspended() {
+		return errors.ErrConflict
+	}
+
+	user.SuspendedAt = nil
+	if err := s.Users.Update(ctx, user); err != nil {
+		return err
+	}
+
+	event := domain.NewUserReactivatedEvent(user.ID, cmd.AdminID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
+
+type ForcePasswordResetCommand struct {
+	UserID  int64  `json:"-"`
+	AdminID int64  `json:"-"`
+	BaseURL string `json:"-"`
+}
+
+func (c *ForcePasswordResetCommand) Validate(v *validation.Validator) {
+	v.Field(c.UserID, "user_id").Required().EntityID()
+	v.Field(c.AdminID, "admin_id").Required().EntityID()
+}
+
+// ForcePasswordReset discards the user's password, logs them out everywhere
+// and emails them a reset link. They cannot log in with a password again
+// until they follow it.
+func (s *AuthService) ForcePasswordReset(ctx context.Context, cmd *ForcePasswordResetCommand) error {
+	if err := validation.Validate(cmd); err != nil {
+		return err
+	}
+
+	user, ok := s.Users.GetByID(ctx, cmd.UserID)
+	if !ok {
+		return errors.ErrNotFound
+	}
+
+	passwordHash, err := unusablePasswordHash()
+	if err != nil {
+		return err
+	}
+	user.PasswordHash = passwordHash
+	if err := s.Users.Update(ctx, user); err != nil {
+		return err
+	}
+
+	if err := s.Sessions.DeleteByUserID(user.ID); err != nil {
+		return err
+	}
+	if err := s.PendingSessions.DeleteByUserID(user.ID); err != nil {
+		return err
+	}
+
+	token, err := auth.GenerateToken()
+	if err != nil {
+		return err
+	}
+	tokenHash := auth.HashToken(token)
+	if err := s.Resets.CreateResetToken(ctx, user.ID, tokenHash[:], time.Now().Add(forcedResetTokenTTL)); err != nil {
+		return err
+	}
+
+	resetURL := cmd.BaseURL + "/reset-password"
+	_ = s.Events.Publish(ctx, domain.NewPasswordResetRequestedEvent(user.ID, user.Email, resetURL, token))
+
+	event := domain.NewUserPasswordResetForcedEvent(user.ID, cmd.AdminID)
+	_ = s.Events.Publish(ctx, event)
+
+	return nil
+}
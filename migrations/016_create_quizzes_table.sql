@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_enum WHERE enumlabel = 'quiz' AND enumtypid = (SELECT oid FROM pg_type WHERE typname = 'content_type')) THEN
+        ALTER TYPE content_type ADD VALUE 'quiz';
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+CREATE TABLE IF NOT EXISTS quizzes (
+    content_item_id BIGINT PRIMARY KEY REFERENCES content(id) ON DELETE CASCADE,
+    questions       JSONB NOT NULL DEFAULT '[]'::jsonb,
+    max_attempts    INT NOT NULL DEFAULT 0,
+    passing_score   INT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS quiz_attempts (
+    id           BIGSERIAL PRIMARY KEY,
+    quiz_id      BIGINT NOT NULL REFERENCES quizzes(content_item_id) ON DELETE CASCADE,
+    user_id      BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    answers      JSONB NOT NULL DEFAULT '[]'::jsonb,
+    score        INT NOT NULL,
+    max_score    INT NOT NULL,
+    passed       BOOLEAN NOT NULL DEFAULT false,
+    submitted_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS quiz_attempts_quiz_user_idx ON quiz_attempts(quiz_id, user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS quiz_attempts_quiz_user_idx;
+DROP TABLE IF EXISTS quiz_attempts;
+DROP TABLE IF EXISTS quizzes;
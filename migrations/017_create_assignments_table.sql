@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_enum WHERE enumlabel = 'assignment' AND enumtypid = (SELECT oid FROM pg_type WHERE typname = 'content_type')) THEN
+        ALTER TYPE content_type ADD VALUE 'assignment';
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'submission_status') THEN
+        CREATE TYPE submission_status AS ENUM (
+            'submitted',
+            'graded'
+        );
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+CREATE TABLE IF NOT EXISTS assignments (
+    content_item_id BIGINT PRIMARY KEY REFERENCES content(id) ON DELETE CASCADE,
+    instructions    TEXT NOT NULL DEFAULT '',
+    due_at          TIMESTAMPTZ,
+    max_points      INT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS submissions (
+    id            BIGSERIAL PRIMARY KEY,
+    assignment_id BIGINT NOT NULL REFERENCES assignments(content_item_id) ON DELETE CASCADE,
+    user_id       BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    text          TEXT NOT NULL DEFAULT '',
+    file_name     TEXT NOT NULL DEFAULT '',
+    file_size     BIGINT NOT NULL DEFAULT 0,
+    mime_type     TEXT NOT NULL DEFAULT '',
+    storage_path  TEXT NOT NULL DEFAULT '',
+    late          BOOLEAN NOT NULL DEFAULT false,
+    status        submission_status NOT NULL DEFAULT 'submitted',
+    grade         INT,
+    feedback      TEXT NOT NULL DEFAULT '',
+    graded_by     BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    graded_at     TIMESTAMPTZ,
+    submitted_at  TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at    TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS submissions_assignment_id_idx ON submissions(assignment_id);
+CREATE INDEX IF NOT EXISTS submissions_user_id_idx ON submissions(user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS submissions_user_id_idx;
+DROP INDEX IF EXISTS submissions_assignment_id_idx;
+DROP TABLE IF EXISTS submissions;
+DROP TABLE IF EXISTS assignments;
+DROP TYPE IF EXISTS submission_status;
@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS content_completions (
+    user_id      BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    course_id    BIGINT NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    module_id    BIGINT NOT NULL REFERENCES modules(id) ON DELETE CASCADE,
+    content_id   BIGINT NOT NULL REFERENCES content(id) ON DELETE CASCADE,
+    content_type content_type NOT NULL,
+    completed_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, content_type, content_id)
+);
+
+CREATE INDEX IF NOT EXISTS content_completions_user_course_idx ON content_completions(user_id, course_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS content_completions_user_course_idx;
+DROP TABLE IF EXISTS content_completions;
@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS certificates (
+    id              BIGSERIAL PRIMARY KEY,
+    code            TEXT NOT NULL UNIQUE,
+    user_id         BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    course_id       BIGINT NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    learner_name    TEXT NOT NULL,
+    course_title    TEXT NOT NULL,
+    instructor_name TEXT NOT NULL,
+    issued_at       TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (user_id, course_id)
+);
+
+CREATE INDEX IF NOT EXISTS certificates_user_id_idx ON certificates(user_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS certificates_user_id_idx;
+DROP TABLE IF EXISTS certificates;
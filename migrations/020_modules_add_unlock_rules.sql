@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'module_unlock_rule') THEN
+        CREATE TYPE module_unlock_rule AS ENUM (
+            'immediately',
+            'after_days',
+            'on_date',
+            'after_previous'
+        );
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+ALTER TABLE modules
+    ADD COLUMN IF NOT EXISTS unlock_rule module_unlock_rule NOT NULL DEFAULT 'immediately',
+    ADD COLUMN IF NOT EXISTS unlock_days INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS unlock_at   TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE modules
+    DROP COLUMN IF EXISTS unlock_at,
+    DROP COLUMN IF EXISTS unlock_days,
+    DROP COLUMN IF EXISTS unlock_rule;
+DROP TYPE IF EXISTS module_unlock_rule;
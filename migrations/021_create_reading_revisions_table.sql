@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS reading_revisions (
+    id            BIGSERIAL PRIMARY KEY,
+    reading_id    BIGINT NOT NULL REFERENCES readings(content_item_id) ON DELETE CASCADE,
+    number        INTEGER NOT NULL,
+    author_id     BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    format        reading_format NOT NULL,
+    content       TEXT NOT NULL DEFAULT '',
+    restored_from INTEGER,
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (reading_id, number)
+);
+
+INSERT INTO reading_revisions (reading_id, number, author_id, format, content, created_at)
+SELECT r.content_item_id, 1, c.instructor_id, r.format, COALESCE(r.content, ''), ci.updated_at
+FROM readings r
+INNER JOIN content ci ON ci.id = r.content_item_id
+INNER JOIN modules m ON m.id = ci.module_id
+INNER JOIN courses c ON c.id = m.course_id
+ON CONFLICT (reading_id, number) DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS reading_revisions;
@@ -0,0 +1,34 @@
+-- +goose Up
+ALTER TABLE courses ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(summary, '')), 'B') ||
+    setweight(to_tsvector('english', coalesce(learning_objectives, '')), 'C')
+) STORED;
+
+ALTER TABLE modules ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(description, '')), 'B')
+) STORED;
+
+ALTER TABLE content ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(title, '')), 'A')
+) STORED;
+
+ALTER TABLE readings ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('english', coalesce(content, '')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS courses_search_vector_idx ON courses USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS modules_search_vector_idx ON modules USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS content_search_vector_idx ON content USING GIN (search_vector);
+CREATE INDEX IF NOT EXISTS readings_search_vector_idx ON readings USING GIN (search_vector);
+
+-- +goose Down
+DROP INDEX IF EXISTS readings_search_vector_idx;
+DROP INDEX IF EXISTS content_search_vector_idx;
+DROP INDEX IF EXISTS modules_search_vector_idx;
+DROP INDEX IF EXISTS courses_search_vector_idx;
+ALTER TABLE readings DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE content DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE modules DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE courses DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_enum WHERE enumlabel = 'reviewer' AND enumtypid = (SELECT oid FROM pg_type WHERE typname = 'system_role')) THEN
+        ALTER TYPE system_role ADD VALUE 'reviewer';
+    END IF;
+
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'course_role') THEN
+        CREATE TYPE course_role AS ENUM (
+            'owner',
+            'co_instructor',
+            'ta'
+        );
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+CREATE TABLE IF NOT EXISTS course_members (
+    course_id  BIGINT NOT NULL REFERENCES courses(id) ON DELETE CASCADE,
+    user_id    BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role       course_role NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (course_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS course_members_user_id_idx ON course_members(user_id);
+
+INSERT INTO course_members (course_id, user_id, role, created_at)
+SELECT id, instructor_id, 'owner', created_at
+FROM courses
+ON CONFLICT (course_id, user_id) DO NOTHING;
+
+-- +goose Down
+DROP INDEX IF EXISTS course_members_user_id_idx;
+DROP TABLE IF EXISTS course_members;
+DROP TYPE IF EXISTS course_role;
+UPDATE users SET role = 'user' WHERE role::text = 'reviewer';
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'proposal_comment_kind') THEN
+        CREATE TYPE proposal_comment_kind AS ENUM (
+            'review',
+            'reply'
+        );
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+CREATE TABLE IF NOT EXISTS proposal_comments (
+    id          BIGSERIAL PRIMARY KEY,
+    proposal_id BIGINT NOT NULL REFERENCES proposals(id) ON DELETE CASCADE,
+    author_id   BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    kind        proposal_comment_kind NOT NULL,
+    body        TEXT NOT NULL DEFAULT '',
+    status      proposal_status NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS proposal_comments_proposal_id_idx ON proposal_comments(proposal_id, created_at);
+
+INSERT INTO proposal_comments (proposal_id, author_id, kind, body, status, created_at)
+SELECT id, reviewer_id, 'review', review_notes, status, updated_at
+FROM proposals
+WHERE reviewer_id IS NOT NULL
+  AND status IN ('approved', 'rejected', 'changes_requested')
+  AND NOT EXISTS (SELECT 1 FROM proposal_comments pc WHERE pc.proposal_id = proposals.id);
+
+-- +goose Down
+DROP INDEX IF EXISTS proposal_comments_proposal_id_idx;
+DROP TABLE IF EXISTS proposal_comments;
+DROP TYPE IF EXISTS proposal_comment_kind;
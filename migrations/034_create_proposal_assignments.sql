@@ -0,0 +1,38 @@
+-- +goose Up
+-- +goose StatementBegin
+DO $$
+BEGIN
+    IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'proposal_vote') THEN
+        CREATE TYPE proposal_vote AS ENUM (
+            'approve',
+            'reject',
+            'request_changes'
+        );
+    END IF;
+END $$;
+-- +goose StatementEnd
+
+CREATE TABLE IF NOT EXISTS proposal_assignments (
+    proposal_id   BIGINT NOT NULL REFERENCES proposals(id) ON DELETE CASCADE,
+    reviewer_id   BIGINT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    assigned_by   BIGINT REFERENCES users(id) ON DELETE SET NULL,
+    vote          proposal_vote,
+    notes         TEXT NOT NULL DEFAULT '',
+    assigned_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    voted_at      TIMESTAMPTZ,
+    pending_since TIMESTAMPTZ NOT NULL DEFAULT now(),
+    reminded_at   TIMESTAMPTZ,
+    PRIMARY KEY (proposal_id, reviewer_id)
+);
+
+CREATE INDEX IF NOT EXISTS proposal_assignments_reviewer_id_idx ON proposal_assignments(reviewer_id);
+CREATE INDEX IF NOT EXISTS proposal_assignments_pending_idx ON proposal_assignments(pending_since) WHERE vote IS NULL;
+
+ALTER TABLE proposal_comments ADD COLUMN IF NOT EXISTS vote proposal_vote;
+
+-- +goose Down
+ALTER TABLE proposal_comments DROP COLUMN IF EXISTS vote;
+DROP INDEX IF EXISTS proposal_assignments_pending_idx;
+DROP INDEX IF EXISTS proposal_assignments_reviewer_id_idx;
+DROP TABLE IF EXISTS proposal_assignments;
+DROP TYPE IF EXISTS proposal_vote;
@@ -0,0 +1,28 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS proposal_versions (
+    id                    BIGSERIAL PRIMARY KEY,
+    proposal_id           BIGINT NOT NULL REFERENCES proposals(id) ON DELETE CASCADE,
+    number                INTEGER NOT NULL,
+    title                 TEXT NOT NULL DEFAULT '',
+    summary               TEXT NOT NULL DEFAULT '',
+    qualifications        TEXT NOT NULL DEFAULT '',
+    target_audience       TEXT NOT NULL DEFAULT '',
+    learning_objectives   TEXT NOT NULL DEFAULT '',
+    outline               TEXT NOT NULL DEFAULT '',
+    assumed_prerequisites TEXT NOT NULL DEFAULT '',
+    submitted_at          TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (proposal_id, number)
+);
+
+INSERT INTO proposal_versions (
+    proposal_id, number, title, summary, qualifications, target_audience,
+    learning_objectives, outline, assumed_prerequisites, submitted_at
+)
+SELECT id, 1, title, summary, qualifications, target_audience,
+       learning_objectives, outline, assumed_prerequisites, updated_at
+FROM proposals
+WHERE status IN ('submitted', 'approved', 'rejected', 'changes_requested')
+ON CONFLICT (proposal_id, number) DO NOTHING;
+
+-- +goose Down
+DROP TABLE IF EXISTS proposal_versions;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE proposals
+    ADD COLUMN IF NOT EXISTS outline_modules JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+ALTER TABLE proposal_versions
+    ADD COLUMN IF NOT EXISTS outline_modules JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+-- +goose Down
+ALTER TABLE proposal_versions
+    DROP COLUMN IF EXISTS outline_modules;
+
+ALTER TABLE proposals
+    DROP COLUMN IF EXISTS outline_modules;
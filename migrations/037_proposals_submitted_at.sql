@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE proposals
+    ADD COLUMN IF NOT EXISTS submitted_at TIMESTAMPTZ;
+
+UPDATE proposals p
+SET submitted_at = COALESCE(
+    (SELECT MAX(v.submitted_at) FROM proposal_versions v WHERE v.proposal_id = p.id),
+    p.updated_at
+)
+WHERE p.status IN ('submitted', 'approved', 'rejected', 'changes_requested')
+  AND p.submitted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS proposals_queued_at_idx ON proposals(COALESCE(submitted_at, created_at), id);
+
+-- +goose Down
+DROP INDEX IF EXISTS proposals_queued_at_idx;
+
+ALTER TABLE proposals
+    DROP COLUMN IF EXISTS submitted_at;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS proposal_attachments (
+    id           BIGSERIAL PRIMARY KEY,
+    proposal_id  BIGINT NOT NULL REFERENCES proposals(id) ON DELETE CASCADE,
+    file_name    TEXT NOT NULL,
+    file_size    BIGINT NOT NULL DEFAULT 0,
+    mime_type    TEXT NOT NULL DEFAULT '',
+    storage_path TEXT NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS proposal_attachments_proposal_id_idx ON proposal_attachments(proposal_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS proposal_attachments;
@@ -0,0 +1,25 @@
+-- +goose Up
+ALTER TABLE quiz_attempts
+    ADD COLUMN IF NOT EXISTS number INT;
+
+UPDATE quiz_attempts a
+SET number = n.number
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY quiz_id, user_id ORDER BY id) AS number
+    FROM quiz_attempts
+) n
+WHERE a.id = n.id
+  AND a.number IS NULL;
+
+ALTER TABLE quiz_attempts
+    ALTER COLUMN number SET NOT NULL;
+
+DROP INDEX IF EXISTS quiz_attempts_quiz_user_idx;
+CREATE UNIQUE INDEX IF NOT EXISTS quiz_attempts_quiz_user_number_idx ON quiz_attempts(quiz_id, user_id, number);
+
+-- +goose Down
+DROP INDEX IF EXISTS quiz_attempts_quiz_user_number_idx;
+CREATE INDEX IF NOT EXISTS quiz_attempts_quiz_user_idx ON quiz_attempts(quiz_id, user_id);
+
+ALTER TABLE quiz_attempts
+    DROP COLUMN IF EXISTS number;
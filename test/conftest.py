@@ -1,3 +1,4 @@
+import json
 import os
 import signal
 import socket
@@ -13,6 +14,10 @@ USER_PASSWORD = "user"
 ADMIN_EMAIL = "admin@bytecourses.org"
 ADMIN_PASSWORD = "admin"
 
+# Server logs by base URL. The server runs with --email-service=log, so these
+# hold every email it sends, including verification and reset tokens.
+SERVER_LOGS = {}
+
 
 def get_free_port():
     with socket.socket(socket.AF_INET, socket.SOCK_STREAM) as s:
@@ -20,12 +25,30 @@ def get_free_port():
         return s.getsockname()[1]
 
 
+def read_email_token(base_url, kind, email):
+    email = email.strip().lower()
+    token = None
+    with open(SERVER_LOGS[base_url]) as f:
+        for line in f:
+            try:
+                entry = json.loads(line)
+            except json.JSONDecodeError:
+                continue
+            if entry.get("msg") == "email" and entry.get("kind") == kind and entry.get("to") == email:
+                token = entry.get("token")
+    assert token, f"No {kind} email sent to {email}"
+    return token
+
+
 @pytest.fixture(scope="function")
-def go_server():
+def go_server(tmp_path):
     port = get_free_port()
     base_url = f"http://127.0.0.1:{port}"
     env = os.environ.copy()
     env["PORT"] = f"{port}"
+    log_path = tmp_path / "server.log"
+    log_file = open(log_path, "w")
+    SERVER_LOGS[base_url] = log_path
 
     proc = subprocess.Popen(
         [
@@ -33,13 +56,13 @@ def go_server():
             "run",
             "cmd/server/main.go",
             "--bcrypt-cost=5",
-            "--email-service=none",
+            "--email-service=log",
             "--storage=memory",
             "--seed-users=./test/fixtures/users.json",
             "--seed-proposals=./test/fixtures/proposals.json",
         ],
         env=env,
-        stdout=subprocess.DEVNULL,
+        stdout=log_file,
         stderr=subprocess.DEVNULL,
         start_new_session=True,
     )
@@ -53,12 +76,15 @@ def go_server():
             time.sleep(0.2)
     else:
         os.killpg(proc.pid, signal.SIGTERM)
+        log_file.close()
         raise RuntimeError("Go server did not start")
 
     yield base_url
 
     os.killpg(proc.pid, signal.SIGTERM)
     proc.wait()
+    log_file.close()
+    del SERVER_LOGS[base_url]
 
 
 @pytest.fixture(scope="function")
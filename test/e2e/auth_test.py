@@ -1,14 +1,14 @@
 import requests
 from http import HTTPStatus
 
-from .conftest import register_and_login
+from .conftest import read_email_token, register_and_login, verify_email
 
 
 class TestRegister:
     def test_creates_user_with_valid_credentials(self, api_url):
         payload = {
             "email": "newuser@example.com",
-            "password": "password123",
+            "password": "lilac-harbor-97",
             "name": "New User",
         }
         r = requests.post(f"{api_url}/register", json=payload)
@@ -19,7 +19,7 @@ class TestRegister:
         session = register_and_login(
             api_url,
             email="user@example.com",
-            password="secret",
+            password="lilac-harbor-97",
             name="Test User",
         )
         r = session.get(f"{api_url}/me")
@@ -30,7 +30,7 @@ class TestRegister:
     def test_rejects_duplicate_email(self, api_url):
         payload = {
             "email": "duplicate@example.com",
-            "password": "password123",
+            "password": "lilac-harbor-97",
             "name": "Name",
         }
         r = requests.post(f"{api_url}/register", json=payload)
@@ -40,12 +40,12 @@ class TestRegister:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_rejects_missing_email(self, api_url):
-        payload = {"password": "password1234", "name": "Name"}
+        payload = {"password": "lilac-harbor-97", "name": "Name"}
         r = requests.post(f"{api_url}/register", json=payload)
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
     def test_rejects_empty_email(self, api_url):
-        payload = {"email": "", "password": "password1234", "name": "Name"}
+        payload = {"email": "", "password": "lilac-harbor-97", "name": "Name"}
         r = requests.post(f"{api_url}/register", json=payload)
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
@@ -59,6 +59,39 @@ class TestRegister:
         r = requests.post(f"{api_url}/register", json=payload)
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
+    def test_rejects_common_password(self, api_url):
+        payload = {
+            "email": "common@example.com",
+            "password": "password123",
+            "name": "Name",
+        }
+        r = requests.post(f"{api_url}/register", json=payload)
+        assert r.status_code == HTTPStatus.BAD_REQUEST
+        messages = [e["Message"] for e in r.json()["errors"]]
+        assert any("too common" in m for m in messages)
+
+    def test_rejects_short_password(self, api_url):
+        payload = {
+            "email": "short@example.com",
+            "password": "k7#vq",
+            "name": "Name",
+        }
+        r = requests.post(f"{api_url}/register", json=payload)
+        assert r.status_code == HTTPStatus.BAD_REQUEST
+        messages = [e["Message"] for e in r.json()["errors"]]
+        assert any("at least 10 characters" in m for m in messages)
+
+    def test_rejects_password_containing_email(self, api_url):
+        payload = {
+            "email": "marguerite@example.com",
+            "password": "marguerite-1987",
+            "name": "Name",
+        }
+        r = requests.post(f"{api_url}/register", json=payload)
+        assert r.status_code == HTTPStatus.BAD_REQUEST
+        messages = [e["Message"] for e in r.json()["errors"]]
+        assert any("email" in m for m in messages)
+
     def test_rejects_empty_payload(self, api_url):
         r = requests.post(f"{api_url}/register")
         assert r.status_code == HTTPStatus.BAD_REQUEST
@@ -75,7 +108,7 @@ class TestRegister:
         session = register_and_login(
             api_url,
             email="   user@example.com  ",
-            password="secret",
+            password="lilac-harbor-97",
             name="Test User   \
             ",
         )
@@ -89,7 +122,7 @@ class TestLogin:
     def test_succeeds_with_valid_credentials(self, api_url):
         payload = {
             "email": "logintest@example.com",
-            "password": "password123",
+            "password": "lilac-harbor-97",
             "name": "Name",
         }
         requests.post(f"{api_url}/register", json=payload)
@@ -101,7 +134,7 @@ class TestLogin:
     def test_allows_multiple_logins(self, api_url):
         payload = {
             "email": "multilogin@example.com",
-            "password": "password123",
+            "password": "lilac-harbor-97",
             "name": "Name",
         }
         requests.post(f"{api_url}/register", json=payload)
@@ -114,7 +147,7 @@ class TestLogin:
     def test_rejects_wrong_password(self, api_url):
         payload = {
             "email": "logintest@example.com",
-            "password": "password123",
+            "password": "lilac-harbor-97",
             "name": "Name",
         }
         requests.post(f"{api_url}/register", json=payload)
@@ -129,7 +162,7 @@ class TestLogin:
     def test_rejects_missing_password(self, api_url):
         payload = {
             "email": "logintest@example.com",
-            "password": "password123",
+            "password": "lilac-harbor-97",
             "name": "Name",
         }
         requests.post(f"{api_url}/register", json=payload)
@@ -143,7 +176,7 @@ class TestLogin:
     def test_rejects_empty_password(self, api_url):
         payload = {
             "email": "logintest@example.com",
-            "password": "password123",
+            "password": "lilac-harbor-97",
             "name": "Name",
         }
         requests.post(f"{api_url}/register", json=payload)
@@ -156,13 +189,13 @@ class TestLogin:
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
     def test_rejects_missing_email(self, api_url):
-        r = requests.post(f"{api_url}/login", json={"password": "password123"})
+        r = requests.post(f"{api_url}/login", json={"password": "lilac-harbor-97"})
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
     def test_rejects_empty_email(self, api_url):
         r = requests.post(
             f"{api_url}/login",
-            json={"email": "", "password": "password123"},
+            json={"email": "", "password": "lilac-harbor-97"},
         )
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
@@ -200,7 +233,7 @@ class TestAuthFlow:
             f"{api_url}/register",
             json={
                 "email": "flowtest@example.com",
-                "password": "secret",
+                "password": "lilac-harbor-97",
                 "name": "Name",
             },
         )
@@ -208,7 +241,7 @@ class TestAuthFlow:
 
         r = session.post(
             f"{api_url}/login",
-            json={"email": "flowtest@example.com", "password": "secret"},
+            json={"email": "flowtest@example.com", "password": "lilac-harbor-97"},
         )
         assert r.status_code == HTTPStatus.OK
         assert "session" in session.cookies
@@ -224,6 +257,57 @@ class TestAuthFlow:
         assert r.status_code == HTTPStatus.UNAUTHORIZED
 
 
+class TestEmailVerification:
+    def test_unverified_user_cannot_create_proposal(self, api_url):
+        session = register_and_login(
+            api_url, "unverified@example.com", "lilac-harbor-97", verify=False
+        )
+        r = session.get(f"{api_url}/me")
+        assert r.status_code == HTTPStatus.OK
+        assert "verified_at" not in r.json()
+
+        r = session.post(
+            f"{api_url}/proposals",
+            json={"title": "Course", "summary": "A course."},
+        )
+        assert r.status_code == HTTPStatus.FORBIDDEN
+
+    def test_verified_user_can_create_proposal(self, api_url):
+        session = register_and_login(
+            api_url, "verifyme@example.com", "lilac-harbor-97", verify=False
+        )
+        verify_email(session, api_url, "verifyme@example.com")
+
+        r = session.get(f"{api_url}/me")
+        assert r.status_code == HTTPStatus.OK
+        assert r.json().get("verified_at")
+
+        r = session.post(
+            f"{api_url}/proposals",
+            json={"title": "Course", "summary": "A course."},
+        )
+        assert r.status_code == HTTPStatus.CREATED
+
+    def test_rejects_invalid_token(self, api_url):
+        r = requests.post(
+            f"{api_url}/email-verification/confirm",
+            json={"token": "not-a-real-token"},
+        )
+        assert r.status_code == HTTPStatus.BAD_REQUEST
+
+    def test_rejects_reused_token(self, api_url):
+        session = register_and_login(
+            api_url, "reuse@example.com", "lilac-harbor-97", verify=False
+        )
+        verify_email(session, api_url, "reuse@example.com")
+
+        token = read_email_token(
+            api_url.replace("/api", ""), "verification", "reuse@example.com"
+        )
+        r = session.post(f"{api_url}/email-verification/confirm", json={"token": token})
+        assert r.status_code == HTTPStatus.BAD_REQUEST
+
+
 class TestUserRoles:
     def test_admin_user_has_admin_role(self, api_url, admin_session):
         r = admin_session.get(f"{api_url}/me")
@@ -251,7 +335,7 @@ class TestUpdateProfile:
 
 class TestDeleteMe:
     def test_deletes_user_account(self, api_url):
-        session = register_and_login(api_url, "todelete@example.com", "password123")
+        session = register_and_login(api_url, "todelete@example.com", "lilac-harbor-97")
 
         r = session.get(f"{api_url}/me")
         assert r.status_code == HTTPStatus.OK
@@ -264,7 +348,7 @@ class TestDeleteMe:
         assert r.status_code == HTTPStatus.UNAUTHORIZED
 
     def test_invalidates_session_after_deletion(self, api_url):
-        session = register_and_login(api_url, "todelete2@example.com", "password123")
+        session = register_and_login(api_url, "todelete2@example.com", "lilac-harbor-97")
 
         r = session.delete(f"{api_url}/me")
         assert r.status_code == HTTPStatus.NO_CONTENT
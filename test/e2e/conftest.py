@@ -2,7 +2,13 @@ import pytest
 import requests
 from http import HTTPStatus
 
-from test.conftest import USER_EMAIL, USER_PASSWORD, ADMIN_EMAIL, ADMIN_PASSWORD
+from test.conftest import (
+    USER_EMAIL,
+    USER_PASSWORD,
+    ADMIN_EMAIL,
+    ADMIN_PASSWORD,
+    read_email_token,
+)
 
 _original_init = requests.Session.__init__
 _original_post = requests.Session.post
@@ -114,7 +120,16 @@ def admin_session(api_url):
     return session
 
 
-def register_and_login(api_url: str, email: str, password: str, name: str = "Name"):
+def verify_email(session, api_url: str, email: str):
+    base_url = api_url.replace("/api", "")
+    token = read_email_token(base_url, "verification", email)
+    r = session.post(f"{api_url}/email-verification/confirm", json={"token": token})
+    assert r.status_code == HTTPStatus.NO_CONTENT, f"Failed to verify {email}"
+
+
+def register_and_login(
+    api_url: str, email: str, password: str, name: str = "Name", verify: bool = True
+):
     base_url = api_url.replace("/api", "")
     session = requests.Session(base_url=base_url)
     payload = {"email": email, "password": password, "name": name}
@@ -122,6 +137,8 @@ def register_and_login(api_url: str, email: str, password: str, name: str = "Nam
     assert r.status_code == HTTPStatus.CREATED, f"Failed to register user {email}"
     assert "id" in r.json(), f"Failed to register user {email}"
     session.user_id = r.json()["id"]
+    if verify:
+        verify_email(session, api_url, email)
     r = session.post(f"{api_url}/login", json={"email": email, "password": password})
     assert r.status_code == HTTPStatus.OK, f"Failed to login as {email}"
     return session
@@ -5,7 +5,7 @@ from .conftest import register_and_login
 
 class TestContentEndpoints:
     def test_content_endpoints_use_nested_structure(self, api_url, admin_session):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -94,3 +94,105 @@ class TestContentEndpoints:
             f"{api_url}/courses/{course_id}/modules/{module_id}/content/{content_id}"
         )
         assert r.status_code == HTTPStatus.NOT_FOUND
+
+    def test_learners_do_not_see_quiz_answer_keys(self, api_url, admin_session):
+        author = register_and_login(api_url, "quizauthor@example.com", "lilac-harbor-97")
+
+        r = author.post(
+            f"{api_url}/proposals",
+            json={
+                "title": "Quiz Course",
+                "summary": "Summary",
+                "target_audience": "Developers",
+                "learning_objectives": "Learn",
+                "assumed_prerequisites": "None",
+            },
+        )
+        proposal_id = r.json()["id"]
+
+        r = author.post(f"{api_url}/proposals/{proposal_id}/actions/submit")
+        assert r.status_code == HTTPStatus.NO_CONTENT
+
+        r = admin_session.post(
+            f"{api_url}/proposals/{proposal_id}/actions/approve",
+            json={"review_notes": "Approved"},
+        )
+        assert r.status_code == HTTPStatus.NO_CONTENT
+
+        r = author.post(f"{api_url}/proposals/{proposal_id}/actions/create-course")
+        course_id = r.json()["id"]
+
+        r = author.post(
+            f"{api_url}/courses/{course_id}/modules",
+            json={"title": "Quiz Module", "description": "Description", "order": 1},
+        )
+        module_id = r.json()["id"]
+        content_url = f"{api_url}/courses/{course_id}/modules/{module_id}/content"
+
+        r = author.post(
+            content_url,
+            json={
+                "type": "quiz",
+                "title": "Checkpoint",
+                "order": 1,
+                "passing_score": 50,
+                "questions": [
+                    {
+                        "prompt": "Pick one",
+                        "type": "multiple_choice",
+                        "options": ["a", "b"],
+                        "correct_options": [1],
+                        "points": 1,
+                    },
+                    {
+                        "prompt": "Name it",
+                        "type": "short_answer",
+                        "accepted_answers": ["go"],
+                        "points": 1,
+                    },
+                ],
+            },
+        )
+        assert r.status_code == HTTPStatus.CREATED
+        quiz_id = r.json()["id"]
+
+        r = author.post(f"{content_url}/{quiz_id}/actions/publish?type=quiz")
+        assert r.status_code == HTTPStatus.NO_CONTENT
+        r = author.post(
+            f"{api_url}/courses/{course_id}/modules/{module_id}/actions/publish"
+        )
+        assert r.status_code == HTTPStatus.NO_CONTENT
+        r = author.post(f"{api_url}/courses/{course_id}/actions/publish")
+        assert r.status_code == HTTPStatus.NO_CONTENT
+
+        r = author.get(f"{content_url}/{quiz_id}")
+        assert r.status_code == HTTPStatus.OK
+        questions = r.json()["questions"]
+        assert questions[0]["correct_options"] == [1]
+        assert questions[1]["accepted_answers"] == ["go"]
+
+        learner = register_and_login(api_url, "quizlearner@example.com", "lilac-harbor-97")
+        r = learner.post(f"{api_url}/courses/{course_id}/actions/enroll")
+        assert r.status_code == HTTPStatus.NO_CONTENT
+
+        r = learner.get(f"{content_url}/{quiz_id}")
+        assert r.status_code == HTTPStatus.OK
+        for question in r.json()["questions"]:
+            assert "correct_options" not in question
+            assert "accepted_answers" not in question
+        assert r.json()["questions"][0]["options"] == ["a", "b"]
+
+        r = learner.get(content_url)
+        assert r.status_code == HTTPStatus.OK
+        assert len(r.json()) == 1
+        for question in r.json()[0]["questions"]:
+            assert "correct_options" not in question
+            assert "accepted_answers" not in question
+
+        r = learner.get(f"{content_url}/{quiz_id}/attempts")
+        assert r.status_code == HTTPStatus.OK
+        assert r.json() == []
+
+        outsider = register_and_login(api_url, "quizoutsider@example.com", "lilac-harbor-97")
+        r = outsider.get(f"{content_url}/{quiz_id}/attempts")
+        assert r.status_code == HTTPStatus.FORBIDDEN
@@ -6,7 +6,7 @@ from .conftest import register_and_login
 
 class TestCourseCreate:
     def test_rejects_direct_course_creation(self, api_url):
-        session = register_and_login(api_url, "instructor@example.com", "password123")
+        session = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         payload = {
             "title": "Introduction to Python",
@@ -19,7 +19,7 @@ class TestCourseCreate:
         assert r.status_code == HTTPStatus.METHOD_NOT_ALLOWED
 
     def test_rejects_direct_course_creation_even_with_valid_payload(self, api_url):
-        session = register_and_login(api_url, "instructor@example.com", "password123")
+        session = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         payload = {
             "title": "Valid Title",
@@ -49,7 +49,7 @@ class TestCourseCreationFromProposalOnly:
     def test_creates_course_from_approved_proposal_owned_by_user(
         self, api_url, admin_session
     ):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -78,7 +78,7 @@ class TestCourseCreationFromProposalOnly:
         assert r.json()["status"] == "draft"
 
     def test_created_course_has_correct_proposal_id(self, api_url, admin_session):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -108,7 +108,7 @@ class TestCourseCreationFromProposalOnly:
     def test_created_course_has_correct_fields_from_proposal(
         self, api_url, admin_session
     ):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -144,8 +144,8 @@ class TestCourseCreationFromProposalOnly:
     def test_cannot_create_course_from_proposal_owned_by_other_user(
         self, api_url, admin_session
     ):
-        author1 = register_and_login(api_url, "author1@example.com", "password123")
-        author2 = register_and_login(api_url, "author2@example.com", "password123")
+        author1 = register_and_login(api_url, "author1@example.com", "lilac-harbor-97")
+        author2 = register_and_login(api_url, "author2@example.com", "lilac-harbor-97")
 
         r = author1.post(
             f"{api_url}/proposals",
@@ -172,13 +172,13 @@ class TestCourseCreationFromProposalOnly:
         assert r.status_code == HTTPStatus.NOT_FOUND
 
     def test_cannot_create_course_from_nonexistent_proposal(self, api_url):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(f"{api_url}/proposals/{2**63 - 1}/actions/create-course")
         assert r.status_code == HTTPStatus.NOT_FOUND
 
     def test_cannot_create_course_from_draft_proposal(self, api_url):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -196,7 +196,7 @@ class TestCourseCreationFromProposalOnly:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_cannot_create_course_from_submitted_proposal(self, api_url):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -217,7 +217,7 @@ class TestCourseCreationFromProposalOnly:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_cannot_create_course_from_rejected_proposal(self, api_url, admin_session):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -244,7 +244,7 @@ class TestCourseCreationFromProposalOnly:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_cannot_create_course_from_withdrawn_proposal(self, api_url):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -270,7 +270,7 @@ class TestCourseCreationFromProposalOnly:
     def test_cannot_create_course_from_changes_requested_proposal(
         self, api_url, admin_session
     ):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -299,7 +299,7 @@ class TestCourseCreationFromProposalOnly:
     def test_cannot_create_second_course_from_same_proposal(
         self, api_url, admin_session
     ):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -332,7 +332,7 @@ class TestCourseCreationFromProposalOnly:
     def test_requires_authentication_to_create_course_from_proposal(
         self, api_url, admin_session
     ):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -361,7 +361,7 @@ class TestCourseCreationFromProposalOnly:
 
 class TestCourseRead:
     def test_gets_course_by_id(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -394,7 +394,7 @@ class TestCourseRead:
         assert r.json()["title"] == "Course Title"
 
     def test_returns_404_for_nonexistent_course(self, api_url):
-        session = register_and_login(api_url, "instructor@example.com", "password123")
+        session = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = session.get(f"{api_url}/courses/{2**63 - 1}")
         assert r.status_code == HTTPStatus.NOT_FOUND
@@ -402,10 +402,11 @@ class TestCourseRead:
     def test_lists_live_courses_returns_empty_when_no_courses(self, api_url):
         r = requests.get(f"{api_url}/courses")
         assert r.status_code == HTTPStatus.OK
-        assert r.json() == []
+        assert r.json()["courses"] == []
+        assert r.json()["next_cursor"] == ""
 
     def test_rejects_delete_method_on_courses_list(self, api_url):
-        session = register_and_login(api_url, "instructor@example.com", "password123")
+        session = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = session.delete(f"{api_url}/courses")
         assert r.status_code == HTTPStatus.METHOD_NOT_ALLOWED
@@ -413,7 +414,7 @@ class TestCourseRead:
 
 class TestCourseUpdate:
     def test_updates_course_fields(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -456,7 +457,7 @@ class TestCourseUpdate:
         assert r.json()["summary"] == "Updated Summary"
 
     def test_returns_404_for_nonexistent_course(self, api_url):
-        session = register_and_login(api_url, "instructor@example.com", "password123")
+        session = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = session.patch(
             f"{api_url}/courses/{2**63 - 1}",
@@ -478,7 +479,7 @@ class TestCourseUpdate:
         assert r.status_code == HTTPStatus.UNAUTHORIZED
 
     def test_partial_update(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -521,7 +522,7 @@ class TestCourseUpdate:
         assert r.json()["summary"] == "Original Summary"
 
     def test_invalid_course_id_format(self, api_url):
-        session = register_and_login(api_url, "instructor@example.com", "password123")
+        session = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = session.patch(
             f"{api_url}/courses/invalid",
@@ -539,7 +540,7 @@ class TestCourseUpdate:
 class TestCoursePermissions:
     def test_instructor_can_access_own_course(self, api_url, admin_session):
         instructor = register_and_login(
-            api_url, "instructor1@example.com", "password123"
+            api_url, "instructor1@example.com", "lilac-harbor-97"
         )
 
         r = instructor.post(
@@ -572,10 +573,10 @@ class TestCoursePermissions:
 
     def test_other_instructor_cannot_update_course(self, api_url, admin_session):
         instructor1 = register_and_login(
-            api_url, "instructor1@example.com", "password123"
+            api_url, "instructor1@example.com", "lilac-harbor-97"
         )
         instructor2 = register_and_login(
-            api_url, "instructor2@example.com", "password123"
+            api_url, "instructor2@example.com", "lilac-harbor-97"
         )
 
         r = instructor1.post(
@@ -619,10 +620,10 @@ class TestCoursePermissions:
 
     def test_instructors_see_each_others_draft_courses(self, api_url, admin_session):
         instructor1 = register_and_login(
-            api_url, "instructor1@example.com", "password123"
+            api_url, "instructor1@example.com", "lilac-harbor-97"
         )
         instructor2 = register_and_login(
-            api_url, "instructor2@example.com", "password123"
+            api_url, "instructor2@example.com", "lilac-harbor-97"
         )
 
         r = instructor1.post(
@@ -687,7 +688,7 @@ class TestCoursePublicAccess:
 
     def test_live_courses_are_publicly_accessible(self, api_url, admin_session):
         instructor = register_and_login(
-            api_url, "instructor@example.com", "password123"
+            api_url, "instructor@example.com", "lilac-harbor-97"
         )
 
         r = instructor.post(
@@ -720,16 +721,16 @@ class TestCoursePublicAccess:
         r = requests.get(f"{api_url}/courses")
         assert r.status_code == HTTPStatus.OK
 
-        course_ids = [course["id"] for course in r.json()]
+        course_ids = [course["id"] for course in r.json()["courses"]]
         if course_id in course_ids:
-            for course in r.json():
+            for course in r.json()["courses"]:
                 if course["id"] == course_id:
                     assert course["status"] == "published"
 
 
 class TestCoursePublish:
     def test_publishes_draft_course(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -764,7 +765,7 @@ class TestCoursePublish:
         assert r.json()["status"] == "published"
 
     def test_publish_requires_authentication(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -795,10 +796,10 @@ class TestCoursePublish:
 
     def test_cannot_publish_other_instructors_course(self, api_url, admin_session):
         instructor1 = register_and_login(
-            api_url, "instructor1@example.com", "password123"
+            api_url, "instructor1@example.com", "lilac-harbor-97"
         )
         instructor2 = register_and_login(
-            api_url, "instructor2@example.com", "password123"
+            api_url, "instructor2@example.com", "lilac-harbor-97"
         )
 
         r = instructor1.post(
@@ -832,7 +833,7 @@ class TestCoursePublish:
         assert r.json()["status"] == "draft"
 
     def test_cannot_publish_already_published_course(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -865,13 +866,13 @@ class TestCoursePublish:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_cannot_publish_nonexistent_course(self, api_url):
-        session = register_and_login(api_url, "instructor@example.com", "password123")
+        session = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = session.post(f"{api_url}/courses/{2**63 - 1}/actions/publish")
         assert r.status_code == HTTPStatus.NOT_FOUND
 
     def test_published_course_appears_in_list(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -898,20 +899,20 @@ class TestCoursePublish:
         course_id = r.json()["id"]
 
         r = requests.get(f"{api_url}/courses")
-        course_ids = [course["id"] for course in r.json()]
+        course_ids = [course["id"] for course in r.json()["courses"]]
         assert course_id not in course_ids
 
         r = author.post(f"{api_url}/courses/{course_id}/actions/publish")
         assert r.status_code == HTTPStatus.NO_CONTENT
 
         r = requests.get(f"{api_url}/courses")
-        course_ids = [course["id"] for course in r.json()]
+        course_ids = [course["id"] for course in r.json()["courses"]]
         assert course_id in course_ids
 
 
 class TestCourseCreateFromProposal:
     def test_creates_course_from_approved_proposal(self, api_url, admin_session):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -943,7 +944,7 @@ class TestCourseCreateFromProposal:
         assert r.json()["status"] == "draft"
 
     def test_cannot_create_from_draft_proposal(self, api_url):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -961,7 +962,7 @@ class TestCourseCreateFromProposal:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_cannot_create_from_rejected_proposal(self, api_url, admin_session):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -988,7 +989,7 @@ class TestCourseCreateFromProposal:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_cannot_create_if_course_already_exists(self, api_url, admin_session):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1019,8 +1020,8 @@ class TestCourseCreateFromProposal:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_only_proposal_author_can_create_course(self, api_url, admin_session):
-        author1 = register_and_login(api_url, "author1@example.com", "password123")
-        author2 = register_and_login(api_url, "author2@example.com", "password123")
+        author1 = register_and_login(api_url, "author1@example.com", "lilac-harbor-97")
+        author2 = register_and_login(api_url, "author2@example.com", "lilac-harbor-97")
 
         r = author1.post(
             f"{api_url}/proposals",
@@ -1047,7 +1048,7 @@ class TestCourseCreateFromProposal:
         assert r.status_code == HTTPStatus.NOT_FOUND
 
     def test_requires_authentication(self, api_url, admin_session):
-        author = register_and_login(api_url, "author@example.com", "password123")
+        author = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1077,7 +1078,7 @@ class TestCourseCreateFromProposal:
 class TestCourseAdminAccess:
     def test_admin_can_access_any_course(self, api_url, admin_session):
         instructor = register_and_login(
-            api_url, "instructor@example.com", "password123"
+            api_url, "instructor@example.com", "lilac-harbor-97"
         )
 
         r = instructor.post(
@@ -1112,7 +1113,7 @@ class TestCourseAdminAccess:
         self, api_url, admin_session
     ):
         instructor = register_and_login(
-            api_url, "instructor@example.com", "password123"
+            api_url, "instructor@example.com", "lilac-harbor-97"
         )
 
         r = instructor.post(
@@ -1148,7 +1149,7 @@ class TestCourseAdminAccess:
         self, api_url, admin_session
     ):
         instructor = register_and_login(
-            api_url, "instructor@example.com", "password123"
+            api_url, "instructor@example.com", "lilac-harbor-97"
         )
 
         r = instructor.post(
@@ -1185,7 +1186,7 @@ class TestCourseAdminAccess:
 
 class TestCourseFieldValidation:
     def test_rejects_title_too_short(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1224,7 +1225,7 @@ class TestCourseFieldValidation:
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
     def test_rejects_title_too_long(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1263,7 +1264,7 @@ class TestCourseFieldValidation:
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
     def test_rejects_summary_too_long(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1302,7 +1303,7 @@ class TestCourseFieldValidation:
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
     def test_rejects_target_audience_too_long(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1341,7 +1342,7 @@ class TestCourseFieldValidation:
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
     def test_rejects_learning_objectives_too_long(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1380,7 +1381,7 @@ class TestCourseFieldValidation:
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
     def test_rejects_assumed_prerequisites_too_long(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1419,7 +1420,7 @@ class TestCourseFieldValidation:
         assert r.status_code == HTTPStatus.BAD_REQUEST
 
     def test_trims_whitespace_from_fields(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1453,7 +1454,7 @@ class TestCourseFieldValidation:
         assert r.json()["assumed_prerequisites"] == "None"
 
     def test_trims_whitespace_on_update(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1501,7 +1502,7 @@ class TestCourseFieldValidation:
 
 class TestCourseList:
     def test_list_only_shows_published_courses(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1555,12 +1556,12 @@ class TestCourseList:
 
         r = requests.get(f"{api_url}/courses")
         assert r.status_code == HTTPStatus.OK
-        course_ids = [course["id"] for course in r.json()]
+        course_ids = [course["id"] for course in r.json()["courses"]]
         assert draft_course_id not in course_ids
         assert published_course_id in course_ids
 
     def test_list_shows_multiple_published_courses(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1616,12 +1617,12 @@ class TestCourseList:
 
         r = requests.get(f"{api_url}/courses")
         assert r.status_code == HTTPStatus.OK
-        course_ids = [course["id"] for course in r.json()]
+        course_ids = [course["id"] for course in r.json()["courses"]]
         assert course1_id in course_ids
         assert course2_id in course_ids
 
     def test_list_works_without_authentication(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1651,11 +1652,11 @@ class TestCourseList:
 
         r = requests.get(f"{api_url}/courses")
         assert r.status_code == HTTPStatus.OK
-        course_ids = [course["id"] for course in r.json()]
+        course_ids = [course["id"] for course in r.json()["courses"]]
         assert course_id in course_ids
 
     def test_list_works_with_authentication(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1685,7 +1686,7 @@ class TestCourseList:
 
         r = author.get(f"{api_url}/courses")
         assert r.status_code == HTTPStatus.OK
-        course_ids = [course["id"] for course in r.json()]
+        course_ids = [course["id"] for course in r.json()["courses"]]
         assert course_id in course_ids
 
 
@@ -1694,9 +1695,9 @@ class TestCourseGetPermissions:
         self, api_url, admin_session
     ):
         instructor = register_and_login(
-            api_url, "instructor@example.com", "password123"
+            api_url, "instructor@example.com", "lilac-harbor-97"
         )
-        student = register_and_login(api_url, "student@example.com", "password123")
+        student = register_and_login(api_url, "student@example.com", "lilac-harbor-97")
 
         r = instructor.post(
             f"{api_url}/proposals",
@@ -1727,9 +1728,9 @@ class TestCourseGetPermissions:
 
     def test_published_course_visibility(self, api_url, admin_session):
         instructor = register_and_login(
-            api_url, "instructor@example.com", "password123"
+            api_url, "instructor@example.com", "lilac-harbor-97"
         )
-        student = register_and_login(api_url, "student@example.com", "password123")
+        student = register_and_login(api_url, "student@example.com", "lilac-harbor-97")
 
         r = instructor.post(
             f"{api_url}/proposals",
@@ -1761,7 +1762,7 @@ class TestCourseGetPermissions:
         assert r.status_code == HTTPStatus.OK
 
     def test_invalid_course_id_format(self, api_url):
-        session = register_and_login(api_url, "instructor@example.com", "password123")
+        session = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = session.get(f"{api_url}/courses/invalid")
         assert r.status_code == HTTPStatus.BAD_REQUEST
@@ -1769,7 +1770,7 @@ class TestCourseGetPermissions:
 
 class TestCourseTimestamps:
     def test_created_at_is_set_on_creation(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
@@ -1798,7 +1799,7 @@ class TestCourseTimestamps:
         assert r.json()["created_at"] is not None
 
     def test_updated_at_changes_on_update(self, api_url, admin_session):
-        author = register_and_login(api_url, "instructor@example.com", "password123")
+        author = register_and_login(api_url, "instructor@example.com", "lilac-harbor-97")
 
         r = author.post(
             f"{api_url}/proposals",
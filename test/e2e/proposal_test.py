@@ -5,7 +5,7 @@ from .conftest import register_and_login
 
 class TestProposalCreate:
     def test_creates_proposal_with_title_and_summary(self, api_url):
-        session = register_and_login(api_url, "author@example.com", "password123")
+        session = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = session.post(
             f"{api_url}/proposals",
@@ -15,7 +15,7 @@ class TestProposalCreate:
         assert "id" in r.json()
 
     def test_creates_proposal_with_all_fields(self, api_url):
-        session = register_and_login(api_url, "author@example.com", "password123")
+        session = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         payload = {
             "title": "Complete Course",
@@ -48,7 +48,7 @@ class TestProposalCreate:
         assert r.json()["status"] == "draft"
 
     def test_new_proposal_has_author_id(self, api_url):
-        session = register_and_login(api_url, "author@example.com", "password123")
+        session = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         payload = {"title": "Course", "summary": "A course."}
         r = session.post(f"{api_url}/proposals", json=payload)
@@ -59,7 +59,7 @@ class TestProposalCreate:
 
 class TestProposalRead:
     def test_lists_own_proposals(self, api_url):
-        session = register_and_login(api_url, "author@example.com", "password123")
+        session = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         session.post(
             f"{api_url}/proposals",
@@ -79,14 +79,14 @@ class TestProposalRead:
         assert proposals[1]["title"] == "Second Course"
 
     def test_returns_empty_list_when_no_proposals(self, api_url):
-        session = register_and_login(api_url, "newauthor@example.com", "password123")
+        session = register_and_login(api_url, "newauthor@example.com", "lilac-harbor-97")
 
         r = session.get(f"{api_url}/proposals")
         assert r.status_code == HTTPStatus.OK
         assert r.json() == []
 
     def test_gets_proposal_by_id(self, api_url):
-        session = register_and_login(api_url, "author@example.com", "password123")
+        session = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = session.post(
             f"{api_url}/proposals",
@@ -100,13 +100,13 @@ class TestProposalRead:
         assert r.json()["author_id"] == session.user_id
 
     def test_returns_404_for_nonexistent_proposal(self, api_url):
-        session = register_and_login(api_url, "author@example.com", "password123")
+        session = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = session.get(f"{api_url}/proposals/{2**63 - 1}")
         assert r.status_code == HTTPStatus.NOT_FOUND
 
     def test_rejects_delete_method_on_proposals_list(self, api_url):
-        session = register_and_login(api_url, "author@example.com", "password123")
+        session = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = session.delete(f"{api_url}/proposals")
         assert r.status_code == HTTPStatus.METHOD_NOT_ALLOWED
@@ -114,7 +114,7 @@ class TestProposalRead:
 
 class TestProposalUpdate:
     def test_updates_proposal_title(self, api_url):
-        session = register_and_login(api_url, "author@example.com", "password123")
+        session = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = session.post(
             f"{api_url}/proposals",
@@ -183,7 +183,7 @@ class TestProposalDelete:
 
 class TestProposalUpdateStatus:
     def test_submit_changes_status_to_submitted(self, api_url):
-        session = register_and_login(api_url, "author@example.com", "password123")
+        session = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = session.post(
             f"{api_url}/proposals",
@@ -198,7 +198,7 @@ class TestProposalUpdateStatus:
         assert r.json()["status"] == "submitted"
 
     def test_unknown_status_returns_400(self, api_url):
-        session = register_and_login(api_url, "author@example.com", "password123")
+        session = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = session.post(
             f"{api_url}/proposals",
@@ -313,8 +313,8 @@ class TestProposalUpdateStatus:
 
 class TestProposalPermissions:
     def test_user_cannot_view_other_users_proposal(self, api_url):
-        user_a = register_and_login(api_url, "usera@example.com", "password")
-        user_b = register_and_login(api_url, "userb@example.com", "password")
+        user_a = register_and_login(api_url, "usera@example.com", "lilac-harbor-97")
+        user_b = register_and_login(api_url, "userb@example.com", "lilac-harbor-97")
 
         r = user_a.post(
             f"{api_url}/proposals",
@@ -326,8 +326,8 @@ class TestProposalPermissions:
         assert r.status_code == HTTPStatus.NOT_FOUND
 
     def test_users_see_only_their_own_proposals(self, api_url):
-        user_a = register_and_login(api_url, "usera@example.com", "password")
-        user_b = register_and_login(api_url, "userb@example.com", "password")
+        user_a = register_and_login(api_url, "usera@example.com", "lilac-harbor-97")
+        user_b = register_and_login(api_url, "userb@example.com", "lilac-harbor-97")
 
         user_a.post(
             f"{api_url}/proposals",
@@ -347,7 +347,7 @@ class TestProposalPermissions:
         assert r.json()[0]["title"] == "B's Proposal"
 
     def test_users_cannot_approve_their_own_proposals(self, api_url):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -370,8 +370,8 @@ class TestProposalPermissions:
         assert r.json()["reviewer_id"] is None
 
     def test_user_cannot_submit_other_users_proposal(self, api_url):
-        user_a = register_and_login(api_url, "usera@example.com", "password")
-        user_b = register_and_login(api_url, "userb@example.com", "password")
+        user_a = register_and_login(api_url, "usera@example.com", "lilac-harbor-97")
+        user_b = register_and_login(api_url, "userb@example.com", "lilac-harbor-97")
 
         r = user_a.post(
             f"{api_url}/proposals",
@@ -383,8 +383,8 @@ class TestProposalPermissions:
         assert r.status_code == HTTPStatus.NOT_FOUND
 
     def test_user_cannot_withdraw_other_users_proposal(self, api_url):
-        user_a = register_and_login(api_url, "usera@example.com", "password")
-        user_b = register_and_login(api_url, "userb@example.com", "password")
+        user_a = register_and_login(api_url, "usera@example.com", "lilac-harbor-97")
+        user_b = register_and_login(api_url, "userb@example.com", "lilac-harbor-97")
 
         r = user_a.post(
             f"{api_url}/proposals",
@@ -397,8 +397,8 @@ class TestProposalPermissions:
         assert r.status_code == HTTPStatus.NOT_FOUND
 
     def test_user_cannot_update_other_users_proposal(self, api_url):
-        user_a = register_and_login(api_url, "usera@example.com", "password")
-        user_b = register_and_login(api_url, "userb@example.com", "password")
+        user_a = register_and_login(api_url, "usera@example.com", "lilac-harbor-97")
+        user_b = register_and_login(api_url, "userb@example.com", "lilac-harbor-97")
 
         r = user_a.post(
             f"{api_url}/proposals",
@@ -413,8 +413,8 @@ class TestProposalPermissions:
         assert r.status_code == HTTPStatus.NOT_FOUND
 
     def test_user_cannot_delete_other_users_proposal(self, api_url):
-        user_a = register_and_login(api_url, "usera@example.com", "password")
-        user_b = register_and_login(api_url, "userb@example.com", "password")
+        user_a = register_and_login(api_url, "usera@example.com", "lilac-harbor-97")
+        user_b = register_and_login(api_url, "userb@example.com", "lilac-harbor-97")
 
         r = user_a.post(
             f"{api_url}/proposals",
@@ -426,8 +426,8 @@ class TestProposalPermissions:
         assert r.status_code == HTTPStatus.NOT_FOUND
 
     def test_user_cannot_approve_other_users_proposal(self, api_url):
-        user_a = register_and_login(api_url, "usera@example.com", "password")
-        user_b = register_and_login(api_url, "userb@example.com", "password")
+        user_a = register_and_login(api_url, "usera@example.com", "lilac-harbor-97")
+        user_b = register_and_login(api_url, "userb@example.com", "lilac-harbor-97")
 
         r = user_a.post(
             f"{api_url}/proposals",
@@ -443,8 +443,8 @@ class TestProposalPermissions:
         assert r.status_code == HTTPStatus.FORBIDDEN
 
     def test_user_cannot_reject_other_users_proposal(self, api_url):
-        user_a = register_and_login(api_url, "usera@example.com", "password")
-        user_b = register_and_login(api_url, "userb@example.com", "password")
+        user_a = register_and_login(api_url, "usera@example.com", "lilac-harbor-97")
+        user_b = register_and_login(api_url, "userb@example.com", "lilac-harbor-97")
 
         r = user_a.post(
             f"{api_url}/proposals",
@@ -460,8 +460,8 @@ class TestProposalPermissions:
         assert r.status_code == HTTPStatus.FORBIDDEN
 
     def test_user_cannot_request_changes_on_other_users_proposal(self, api_url):
-        user_a = register_and_login(api_url, "usera@example.com", "password")
-        user_b = register_and_login(api_url, "userb@example.com", "password")
+        user_a = register_and_login(api_url, "usera@example.com", "lilac-harbor-97")
+        user_b = register_and_login(api_url, "userb@example.com", "lilac-harbor-97")
 
         r = user_a.post(
             f"{api_url}/proposals",
@@ -477,7 +477,7 @@ class TestProposalPermissions:
         assert r.status_code == HTTPStatus.FORBIDDEN
 
     def test_user_cannot_reject_their_own_proposal(self, api_url):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -493,7 +493,7 @@ class TestProposalPermissions:
         assert r.status_code == HTTPStatus.FORBIDDEN
 
     def test_user_cannot_request_changes_on_their_own_proposal(self, api_url):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -511,7 +511,7 @@ class TestProposalPermissions:
 
 class TestAdminProposalAccess:
     def test_admin_sees_submitted_proposals(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -528,12 +528,13 @@ class TestAdminProposalAccess:
 
         r = admin_session.get(f"{api_url}/proposals")
         assert r.status_code == HTTPStatus.OK
-        ids = [p["id"] for p in r.json()]
+        ids = [p["id"] for p in r.json()["proposals"]]
         assert submitted_id in ids
         assert draft_id not in ids
+        assert r.json()["counts"]["submitted"] >= 1
 
     def test_admin_cannot_view_draft_proposals(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -545,7 +546,7 @@ class TestAdminProposalAccess:
         assert r.status_code == HTTPStatus.NOT_FOUND
 
     def test_admin_can_view_submitted_proposal(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -819,7 +820,7 @@ class TestProposalWorkflowWithdrawal:
 
 class TestProposalStateTransitions:
     def test_approve_fails_on_draft_proposal(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -834,7 +835,7 @@ class TestProposalStateTransitions:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_approve_fails_on_withdrawn_proposal(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -852,7 +853,7 @@ class TestProposalStateTransitions:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_approve_fails_on_already_approved_proposal(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -873,7 +874,7 @@ class TestProposalStateTransitions:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_approve_fails_on_rejected_proposal(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -894,7 +895,7 @@ class TestProposalStateTransitions:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_reject_fails_on_draft_proposal(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -909,7 +910,7 @@ class TestProposalStateTransitions:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_reject_fails_on_withdrawn_proposal(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -927,7 +928,7 @@ class TestProposalStateTransitions:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_reject_fails_on_already_rejected_proposal(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -948,7 +949,7 @@ class TestProposalStateTransitions:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_request_changes_fails_on_draft_proposal(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -963,7 +964,7 @@ class TestProposalStateTransitions:
         assert r.status_code == HTTPStatus.CONFLICT
 
     def test_request_changes_fails_on_withdrawn_proposal(self, api_url, admin_session):
-        user = register_and_login(api_url, "author@example.com", "password123")
+        user = register_and_login(api_url, "author@example.com", "lilac-harbor-97")
 
         r = user.post(
             f"{api_url}/proposals",
@@ -48,6 +48,9 @@ export default class FormHandler {
             const el = document.getElementById(id);
             payload[id] = el?.value ?? "";
         });
+        if (this.options.readExtraFields) {
+            Object.assign(payload, this.options.readExtraFields());
+        }
         return payload;
     }
 
@@ -30,6 +30,12 @@ async function handleError(response) {
         if (text.includes("CSRF")) {
             throw new Error("CSRF token validation failed - please refresh the page");
         }
+        if (text.includes("email not verified")) {
+            throw new Error("Please verify your email address first");
+        }
+        if (text.includes("suspended")) {
+            throw new Error("This account has been suspended");
+        }
         throw new Error("Permission denied");
     }
     if (response.status === 409) {
@@ -112,7 +118,26 @@ const api = {
         return response;
     },
 
-    async delete(path) {
+    async put(path, data) {
+        const headers = { "Content-Type": "application/json" };
+        const csrfToken = getCSRFToken();
+        if (csrfToken) {
+            headers["X-CSRF-Token"] = csrfToken;
+        }
+        const response = await fetch(path, {
+            method: "PUT",
+            headers: headers,
+            body: JSON.stringify(data),
+        });
+        const handled = handleResponse(response, path);
+        if (!handled) return null;
+        if (!response.ok) {
+            await handleError(response);
+        }
+        return response;
+    },
+
+    async delete(path, data) {
         const headers = {};
         const csrfToken = getCSRFToken();
         if (csrfToken) {
@@ -121,6 +146,10 @@ const api = {
         const options = {
             method: "DELETE",
         };
+        if (data !== undefined) {
+            headers["Content-Type"] = "application/json";
+            options.body = JSON.stringify(data);
+        }
         if (Object.keys(headers).length > 0) {
             options.headers = headers;
         }
@@ -6,14 +6,14 @@ export async function extractErrorMessage(response) {
     if (contentType.includes("application/json") && text) {
         try {
             const data = JSON.parse(text);
-            if (data.error) {
-                message = data.error;
-            } else if (data.errors && Array.isArray(data.errors) && data.errors.length > 0) {
+            if (data.errors && Array.isArray(data.errors) && data.errors.length > 0) {
                 const parts = data.errors.map((e) => {
                     const m = e.Message || e.message || String(e);
                     return e.Field ? `${e.Field}: ${m}` : m;
                 });
                 message = parts.join("; ");
+            } else if (data.error) {
+                message = data.error;
             }
         } catch (_) {
             message = text || "";
@@ -31,14 +31,14 @@ export function parseErrorFromText(text, contentType) {
     if (contentType.includes("application/json") && text) {
         try {
             const data = JSON.parse(text);
-            if (data.error) {
-                message = data.error;
-            } else if (data.errors && Array.isArray(data.errors) && data.errors.length > 0) {
+            if (data.errors && Array.isArray(data.errors) && data.errors.length > 0) {
                 const parts = data.errors.map((e) => {
                     const m = e.Message || e.message || String(e);
                     return e.Field ? `${e.Field}: ${m}` : m;
                 });
                 message = parts.join("; ");
+            } else if (data.error) {
+                message = data.error;
             }
         } catch (_) {
             message = text || "";
@@ -100,3 +100,32 @@ export async function deleteProposal(proposalId, status, options = {}) {
         return false;
     }
 }
+
+export function bindUnlockRuleInputs(container) {
+    const rule = container.querySelector(".module-unlock-rule");
+    const days = container.querySelector(".module-unlock-days");
+    const date = container.querySelector(".module-unlock-date");
+    if (!rule) return;
+
+    const sync = () => {
+        days.style.display = rule.value === "after_days" ? "" : "none";
+        date.style.display = rule.value === "on_date" ? "" : "none";
+    };
+    rule.addEventListener("change", sync);
+    sync();
+}
+
+export function readUnlockRule(container) {
+    const rule = container.querySelector(".module-unlock-rule")?.value || "immediately";
+    const days = container.querySelector(".module-unlock-days")?.value;
+    const date = container.querySelector(".module-unlock-date")?.value;
+
+    return {
+        unlock_rule: rule,
+        unlock_days: rule === "after_days" ? Number(days) || 0 : 0,
+        unlock_at:
+            rule === "on_date" && date
+                ? new Date(`${date}T00:00:00`).toISOString()
+                : null,
+    };
+}
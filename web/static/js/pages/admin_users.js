@@ -0,0 +1,136 @@
+import api from "../core/api.js";
+import { $ } from "../core/dom.js";
+import { showError, hideError, formatDate, debounce } from "../core/utils.js";
+
+const ROLES = ["user", "reviewer", "admin"];
+
+document.addEventListener("DOMContentLoaded", () => {
+    const list = $("#admin-user-list");
+    if (!list) return;
+
+    const currentUserID = Number(list.getAttribute("data-current-user-id"));
+    const searchInput = $("#user-search");
+    const roleFilter = $("#user-role-filter");
+    const moreBtn = $("#admin-user-more");
+    const statusDiv = $("#admin-user-status");
+    let nextCursor = "";
+
+    const load = async (append) => {
+        hideError(statusDiv);
+        const params = new URLSearchParams();
+        if (searchInput.value.trim()) params.set("q", searchInput.value.trim());
+        if (roleFilter.value) params.set("role", roleFilter.value);
+        if (append && nextCursor) params.set("cursor", nextCursor);
+
+        try {
+            const response = await api.get(`/api/admin/users?${params}`);
+            const page = await response.json();
+            if (!append) list.innerHTML = "";
+            for (const user of page.users) {
+                list.appendChild(renderUser(user));
+            }
+            if (!append && page.users.length === 0) {
+                list.innerHTML = '<li class="empty-state">No users match these filters.</li>';
+            }
+            nextCursor = page.next_cursor;
+            moreBtn.classList.toggle("hidden", !nextCursor);
+        } catch (error) {
+            showError(error.message || "Failed to load users", statusDiv);
+        }
+    };
+
+    const act = async (message, request) => {
+        if (message && !confirm(message)) return;
+        hideError(statusDiv);
+        try {
+            await request();
+            await load(false);
+        } catch (error) {
+            showError(error.message || "Action failed", statusDiv);
+        }
+    };
+
+    const renderUser = (user) => {
+        const item = document.createElement("li");
+
+        const info = document.createElement("div");
+        const name = document.createElement("strong");
+        name.textContent = user.name || user.email;
+        if (user.suspended_at) {
+            const badge = document.createElement("span");
+            badge.className = "session-current admin-user-suspended";
+            badge.textContent = "Suspended";
+            name.append(" ", badge);
+        }
+        const details = document.createElement("span");
+        details.textContent = `${user.email} · joined ${formatDate(user.created_at)}`;
+        info.append(name, details);
+        item.appendChild(info);
+
+        const self = user.id === currentUserID;
+        const actions = document.createElement("div");
+        actions.className = "admin-user-actions";
+
+        const roleSelect = document.createElement("select");
+        roleSelect.className = "filter-dropdown";
+        roleSelect.setAttribute("aria-label", `Role for ${user.email}`);
+        roleSelect.disabled = self;
+        for (const role of ROLES) {
+            const option = document.createElement("option");
+            option.value = role;
+            option.textContent = role;
+            option.selected = role === user.role;
+            roleSelect.appendChild(option);
+        }
+        roleSelect.addEventListener("change", () =>
+            act(null, () =>
+                api.put(`/api/admin/users/${user.id}/role`, { role: roleSelect.value }),
+            ),
+        );
+        actions.appendChild(roleSelect);
+
+        const resetBtn = document.createElement("button");
+        resetBtn.type = "button";
+        resetBtn.className = "btn btn-secondary";
+        resetBtn.textContent = "Reset Password";
+        resetBtn.addEventListener("click", () =>
+            act(`Log ${user.email} out and email them a password reset link?`, () =>
+                api.post(`/api/admin/users/${user.id}/actions/force-password-reset`),
+            ),
+        );
+        actions.appendChild(resetBtn);
+
+        const suspendBtn = document.createElement("button");
+        suspendBtn.type = "button";
+        if (user.suspended_at) {
+            suspendBtn.className = "btn btn-secondary";
+            suspendBtn.textContent = "Reactivate";
+            suspendBtn.addEventListener("click", () =>
+                act(null, () => api.post(`/api/admin/users/${user.id}/actions/reactivate`)),
+            );
+        } else {
+            suspendBtn.className = "btn btn-danger";
+            suspendBtn.textContent = "Suspend";
+            suspendBtn.disabled = self;
+            suspendBtn.addEventListener("click", () =>
+                act(`Suspend ${user.email}? They will be logged out everywhere.`, () =>
+                    api.post(`/api/admin/users/${user.id}/actions/suspend`),
+                ),
+            );
+        }
+        actions.appendChild(suspendBtn);
+
+        item.appendChild(actions);
+        return item;
+    };
+
+    searchInput.addEventListener("input", debounce(() => load(false), 300));
+    roleFilter.addEventListener("change", () => load(false));
+    $("#user-filters").addEventListener("submit", (e) => {
+        e.preventDefault();
+        load(false);
+    });
+    moreBtn.addEventListener("click", () => load(true));
+
+    load(false);
+});
@@ -0,0 +1,99 @@
+import api, { getCSRFToken } from "../core/api.js";
+import { $ } from "../core/dom.js";
+import { showErrorToast, showSuccessToast } from "../components/Toast.js";
+
+async function submitWithFile(url, text, file) {
+    const formData = new FormData();
+    formData.append("text", text);
+    formData.append("file", file);
+
+    const headers = {};
+    const csrfToken = getCSRFToken();
+    if (csrfToken) {
+        headers["X-CSRF-Token"] = csrfToken;
+    }
+
+    const response = await fetch(url, {
+        method: "POST",
+        headers: headers,
+        body: formData,
+        credentials: "include",
+    });
+
+    if (!response.ok) {
+        const data = await response.json().catch(() => ({}));
+        throw new Error(data.error || "Failed to submit");
+    }
+}
+
+document.addEventListener("DOMContentLoaded", () => {
+    const { courseId, moduleId, assignmentId } = window.ASSIGNMENT_VIEW_DATA || {};
+
+    if (!courseId || !moduleId || !assignmentId) return;
+
+    const apiUrl = `/api/courses/${courseId}/modules/${moduleId}/content/${assignmentId}`;
+
+    const form = $("#submission-form");
+    if (form) {
+        form.addEventListener("submit", async (e) => {
+            e.preventDefault();
+            const text = $("#submission-text").value.trim();
+            const file = $("#submission-file").files[0];
+            if (!text && !file) {
+                showErrorToast("Add some text or attach a file");
+                return;
+            }
+            try {
+                if (file) {
+                    await submitWithFile(`${apiUrl}/submissions`, text, file);
+                } else {
+                    await api.post(`${apiUrl}/submissions`, { text });
+                }
+                showSuccessToast("Submission received");
+                setTimeout(() => window.location.reload(), 1500);
+            } catch (err) {
+                showErrorToast(err.message || "Failed to submit");
+            }
+        });
+    }
+
+    document.querySelectorAll(".assignment-grade-form").forEach((gradeForm) => {
+        gradeForm.addEventListener("submit", async (e) => {
+            e.preventDefault();
+            const submissionId = gradeForm.dataset.submissionId;
+            try {
+                await api.post(`${apiUrl}/submissions/${submissionId}/actions/grade`, {
+                    grade: Number(gradeForm.elements.grade.value),
+                    feedback: gradeForm.elements.feedback.value.trim(),
+                });
+                showSuccessToast("Grade saved");
+            } catch (err) {
+                showErrorToast(err.message || "Failed to save grade");
+            }
+        });
+    });
+
+    const publishBtn = $("#publish-btn");
+    if (publishBtn) {
+        publishBtn.addEventListener("click", async () => {
+            try {
+                await api.post(`${apiUrl}/actions/publish?type=assignment`);
+                window.location.reload();
+            } catch (err) {
+                showErrorToast(err.message || "Failed to publish");
+            }
+        });
+    }
+
+    const unpublishBtn = $("#unpublish-btn");
+    if (unpublishBtn) {
+        unpublishBtn.addEventListener("click", async () => {
+            try {
+                await api.post(`${apiUrl}/actions/unpublish?type=assignment`);
+                window.location.reload();
+            } catch (err) {
+                showErrorToast(err.message || "Failed to unpublish");
+            }
+        });
+    }
+});
@@ -39,7 +39,7 @@ function createAuthFormHandler(config) {
 
             if (isSuccess) {
                 if (config.onSuccess) {
-                    config.onSuccess(response, form, successDiv);
+                    await config.onSuccess(response, form, successDiv);
                 } else if (config.successRedirect) {
                     window.location.href = config.successRedirect;
                 }
@@ -66,6 +66,31 @@ function validateNextUrl(next) {
     return s;
 }
 
+function redirectAfterLogin() {
+    const params = new URLSearchParams(window.location.search);
+    window.location.href = validateNextUrl(params.get("next"));
+}
+
+async function showTwoFactorForm(enrollmentRequired) {
+    const loginForm = $("#loginForm");
+    const twoFactorForm = $("#twoFactorForm");
+    if (!twoFactorForm) return;
+
+    if (enrollmentRequired) {
+        const response = await api.post("/api/login/2fa/setup");
+        const setup = await response.json();
+        $("#two-factor-qr").innerHTML = setup.qr_code;
+        $("#two-factor-secret").textContent = setup.secret;
+        $("#two-factor-setup").classList.remove("hidden");
+        $("#toggle-recovery-code").classList.add("hidden");
+    }
+
+    loginForm.classList.add("hidden");
+    $("#sso-login")?.classList.add("hidden");
+    twoFactorForm.classList.remove("hidden");
+    twoFactorForm.code.focus();
+}
+
 export function initLoginForm() {
     createAuthFormHandler({
         formSelector: "#loginForm",
@@ -76,10 +101,96 @@ export function initLoginForm() {
             email: form.email.value,
             password: form.password.value,
         }),
-        onSuccess: () => {
-            const params = new URLSearchParams(window.location.search);
-            const nextUrl = validateNextUrl(params.get("next"));
-            window.location.href = nextUrl;
+        onSuccess: async (response) => {
+            const result = await response.json();
+            if (result.two_factor_required) {
+                await showTwoFactorForm(result.enrollment_required);
+                return;
+            }
+            redirectAfterLogin();
+        },
+    });
+}
+
+const ssoErrors = {
+    sso: "Single sign-on failed. Please try again.",
+    sso_conflict: "An account with this email already exists. Log in with your password to continue.",
+};
+
+// initSingleSignOn finishes logins that come back from the identity
+// provider, which redirects here with sso=1 once the session or pending
+// second factor has been set up.
+export async function initSingleSignOn() {
+    const params = new URLSearchParams(window.location.search);
+
+    const link = $("#sso-login-link");
+    if (link && params.get("next")) {
+        link.href = `/api/login/oidc?next=${encodeURIComponent(validateNextUrl(params.get("next")))}`;
+    }
+
+    const error = params.get("error");
+    if (error && ssoErrors[error]) {
+        showError(ssoErrors[error], $("#error-message"));
+        return;
+    }
+
+    if (params.get("sso") !== "1") return;
+
+    if (params.get("two_factor") === "1") {
+        try {
+            await showTwoFactorForm(params.get("enroll") === "1");
+        } catch (error) {
+            showError("Your sign-in has expired. Please try again.", $("#error-message"));
+        }
+        return;
+    }
+
+    redirectAfterLogin();
+}
+
+export function initTwoFactorForm() {
+    const form = $("#twoFactorForm");
+    if (!form) return;
+
+    const toggle = $("#toggle-recovery-code");
+    const codeGroup = $("#two-factor-code-group");
+    const recoveryGroup = $("#recovery-code-group");
+
+    toggle.addEventListener("click", (e) => {
+        e.preventDefault();
+        const useRecovery = recoveryGroup.classList.contains("hidden");
+        codeGroup.classList.toggle("hidden", useRecovery);
+        recoveryGroup.classList.toggle("hidden", !useRecovery);
+        toggle.textContent = useRecovery
+            ? "Use an authentication code instead"
+            : "Use a recovery code instead";
+    });
+
+    createAuthFormHandler({
+        formSelector: "#twoFactorForm",
+        endpoint: "/api/login/2fa",
+        errorContainer: "#two-factor-error",
+        defaultError: "Invalid authentication code",
+        getFormData: (form) =>
+            recoveryGroup.classList.contains("hidden")
+                ? { code: form.code.value.trim() }
+                : { recovery_code: form.recovery_code.value.trim() },
+        onSuccess: async (response) => {
+            const result = await response.json();
+            if (!result.recovery_codes) {
+                redirectAfterLogin();
+                return;
+            }
+
+            const list = $("#recovery-code-list");
+            for (const code of result.recovery_codes) {
+                const item = document.createElement("li");
+                item.textContent = code;
+                list.appendChild(item);
+            }
+            form.classList.add("hidden");
+            $("#recovery-codes").classList.remove("hidden");
+            $("#recovery-codes-continue").addEventListener("click", redirectAfterLogin);
         },
     });
 }
@@ -164,3 +275,65 @@ export function initResetPasswordForm() {
         },
     });
 }
+
+export async function initVerifyEmail() {
+    const statusEl = $("#verify-status");
+    const errorDiv = $("#error-message");
+    const successDiv = $("#success-message");
+    if (!statusEl) return;
+
+    const params = new URLSearchParams(window.location.search);
+    const token = params.get("token");
+    if (!token) {
+        statusEl.classList.add("hidden");
+        showError("Invalid verification link.", errorDiv);
+        return;
+    }
+
+    try {
+        await api.post("/api/email-verification/confirm", { token });
+        statusEl.classList.add("hidden");
+        successDiv.textContent = "Your email address has been verified. Redirecting...";
+        successDiv.classList.remove("hidden");
+        setTimeout(() => {
+            window.location.href = "/";
+        }, 2000);
+    } catch (error) {
+        statusEl.classList.add("hidden");
+        showError(
+            "This verification link is invalid or has expired. Please request a new one.",
+            errorDiv,
+        );
+    }
+}
+
+export async function initConfirmEmailChange() {
+    const statusEl = $("#verify-status");
+    const errorDiv = $("#error-message");
+    const successDiv = $("#success-message");
+    if (!statusEl) return;
+
+    const params = new URLSearchParams(window.location.search);
+    const token = params.get("token");
+    if (!token) {
+        statusEl.classList.add("hidden");
+        showError("Invalid confirmation link.", errorDiv);
+        return;
+    }
+
+    try {
+        await api.post("/api/email-change/confirm", { token });
+        statusEl.classList.add("hidden");
+        successDiv.textContent = "Your email address has been changed. Redirecting...";
+        successDiv.classList.remove("hidden");
+        setTimeout(() => {
+            window.location.href = "/profile";
+        }, 2000);
+    } catch (error) {
+        statusEl.classList.add("hidden");
+        showError(
+            "This confirmation link is invalid or has expired. Please request the change again.",
+            errorDiv,
+        );
+    }
+}
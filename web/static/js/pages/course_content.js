@@ -1,6 +1,10 @@
 import api from "../core/api.js";
 import { $, on } from "../core/dom.js";
-import { confirmAction } from "../core/utils.js";
+import {
+    bindUnlockRuleInputs,
+    confirmAction,
+    readUnlockRule,
+} from "../core/utils.js";
 
 document.addEventListener("DOMContentLoaded", () => {
     const pathMatch = window.location.pathname.match(
@@ -23,6 +27,8 @@ document.addEventListener("DOMContentLoaded", () => {
     const newModuleForm = $("#new-module-form");
 
     if (addModuleBtn && newModuleForm) {
+        bindUnlockRuleInputs(newModuleForm);
+
         on(addModuleBtn, "click", () => {
             addModuleBtn.style.display = "none";
             newModuleForm.style.display = "block";
@@ -51,6 +57,7 @@ document.addEventListener("DOMContentLoaded", () => {
                         title: title,
                         description: descInput.value.trim(),
                         order: order,
+                        ...readUnlockRule(newModuleForm),
                     });
                     window.location.reload();
                 } catch (error) {
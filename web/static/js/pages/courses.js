@@ -1,9 +1,65 @@
 import SearchFilter from "../components/SearchFilter.js";
 
 document.addEventListener("DOMContentLoaded", () => {
-    new SearchFilter("#course-search", "#course-grid", {
+    const filter = new SearchFilter("#course-search", "#course-grid", {
         searchFields: ["title", "summary"],
         itemSelector: ".course-card",
         noResultsSelector: "#no-results",
     });
+
+    const input = document.getElementById("course-search");
+    const fullSearchLink = document.getElementById("full-search-link");
+    if (input) {
+        input.addEventListener("input", () => {
+            if (fullSearchLink) {
+                fullSearchLink.href = `/search?q=${encodeURIComponent(input.value.trim())}`;
+            }
+        });
+        input.addEventListener("keydown", (e) => {
+            if (e.key === "Enter" && input.value.trim()) {
+                window.location.href = `/search?q=${encodeURIComponent(input.value.trim())}`;
+            }
+        });
+    }
+
+    const filters = document.getElementById("catalog-filters");
+    if (filters) {
+        filters.addEventListener("change", () => filters.submit());
+    }
+
+    const loadMore = document.getElementById("load-more");
+    const grid = document.getElementById("course-grid");
+    if (loadMore && grid) {
+        loadMore.addEventListener("click", async (e) => {
+            e.preventDefault();
+            if (loadMore.getAttribute("aria-disabled") === "true") return;
+            loadMore.setAttribute("aria-disabled", "true");
+
+            try {
+                const response = await fetch(loadMore.href);
+                if (!response.ok) {
+                    throw new Error("Request failed");
+                }
+                const doc = new DOMParser().parseFromString(
+                    await response.text(),
+                    "text/html",
+                );
+
+                doc.querySelectorAll("#course-grid .course-card").forEach(
+                    (card) => grid.appendChild(document.importNode(card, true)),
+                );
+                filter.performSearch();
+
+                const next = doc.getElementById("load-more");
+                if (next) {
+                    loadMore.href = next.getAttribute("href");
+                    loadMore.removeAttribute("aria-disabled");
+                } else {
+                    loadMore.parentElement.remove();
+                }
+            } catch {
+                window.location.href = loadMore.href;
+            }
+        });
+    }
 });
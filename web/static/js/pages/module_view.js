@@ -1,6 +1,7 @@
 import api from "../core/api.js";
 import { $, on } from "../core/dom.js";
-import { showErrorToast } from "../components/Toast.js";
+import { showErrorToast, showSuccessToast } from "../components/Toast.js";
+import { bindUnlockRuleInputs, readUnlockRule } from "../core/utils.js";
 
 document.addEventListener("DOMContentLoaded", () => {
     const pathMatch = window.location.pathname.match(
@@ -28,19 +29,43 @@ document.addEventListener("DOMContentLoaded", () => {
         });
     }
 
+    const availability = $(".module-availability");
+    if (availability) {
+        bindUnlockRuleInputs(availability);
+
+        const saveBtn = availability.querySelector(".module-availability-save-btn");
+        on(saveBtn, "click", async () => {
+            saveBtn.disabled = true;
+            try {
+                await api.patch(`/api/courses/${courseId}/modules/${moduleId}`, {
+                    title: availability.dataset.title,
+                    description: availability.dataset.description,
+                    order: Number(availability.dataset.order) || 0,
+                    ...readUnlockRule(availability),
+                });
+                showSuccessToast("Availability updated");
+            } catch (err) {
+                showErrorToast(err.message || "Failed to update availability");
+            } finally {
+                saveBtn.disabled = false;
+            }
+        });
+    }
+
     document.addEventListener("click", async (e) => {
         const pub = e.target.closest(".module-card-publish-btn");
         const unpub = e.target.closest(".module-card-unpublish-btn");
+        const complete = e.target.closest(".module-card-complete-btn");
         const card = e.target.closest(".module-reading-card");
         if (!card) return;
         const cid = card.dataset.courseId;
         const mid = card.dataset.moduleId;
-        const rid = card.dataset.readingId || card.dataset.fileId;
-        const contentType = pub?.dataset.type || unpub?.dataset.type || "reading";
+        const rid = card.dataset.readingId || card.dataset.fileId || card.dataset.quizId || card.dataset.assignmentId;
+        const contentType = pub?.dataset.type || unpub?.dataset.type || complete?.dataset.type || "reading";
         if (!cid || !mid || !rid) return;
 
         const base = `/api/courses/${cid}/modules/${mid}/content/${rid}`;
-        const typeParam = contentType === "file" ? "?type=file" : "";
+        const typeParam = contentType !== "reading" ? `?type=${contentType}` : "";
         if (pub) {
             e.preventDefault();
             e.stopPropagation();
@@ -61,6 +86,21 @@ document.addEventListener("DOMContentLoaded", () => {
             } catch (err) {
                 showErrorToast(err.message || "Failed to unpublish");
             }
+            return;
+        }
+        if (complete) {
+            e.preventDefault();
+            e.stopPropagation();
+            try {
+                if (complete.classList.contains("is-complete")) {
+                    await api.delete(`${base}/actions/complete${typeParam}`);
+                } else {
+                    await api.post(`${base}/actions/complete${typeParam}`);
+                }
+                window.location.reload();
+            } catch (err) {
+                showErrorToast(err.message || "Failed to update progress");
+            }
         }
     });
 });
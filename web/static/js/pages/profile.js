@@ -1,6 +1,6 @@
 import api from "../core/api.js";
 import { $ } from "../core/dom.js";
-import { showError, hideError } from "../core/utils.js";
+import { showError, hideError, formatDate } from "../core/utils.js";
 
 document.addEventListener("DOMContentLoaded", () => {
     const form = $("#profile-form");
@@ -64,4 +64,397 @@ document.addEventListener("DOMContentLoaded", () => {
             saveBtn.textContent = "Save Changes";
         }
     });
+
+    initEmailVerification();
+    initChangePassword();
+    initChangeEmail();
+    initTwoFactor();
+    initAPITokens();
+    initSessions();
+    initRolePolicy();
 });
+
+function initEmailVerification() {
+    const resendBtn = $("#resend-verification-btn");
+    if (!resendBtn) return;
+
+    resendBtn.addEventListener("click", async () => {
+        resendBtn.disabled = true;
+        try {
+            await api.post("/api/me/email-verification");
+            resendBtn.textContent = "Email Sent";
+        } catch (error) {
+            resendBtn.disabled = false;
+            showError(error.message || "Failed to send verification email", $("#profile-status"));
+        }
+    });
+}
+
+function showRecoveryCodes(codes) {
+    const list = $("#recovery-code-list");
+    list.innerHTML = "";
+    for (const code of codes) {
+        const item = document.createElement("li");
+        item.textContent = code;
+        list.appendChild(item);
+    }
+    $("#recovery-codes").classList.remove("hidden");
+}
+
+function initTwoFactor() {
+    const section = $("#two-factor-section");
+    if (!section) return;
+
+    const statusDiv = $("#two-factor-status");
+    const codeInput = $("#two-factor-code");
+
+    const enableBtn = $("#enable-two-factor-btn");
+    const confirmBtn = $("#confirm-two-factor-btn");
+    const regenerateBtn = $("#regenerate-recovery-codes-btn");
+    const disableBtn = $("#disable-two-factor-btn");
+
+    if (enableBtn) {
+        enableBtn.addEventListener("click", async () => {
+            hideError(statusDiv);
+            enableBtn.disabled = true;
+            try {
+                const response = await api.post("/api/me/2fa/setup");
+                const setup = await response.json();
+                $("#two-factor-qr").innerHTML = setup.qr_code;
+                $("#two-factor-secret").textContent = setup.secret;
+                $("#two-factor-setup").classList.remove("hidden");
+                enableBtn.classList.add("hidden");
+                confirmBtn.classList.remove("hidden");
+                codeInput.focus();
+            } catch (error) {
+                showError(error.message || "Failed to start setup", statusDiv);
+            } finally {
+                enableBtn.disabled = false;
+            }
+        });
+    }
+
+    if (confirmBtn) {
+        confirmBtn.addEventListener("click", async () => {
+            hideError(statusDiv);
+            confirmBtn.disabled = true;
+            try {
+                const response = await api.post("/api/me/2fa/confirm", {
+                    code: codeInput.value.trim(),
+                });
+                const result = await response.json();
+                $("#two-factor-setup").classList.add("hidden");
+                confirmBtn.classList.add("hidden");
+                showRecoveryCodes(result.recovery_codes);
+            } catch (error) {
+                showError(error.message || "Invalid authentication code", statusDiv);
+            } finally {
+                confirmBtn.disabled = false;
+            }
+        });
+    }
+
+    if (regenerateBtn) {
+        regenerateBtn.addEventListener("click", async () => {
+            hideError(statusDiv);
+            regenerateBtn.disabled = true;
+            try {
+                const response = await api.post("/api/me/2fa/recovery-codes", {
+                    code: codeInput.value.trim(),
+                });
+                const result = await response.json();
+                codeInput.value = "";
+                showRecoveryCodes(result.recovery_codes);
+            } catch (error) {
+                showError(error.message || "Invalid authentication code", statusDiv);
+            } finally {
+                regenerateBtn.disabled = false;
+            }
+        });
+    }
+
+    if (disableBtn) {
+        disableBtn.addEventListener("click", async () => {
+            hideError(statusDiv);
+            disableBtn.disabled = true;
+            try {
+                await api.delete("/api/me/2fa", {
+                    code: codeInput.value.trim(),
+                });
+                window.location.reload();
+            } catch (error) {
+                showError(error.message || "Failed to disable two-factor authentication", statusDiv);
+            } finally {
+                disableBtn.disabled = false;
+            }
+        });
+    }
+}
+
+function showSuccess(message, element) {
+    element.classList.replace("error-message", "success-message");
+    element.textContent = message;
+    element.classList.remove("hidden");
+}
+
+function resetStatus(element) {
+    element.classList.replace("success-message", "error-message");
+    hideError(element);
+}
+
+function initChangePassword() {
+    const form = $("#password-form");
+    if (!form) return;
+
+    const submitBtn = $("#change-password-btn");
+    const statusDiv = $("#password-status");
+
+    form.addEventListener("submit", async (e) => {
+        e.preventDefault();
+        resetStatus(statusDiv);
+
+        const newPassword = $("#new-password").value;
+        if (newPassword !== $("#confirm-password").value) {
+            showError("Passwords do not match", statusDiv);
+            return;
+        }
+
+        submitBtn.disabled = true;
+        try {
+            await api.patch("/api/me/password", {
+                current_password: $("#current-password").value,
+                new_password: newPassword,
+            });
+            form.reset();
+            showSuccess("Password changed. Other devices have been logged out.", statusDiv);
+        } catch (error) {
+            showError(error.message || "Failed to change password", statusDiv);
+        } finally {
+            submitBtn.disabled = false;
+        }
+    });
+}
+
+function initChangeEmail() {
+    const form = $("#email-change-form");
+    if (!form) return;
+
+    const submitBtn = $("#change-email-btn");
+    const statusDiv = $("#email-change-status");
+
+    form.addEventListener("submit", async (e) => {
+        e.preventDefault();
+        resetStatus(statusDiv);
+
+        const newEmail = $("#new-email").value.trim();
+        submitBtn.disabled = true;
+        try {
+            await api.post("/api/me/email", {
+                new_email: newEmail,
+                current_password: $("#email-change-password").value,
+            });
+            form.reset();
+            showSuccess(`Check ${newEmail} for a link to confirm the change.`, statusDiv);
+        } catch (error) {
+            showError(error.message || "Failed to request email change", statusDiv);
+        } finally {
+            submitBtn.disabled = false;
+        }
+    });
+}
+
+function renderAPITokens(tokens, onRevoke) {
+    const list = $("#api-token-list");
+    list.innerHTML = "";
+    for (const token of tokens) {
+        const item = document.createElement("li");
+
+        const info = document.createElement("div");
+        const name = document.createElement("strong");
+        name.textContent = token.name;
+        const details = document.createElement("span");
+        const lastUsed = token.last_used_at ? formatDate(token.last_used_at) : "never";
+        details.textContent = `${token.prefix}… · ${token.scopes.join(", ")} · expires ${formatDate(token.expires_at)} · last used ${lastUsed}`;
+        info.append(name, details);
+
+        const revokeBtn = document.createElement("button");
+        revokeBtn.type = "button";
+        revokeBtn.className = "btn btn-danger";
+        revokeBtn.textContent = "Revoke";
+        revokeBtn.addEventListener("click", () => onRevoke(token, revokeBtn));
+
+        item.append(info, revokeBtn);
+        list.appendChild(item);
+    }
+}
+
+async function initAPITokens() {
+    const section = $("#api-tokens-section");
+    if (!section) return;
+
+    const form = $("#api-token-form");
+    const createBtn = $("#create-api-token-btn");
+    const statusDiv = $("#api-token-status");
+
+    const load = async () => {
+        try {
+            const response = await api.get("/api/me/tokens");
+            renderAPITokens(await response.json(), revoke);
+        } catch (error) {
+            showError(error.message || "Failed to load API tokens", statusDiv);
+        }
+    };
+
+    const revoke = async (token, button) => {
+        if (!confirm(`Revoke the token "${token.name}"? Scripts using it will stop working.`)) return;
+        hideError(statusDiv);
+        button.disabled = true;
+        try {
+            await api.delete(`/api/me/tokens/${token.id}`);
+            await load();
+        } catch (error) {
+            button.disabled = false;
+            showError(error.message || "Failed to revoke token", statusDiv);
+        }
+    };
+
+    form.addEventListener("submit", async (e) => {
+        e.preventDefault();
+        hideError(statusDiv);
+
+        const scopes = [...section.querySelectorAll('input[name="scope"]:checked')].map((input) => input.value);
+        if (scopes.length === 0) {
+            showError("Select at least one scope", statusDiv);
+            return;
+        }
+
+        createBtn.disabled = true;
+        try {
+            const response = await api.post("/api/me/tokens", {
+                name: $("#api-token-name").value.trim(),
+                scopes,
+                expires_in_days: parseInt($("#api-token-expiry").value, 10) || 0,
+            });
+            const token = await response.json();
+            $("#api-token-secret").textContent = token.secret;
+            $("#api-token-created").classList.remove("hidden");
+            form.reset();
+            await load();
+        } catch (error) {
+            showError(error.message || "Failed to create token", statusDiv);
+        } finally {
+            createBtn.disabled = false;
+        }
+    });
+
+    await load();
+}
+
+function renderSessions(sessions, onRevoke) {
+    const list = $("#session-list");
+    list.innerHTML = "";
+    for (const session of sessions) {
+        const item = document.createElement("li");
+
+        const info = document.createElement("div");
+        const device = document.createElement("strong");
+        device.textContent = session.user_agent || "Unknown device";
+        if (session.current) {
+            const badge = document.createElement("span");
+            badge.className = "session-current";
+            badge.textContent = "This device";
+            device.append(" ", badge);
+        }
+        const details = document.createElement("span");
+        details.textContent = `${session.ip || "unknown IP"} · signed in ${formatDate(session.created_at)} · last active ${formatDate(session.last_seen_at)}`;
+        info.append(device, details);
+        item.appendChild(info);
+
+        if (!session.current) {
+            const revokeBtn = document.createElement("button");
+            revokeBtn.type = "button";
+            revokeBtn.className = "btn btn-danger";
+            revokeBtn.textContent = "Revoke";
+            revokeBtn.addEventListener("click", () => onRevoke(session, revokeBtn));
+            item.appendChild(revokeBtn);
+        }
+
+        list.appendChild(item);
+    }
+}
+
+async function initSessions() {
+    const section = $("#sessions-section");
+    if (!section) return;
+
+    const revokeOthersBtn = $("#revoke-other-sessions-btn");
+    const statusDiv = $("#session-status");
+
+    const load = async () => {
+        try {
+            const response = await api.get("/api/me/sessions");
+            const sessions = await response.json();
+            renderSessions(sessions, revoke);
+            revokeOthersBtn.disabled = sessions.every((session) => session.current);
+        } catch (error) {
+            showError(error.message || "Failed to load sessions", statusDiv);
+        }
+    };
+
+    const revoke = async (session, button) => {
+        if (!confirm("Log out this device?")) return;
+        hideError(statusDiv);
+        button.disabled = true;
+        try {
+            await api.delete(`/api/me/sessions/${session.id}`);
+            await load();
+        } catch (error) {
+            button.disabled = false;
+            showError(error.message || "Failed to revoke session", statusDiv);
+        }
+    };
+
+    revokeOthersBtn.addEventListener("click", async () => {
+        if (!confirm("Log out of every other device?")) return;
+        hideError(statusDiv);
+        revokeOthersBtn.disabled = true;
+        try {
+            await api.post("/api/me/sessions/actions/revoke-others");
+            await load();
+        } catch (error) {
+            revokeOthersBtn.disabled = false;
+            showError(error.message || "Failed to log out other sessions", statusDiv);
+        }
+    });
+
+    await load();
+}
+
+async function initRolePolicy() {
+    const checkbox = $("#admin-require-two-factor");
+    if (!checkbox) return;
+
+    const statusDiv = $("#role-policy-status");
+    const path = "/api/admin/roles/admin/policy";
+
+    try {
+        const response = await api.get(path);
+        const policy = await response.json();
+        checkbox.checked = policy.require_two_factor;
+    } catch (error) {
+        showError(error.message || "Failed to load policy", statusDiv);
+    }
+
+    checkbox.addEventListener("change", async () => {
+        hideError(statusDiv);
+        checkbox.disabled = true;
+        try {
+            await api.put(path, { require_two_factor: checkbox.checked });
+        } catch (error) {
+            checkbox.checked = !checkbox.checked;
+            showError(error.message || "Failed to update policy", statusDiv);
+        } finally {
+            checkbox.disabled = false;
+        }
+    });
+}
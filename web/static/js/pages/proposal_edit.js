@@ -1,8 +1,8 @@
-import api from "../core/api.js";
+import api, { getCSRFToken } from "../core/api.js";
 import FormHandler from "../components/FormHandler.js";
 import HelpTooltip from "../components/HelpTooltip.js";
 import { $ } from "../core/dom.js";
-import { showError, hideError, confirmAction } from "../core/utils.js";
+import { showError, hideError, confirmAction, escapeHtml, extractErrorMessage } from "../core/utils.js";
 
 document.addEventListener("DOMContentLoaded", () => {
     const form = $("#proposal-form");
@@ -26,6 +26,28 @@ document.addEventListener("DOMContentLoaded", () => {
         "assumed_prerequisites",
     ];
 
+    const outlineContainer = $("#outline-modules");
+    const outlineTemplate = $("#outline-module-template");
+    const addOutlineModuleBtn = $("#add-outline-module");
+
+    function readOutlineModules() {
+        return Array.from(
+            outlineContainer.querySelectorAll(".outline-module"),
+        ).map((card) => ({
+            title: card.querySelector(".outline-module-title").value.trim(),
+            description: card
+                .querySelector(".outline-module-description")
+                .value.trim(),
+            items: card
+                .querySelector(".outline-module-items")
+                .value.split("\n")
+                .map((item) => item.trim())
+                .filter((item) => item !== ""),
+        })).filter(
+            (m) => m.title !== "" || m.description !== "" || m.items.length > 0,
+        );
+    }
+
     form.addEventListener("submit", (e) => e.preventDefault());
 
     const handler = new FormHandler("#proposal-form", {
@@ -33,6 +55,7 @@ document.addEventListener("DOMContentLoaded", () => {
         entityId: isNewProposal ? null : proposalId,
         autosaveDelay: saveDelay,
         fieldIds: fieldIds,
+        readExtraFields: () => ({ outline_modules: readOutlineModules() }),
         errorContainer: "#error-message",
         statusContainer: "#save-status",
         onEntityCreated: (id) => {
@@ -40,6 +63,126 @@ document.addEventListener("DOMContentLoaded", () => {
         },
     });
 
+    outlineContainer.addEventListener("input", handler.scheduleSave);
+
+    outlineContainer.addEventListener("click", (e) => {
+        const button = e.target.closest("[data-outline-action]");
+        if (!button) return;
+
+        const card = button.closest(".outline-module");
+        switch (button.dataset.outlineAction) {
+            case "up":
+                if (card.previousElementSibling) {
+                    card.previousElementSibling.before(card);
+                }
+                break;
+            case "down":
+                if (card.nextElementSibling) {
+                    card.nextElementSibling.after(card);
+                }
+                break;
+            case "remove":
+                card.remove();
+                break;
+        }
+        handler.scheduleSave();
+    });
+
+    addOutlineModuleBtn.addEventListener("click", () => {
+        const card = outlineTemplate.content.firstElementChild.cloneNode(true);
+        outlineContainer.appendChild(card);
+        card.querySelector(".outline-module-title").focus();
+    });
+
+    const attachmentList = $("#proposal-attachments");
+    const attachmentInput = $("#attachment-file");
+
+    function formatFileSize(bytes) {
+        if (bytes < 1024) return bytes + " B";
+        if (bytes < 1024 * 1024) return (bytes / 1024).toFixed(1) + " KB";
+        return (bytes / (1024 * 1024)).toFixed(1) + " MB";
+    }
+
+    function renderAttachments(id, attachments) {
+        attachmentList.innerHTML = attachments
+            .map(
+                (a) => `
+                <li data-attachment-id="${a.id}">
+                    <a href="/api/proposals/${id}/attachments/${a.id}">${escapeHtml(a.file_name)}</a>
+                    <span class="proposal-attachment-size">${formatFileSize(a.file_size)}</span>
+                    <button type="button" class="btn btn-sm btn-danger" data-attachment-action="remove">Remove</button>
+                </li>`,
+            )
+            .join("");
+    }
+
+    async function loadAttachments(id) {
+        const response = await api.get(`/api/proposals/${id}/attachments`);
+        if (!response) return;
+        renderAttachments(id, await response.json());
+    }
+
+    if (!isNewProposal) {
+        loadAttachments(proposalId).catch(() => {
+            showError("Failed to load attachments", errorDiv);
+        });
+    }
+
+    attachmentInput.addEventListener("change", async () => {
+        const file = attachmentInput.files[0];
+        if (!file) return;
+
+        const id = await handler.ensureCreated();
+        if (!id) {
+            showError("Failed to create proposal", errorDiv);
+            return;
+        }
+
+        hideError(errorDiv);
+        attachmentInput.disabled = true;
+
+        const formData = new FormData();
+        formData.append("file", file);
+
+        try {
+            const response = await fetch(`/api/proposals/${id}/attachments`, {
+                method: "POST",
+                headers: { "X-CSRF-Token": getCSRFToken() },
+                body: formData,
+                credentials: "include",
+            });
+            if (!response.ok) {
+                throw new Error(await extractErrorMessage(response));
+            }
+            await loadAttachments(id);
+        } catch (e) {
+            showError(e.message || "Upload failed", errorDiv);
+        } finally {
+            attachmentInput.value = "";
+            attachmentInput.disabled = false;
+        }
+    });
+
+    attachmentList.addEventListener("click", async (e) => {
+        const button = e.target.closest("[data-attachment-action='remove']");
+        if (!button) return;
+
+        const item = button.closest("[data-attachment-id]");
+        const confirmed = await confirmAction("This file will be removed from your proposal.", {
+            title: "Remove Attachment?",
+            confirmText: "Remove",
+        });
+        if (!confirmed) return;
+
+        const id = handler.getEntityId();
+        try {
+            await api.delete(`/api/proposals/${id}/attachments/${item.dataset.attachmentId}`);
+            item.remove();
+        } catch (err) {
+            showError(err.message || "Remove failed", errorDiv);
+        }
+    });
+
     async function submit() {
         const id = await handler.ensureCreated();
         if (!id) {
@@ -0,0 +1,126 @@
+import api from "../core/api.js";
+import { $ } from "../core/dom.js";
+import { showError, hideError, formatDate } from "../core/utils.js";
+
+const FIELD_LABELS = {
+    title: "Title",
+    summary: "Summary",
+    qualifications: "Qualifications",
+    target_audience: "Target Audience",
+    learning_objectives: "Learning Objectives",
+    outline: "Course Outline",
+    outline_modules: "Course Modules",
+    assumed_prerequisites: "Prerequisites",
+};
+
+document.addEventListener("DOMContentLoaded", () => {
+    const container = $("#proposal-versions");
+    if (!container) return;
+
+    const proposalId = Number(container.dataset.proposalId);
+    const form = $("#version-compare");
+    const fromSelect = $("#version-from");
+    const toSelect = $("#version-to");
+    const diffContainer = $("#version-diff");
+    const errorDiv = $("#versions-error");
+
+    function renderDiff(text) {
+        const pre = document.createElement("pre");
+        pre.className = "proposal-diff";
+        for (const line of text.split("\n")) {
+            if (line === "" || line.startsWith("---") || line.startsWith("+++")) {
+                continue;
+            }
+            const span = document.createElement("span");
+            if (line.startsWith("@@")) {
+                span.className = "proposal-diff-hunk";
+            } else if (line.startsWith("+")) {
+                span.className = "proposal-diff-insert";
+            } else if (line.startsWith("-")) {
+                span.className = "proposal-diff-delete";
+            }
+            span.textContent = line + "\n";
+            pre.appendChild(span);
+        }
+        return pre;
+    }
+
+    function renderField(field) {
+        const section = document.createElement("section");
+        section.className = "proposal-version-field";
+
+        const heading = document.createElement("h3");
+        heading.textContent = FIELD_LABELS[field.field] || field.field;
+        section.appendChild(heading);
+
+        if (field.changed) {
+            section.appendChild(renderDiff(field.diff));
+        } else {
+            const unchanged = document.createElement("p");
+            unchanged.className = "proposal-version-unchanged";
+            unchanged.textContent = "Unchanged";
+            section.appendChild(unchanged);
+        }
+        return section;
+    }
+
+    async function compare() {
+        hideError(errorDiv);
+        const from = fromSelect.value;
+        const to = toSelect.value;
+
+        try {
+            const response = await api.get(
+                `/api/proposals/${proposalId}/versions/diff?from=${from}&to=${to}`,
+            );
+            const result = await response.json();
+            diffContainer.innerHTML = "";
+            const fields = result.fields
+                .slice()
+                .sort((a, b) => Number(b.changed) - Number(a.changed));
+            for (const field of fields) {
+                diffContainer.appendChild(renderField(field));
+            }
+        } catch (error) {
+            showError(error.message || "Failed to compare versions", errorDiv);
+        }
+    }
+
+    async function loadVersions() {
+        try {
+            const response = await api.get(`/api/proposals/${proposalId}/versions`);
+            const versions = await response.json();
+
+            if (versions.length < 2) {
+                form.classList.add("hidden");
+                diffContainer.innerHTML =
+                    '<div class="empty-state"><p>This proposal has only been submitted once, so there is nothing to compare yet.</p></div>';
+                return;
+            }
+
+            for (const select of [fromSelect, toSelect]) {
+                select.innerHTML = "";
+                for (const version of versions) {
+                    const option = document.createElement("option");
+                    option.value = version.number;
+                    option.textContent = `Version ${version.number} (${formatDate(version.submitted_at)})`;
+                    select.appendChild(option);
+                }
+            }
+            toSelect.value = versions[0].number;
+            fromSelect.value = versions[1].number;
+
+            await compare();
+        } catch (error) {
+            diffContainer.innerHTML = "";
+            showError(error.message || "Failed to load versions", errorDiv);
+        }
+    }
+
+    form.addEventListener("submit", (e) => {
+        e.preventDefault();
+        compare();
+    });
+
+    loadVersions();
+});
@@ -1,6 +1,6 @@
 import api from "../core/api.js";
 import { $ } from "../core/dom.js";
-import { showError, hideError, confirmAction, deleteProposal } from "../core/utils.js";
+import { showError, hideError, confirmAction, deleteProposal, formatDate } from "../core/utils.js";
 
 document.addEventListener("DOMContentLoaded", () => {
     const proposalIdElement = document.querySelector("[data-proposal-id]");
@@ -19,6 +19,7 @@ document.addEventListener("DOMContentLoaded", () => {
     const withdrawBtn = $("#withdrawBtn");
     const deleteBtn = $("#deleteBtn");
     const createCourseBtn = $("#createCourseBtn");
+    const copyAttachments = $("#copyAttachments");
     const errorDiv = $("#error-message");
 
     if (submitBtn) {
@@ -122,10 +123,14 @@ document.addEventListener("DOMContentLoaded", () => {
 
             hideError(errorDiv);
             createCourseBtn.disabled = true;
+            const body = {
+                copy_attachments: copyAttachments ? copyAttachments.checked : false,
+            };
 
             try {
                 const response = await api.post(
                     `/api/proposals/${proposalId}/actions/create-course`,
+                    body,
                 );
                 if (response) {
                     const course = await response.json();
@@ -136,6 +141,7 @@ document.addEventListener("DOMContentLoaded", () => {
                     try {
                         const response = await api.post(
                             `/api/proposals/${proposalId}/actions/create-course`,
+                            body,
                         );
                         if (response && response.status === 409) {
                             const data = await response.json();
@@ -174,9 +180,9 @@ document.addEventListener("DOMContentLoaded", () => {
         switch (action) {
             case "approve":
                 confirmed = await confirmAction(
-                    "This proposal will be approved and the instructor will be able to create a course from it.",
+                    "Your vote to approve will be recorded. The proposal is approved once enough reviewers agree.",
                     {
-                        title: "Approve Proposal?",
+                        title: "Vote to Approve?",
                         confirmText: "Approve",
                         confirmButtonClass: "btn-success",
                         variant: "info",
@@ -185,7 +191,7 @@ document.addEventListener("DOMContentLoaded", () => {
                 break;
             case "request-changes":
                 confirmed = await confirmAction(
-                    "The instructor will be notified and can make changes before resubmitting.",
+                    "Your vote to request changes will be recorded. The instructor is notified once enough reviewers have voted.",
                     {
                         title: "Request Changes?",
                         confirmText: "Request Changes",
@@ -196,9 +202,9 @@ document.addEventListener("DOMContentLoaded", () => {
                 break;
             case "reject":
                 confirmed = await confirmAction(
-                    "This proposal will be rejected. The instructor will be notified of the rejection.",
+                    "Your vote to reject will be recorded. The proposal is rejected once enough reviewers agree.",
                     {
-                        title: "Reject Proposal?",
+                        title: "Vote to Reject?",
                         confirmText: "Reject",
                         confirmButtonClass: "btn-danger",
                         variant: "danger",
@@ -254,4 +260,263 @@ document.addEventListener("DOMContentLoaded", () => {
     if (rejectBtn) {
         rejectBtn.addEventListener("click", () => handleReviewAction("reject"));
     }
+    const commentList = $("#proposal-comments");
+    const commentForm = $("#comment-form");
+    const commentBody = $("#comment-body");
+    const commentErrorDiv = $("#comment-error");
+
+    const STATUS_LABELS = {
+        approved: "Approved",
+        rejected: "Rejected",
+        changes_requested: "Requested changes",
+    };
+
+    const VOTE_LABELS = {
+        approve: "Voted to approve",
+        reject: "Voted to reject",
+        request_changes: "Voted for changes",
+    };
+
+    function renderComment(comment) {
+        const item = document.createElement("li");
+        item.className = `proposal-timeline-item proposal-timeline-${comment.kind}`;
+
+        const meta = document.createElement("div");
+        meta.className = "proposal-timeline-meta";
+        const author = document.createElement("strong");
+        author.textContent = comment.author_name || "Deleted user";
+        meta.appendChild(author);
+        if (comment.kind === "review" && comment.vote) {
+            const badge = document.createElement("span");
+            badge.className = `status-badge status-vote-${comment.vote}`;
+            badge.textContent = VOTE_LABELS[comment.vote] || comment.vote;
+            meta.appendChild(badge);
+        }
+        if (comment.kind === "review" && comment.status !== "submitted") {
+            const badge = document.createElement("span");
+            badge.className = `status-badge status-${comment.status}`;
+            badge.textContent = STATUS_LABELS[comment.status] || comment.status;
+            meta.appendChild(badge);
+        }
+        const date = document.createElement("span");
+        date.textContent = formatDate(comment.created_at);
+        meta.appendChild(date);
+        item.appendChild(meta);
+
+        if (comment.body) {
+            const body = document.createElement("p");
+            body.className = "proposal-timeline-body";
+            body.textContent = comment.body;
+            item.appendChild(body);
+        }
+        return item;
+    }
+
+    async function loadComments() {
+        try {
+            const response = await api.get(`/api/proposals/${proposalId}/comments`);
+            const comments = await response.json();
+            commentList.innerHTML = "";
+            if (comments.length === 0) {
+                commentList.innerHTML = '<li class="empty-state">No reviews or replies yet.</li>';
+                return;
+            }
+            for (const comment of comments) {
+                commentList.appendChild(renderComment(comment));
+            }
+        } catch (error) {
+            showError(error.message || "Failed to load review history", commentErrorDiv);
+        }
+    }
+
+    if (commentList) {
+        commentForm.addEventListener("submit", async (e) => {
+            e.preventDefault();
+            const body = commentBody.value.trim();
+            if (!body) return;
+
+            hideError(commentErrorDiv);
+            const submitButton = commentForm.querySelector("button[type=submit]");
+            submitButton.disabled = true;
+
+            try {
+                await api.post(`/api/proposals/${proposalId}/comments`, { body });
+                commentBody.value = "";
+                await loadComments();
+            } catch (error) {
+                showError(error.message || "Failed to post reply", commentErrorDiv);
+            } finally {
+                submitButton.disabled = false;
+            }
+        });
+
+        loadComments();
+    }
+
+    const reviewersCard = $("#proposal-reviewers-card");
+    const reviewerList = $("#proposal-reviewers");
+    const reviewersQuorum = $("#reviewers-quorum");
+    const reviewersErrorDiv = $("#reviewers-error");
+    const reviewerControls = $("#reviewer-controls");
+    const reviewerSelect = $("#reviewer-select");
+    const assignReviewerBtn = $("#assignReviewerBtn");
+    const autoAssignBtn = $("#autoAssignBtn");
+    const canManageReviewers =
+        reviewersCard && reviewersCard.dataset.canManage === "true";
+
+    function renderReviewer(reviewer) {
+        const item = document.createElement("li");
+
+        const info = document.createElement("div");
+        const name = document.createElement("strong");
+        name.textContent = reviewer.name || reviewer.email || "Deleted user";
+        info.appendChild(name);
+        const detail = document.createElement("span");
+        detail.textContent = reviewer.voted_at
+            ? `Voted ${formatDate(reviewer.voted_at)}`
+            : `Waiting since ${formatDate(reviewer.pending_since)}`;
+        info.appendChild(detail);
+        item.appendChild(info);
+
+        const actions = document.createElement("div");
+        actions.className = "proposal-reviewer-vote";
+        const badge = document.createElement("span");
+        const vote = reviewer.vote || "pending";
+        badge.className = `status-badge status-vote-${vote}`;
+        badge.textContent = VOTE_LABELS[vote] || "Pending";
+        actions.appendChild(badge);
+        if (canManageReviewers) {
+            const removeBtn = document.createElement("button");
+            removeBtn.type = "button";
+            removeBtn.className = "btn btn-secondary";
+            removeBtn.textContent = "Remove";
+            removeBtn.addEventListener("click", () => unassignReviewer(reviewer));
+            actions.appendChild(removeBtn);
+        }
+        item.appendChild(actions);
+        return item;
+    }
+
+    async function loadReviewers() {
+        try {
+            const response = await api.get(`/api/proposals/${proposalId}/reviewers`);
+            const data = await response.json();
+            const voted = data.reviewers.filter((r) => r.vote).length;
+            reviewersQuorum.textContent =
+                `${voted} of ${data.reviewers.length} assigned reviewers have voted. ` +
+                `${data.votes_required} ${data.votes_required === 1 ? "vote decides" : "votes decide"} the proposal.`;
+
+            reviewerList.innerHTML = "";
+            if (data.reviewers.length === 0) {
+                reviewerList.innerHTML = '<li class="empty-state">No reviewers assigned yet.</li>';
+            }
+            for (const reviewer of data.reviewers) {
+                reviewerList.appendChild(renderReviewer(reviewer));
+            }
+            return data.reviewers;
+        } catch (error) {
+            showError(error.message || "Failed to load reviewers", reviewersErrorDiv);
+            return [];
+        }
+    }
+
+    async function loadReviewerOptions(assigned) {
+        const assignedIds = new Set(assigned.map((r) => r.reviewer_id));
+        const authorId = Number(reviewersCard.dataset.authorId);
+        const candidates = [];
+        for (const role of ["reviewer", "admin"]) {
+            const response = await api.get(`/api/admin/users?role=${role}&limit=100`);
+            const page = await response.json();
+            candidates.push(...page.users);
+        }
+
+        reviewerSelect.innerHTML = '<option value="">Choose a reviewer...</option>';
+        for (const user of candidates) {
+            if (assignedIds.has(user.id) || user.id === authorId || user.suspended_at) {
+                continue;
+            }
+            const option = document.createElement("option");
+            option.value = user.id;
+            option.textContent = user.name ? `${user.name} (${user.email})` : user.email;
+            reviewerSelect.appendChild(option);
+        }
+    }
+
+    async function refreshReviewers() {
+        const assigned = await loadReviewers();
+        if (canManageReviewers) {
+            reviewerControls.classList.remove("hidden");
+            try {
+                await loadReviewerOptions(assigned);
+            } catch (error) {
+                showError(error.message || "Failed to load reviewers", reviewersErrorDiv);
+            }
+        }
+    }
+
+    async function unassignReviewer(reviewer) {
+        const confirmed = await confirmAction(
+            "Their vote in the current round will be discarded. If the remaining votes meet the quorum, the proposal is decided right away.",
+            {
+                title: "Remove Reviewer?",
+                confirmText: "Remove",
+                confirmButtonClass: "btn-danger",
+                variant: "warning",
+            }
+        );
+        if (!confirmed) {
+            return;
+        }
+
+        hideError(reviewersErrorDiv);
+        try {
+            await api.delete(`/api/proposals/${proposalId}/reviewers/${reviewer.reviewer_id}`);
+            window.location.reload();
+        } catch (error) {
+            showError(error.message || "Failed to remove reviewer", reviewersErrorDiv);
+        }
+    }
+
+    if (reviewerList) {
+        if (assignReviewerBtn) {
+            assignReviewerBtn.addEventListener("click", async () => {
+                const reviewerId = Number(reviewerSelect.value);
+                if (!reviewerId) return;
+
+                hideError(reviewersErrorDiv);
+                assignReviewerBtn.disabled = true;
+                try {
+                    await api.post(`/api/proposals/${proposalId}/reviewers`, {
+                        reviewer_id: reviewerId,
+                    });
+                    await refreshReviewers();
+                } catch (error) {
+                    showError(error.message || "Failed to assign reviewer", reviewersErrorDiv);
+                } finally {
+                    assignReviewerBtn.disabled = false;
+                }
+            });
+        }
+
+        if (autoAssignBtn) {
+            autoAssignBtn.addEventListener("click", async () => {
+                hideError(reviewersErrorDiv);
+                autoAssignBtn.disabled = true;
+                try {
+                    await api.post(`/api/proposals/${proposalId}/reviewers/actions/auto-assign`);
+                    await refreshReviewers();
+                } catch (error) {
+                    let errorMsg = error.message || "Failed to assign reviewer";
+                    if (error.message && error.message.includes("409")) {
+                        errorMsg = "No other reviewers are available to assign.";
+                    }
+                    showError(errorMsg, reviewersErrorDiv);
+                } finally {
+                    autoAssignBtn.disabled = false;
+                }
+            });
+        }
+
+        refreshReviewers();
+    }
 });
@@ -1,12 +1,75 @@
 import api from "../core/api.js";
-import { escapeHtml, confirmAction, deleteProposal } from "../core/utils.js";
+import { escapeHtml, confirmAction, deleteProposal, debounce } from "../core/utils.js";
 import { $, delegate } from "../core/dom.js";
 
 document.addEventListener("DOMContentLoaded", () => {
     const container = $("#proposals-list");
     if (!container) return;
 
-    const isAdmin = container.getAttribute("data-is-admin") === "true";
+    const isReviewer = container.getAttribute("data-is-reviewer") === "true";
+
+    function renderProposal(p) {
+        let actionsHtml = "";
+        if (!isReviewer) {
+            const actions = [];
+            if (
+                p.status === "draft" ||
+                p.status === "changes_requested"
+            ) {
+                actions.push(
+                    `<a href="/proposals/${p.id}/edit" class="btn btn-secondary">Edit</a>`,
+                );
+            }
+            if (p.status === "submitted") {
+                actions.push(
+                    `<button class="btn btn-secondary" data-withdraw-id="${p.id}">Withdraw</button>`,
+                );
+            }
+            if (p.status !== "submitted") {
+                actions.push(
+                    `<button class="btn btn-danger" data-delete-id="${p.id}" data-proposal-status="${p.status}">Delete</button>`,
+                );
+            }
+            if (actions.length > 0) {
+                actionsHtml = `<div class="proposal-actions">${actions.join("")}</div>`;
+            }
+        }
+
+        let authorHtml = "";
+        let waitingHtml = "";
+        if (isReviewer) {
+            const author = p.author_name
+                ? `${escapeHtml(p.author_name)} &lt;${escapeHtml(p.author_email)}&gt;`
+                : escapeHtml(p.author_email || `Author ID: ${p.author_id}`);
+            authorHtml = `<div class="proposal-author">${author}</div>`;
+            if (p.status === "submitted") {
+                const days = p.waiting_days === 1 ? "1 day" : `${p.waiting_days} days`;
+                waitingHtml = `<span class="proposal-waiting">Waiting ${days}</span>`;
+            }
+        }
+
+        const submittedHtml = p.submitted_at
+            ? `<span>Submitted: ${new Date(p.submitted_at).toLocaleDateString()}</span>`
+            : "";
+
+        return `
+            <div class="proposal-card${p.overdue ? " proposal-card-overdue" : ""}" data-proposal-id="${p.id}">
+                <div class="proposal-header">
+                    <h3><a href="/proposals/${p.id}">${escapeHtml(p.title || "Untitled Proposal")}</a></h3>
+                    <span class="status-badge status-${p.status}">${p.status}</span>
+                </div>
+                <p class="proposal-summary">${escapeHtml(p.summary)}</p>
+                ${authorHtml}
+                <div class="proposal-meta">
+                    <span>Created: ${new Date(p.created_at).toLocaleDateString()}</span>
+                    ${submittedHtml}
+                    <span>Updated: ${new Date(p.updated_at).toLocaleDateString()}</span>
+                    ${waitingHtml}
+                </div>
+                ${actionsHtml}
+            </div>
+        `;
+    }
 
     async function loadProposals() {
         try {
@@ -16,66 +79,15 @@ document.addEventListener("DOMContentLoaded", () => {
             const proposals = await response.json();
 
             if (proposals.length === 0) {
-                if (isAdmin) {
-                    container.innerHTML =
-                        '<div class="empty-state"><p>No proposals have been submitted for review.</p></div>';
-                } else {
-                    container.innerHTML =
-                        '<div class="empty-state"><p>No proposals yet. <a href="/proposals/new">Create your first proposal</a></p></div>';
-                }
+                container.innerHTML =
+                    '<div class="empty-state"><p>No proposals yet. <a href="/proposals/new">Create your first proposal</a></p></div>';
                 return;
             }
 
             container.innerHTML = proposals
                 .slice()
                 .sort((a, b) => new Date(b.updated_at) - new Date(a.updated_at))
-                .map((p) => {
-                    let actionsHtml = "";
-                    if (!isAdmin) {
-                        const actions = [];
-                        if (
-                            p.status === "draft" ||
-                            p.status === "changes_requested"
-                        ) {
-                            actions.push(
-                                `<a href="/proposals/${p.id}/edit" class="btn btn-secondary">Edit</a>`,
-                            );
-                        }
-                        if (p.status === "submitted") {
-                            actions.push(
-                                `<button class="btn btn-secondary" data-withdraw-id="${p.id}">Withdraw</button>`,
-                            );
-                        }
-                        if (p.status !== "submitted") {
-                            actions.push(
-                                `<button class="btn btn-danger" data-delete-id="${p.id}" data-proposal-status="${p.status}">Delete</button>`,
-                            );
-                        }
-                        if (actions.length > 0) {
-                            actionsHtml = `<div class="proposal-actions">${actions.join("")}</div>`;
-                        }
-                    }
-
-                    const authorHtml = isAdmin
-                        ? `<div class="proposal-author">Author ID: ${p.author_id}</div>`
-                        : "";
-
-                    return `
-                        <div class="proposal-card" data-proposal-id="${p.id}">
-                            <div class="proposal-header">
-                                <h3><a href="/proposals/${p.id}">${escapeHtml(p.title || "Untitled Proposal")}</a></h3>
-                                <span class="status-badge status-${p.status}">${p.status}</span>
-                            </div>
-                            <p class="proposal-summary">${escapeHtml(p.summary)}</p>
-                            ${authorHtml}
-                            <div class="proposal-meta">
-                                <span>Created: ${new Date(p.created_at).toLocaleDateString()}</span>
-                                <span>Updated: ${new Date(p.updated_at).toLocaleDateString()}</span>
-                            </div>
-                            ${actionsHtml}
-                        </div>
-                    `;
-                })
+                .map(renderProposal)
                 .join("");
         } catch (error) {
             container.innerHTML =
@@ -83,6 +95,57 @@ document.addEventListener("DOMContentLoaded", () => {
         }
     }
 
+    const authorInput = $("#proposal-author");
+    const sortSelect = $("#proposal-sort");
+    const waitingInput = $("#proposal-waiting-days");
+    const statusTabs = $("#proposal-status-tabs");
+    const moreBtn = $("#proposals-more");
+    let status = "";
+    let nextCursor = "";
+
+    async function loadQueue(append) {
+        const [sort, order] = sortSelect.value.split(":");
+        const params = new URLSearchParams({ sort, order });
+        if (status) params.set("status", status);
+        if (authorInput.value.trim()) params.set("author", authorInput.value.trim());
+        const waitingDays = parseInt(waitingInput.value, 10);
+        if (waitingDays > 0) params.set("waiting_days", String(waitingDays));
+        if (append && nextCursor) params.set("cursor", nextCursor);
+
+        try {
+            const response = await api.get(`/api/proposals?${params}`);
+            if (!response) return;
+
+            const page = await response.json();
+
+            let total = 0;
+            for (const [key, count] of Object.entries(page.counts)) {
+                total += count;
+                const badge = statusTabs.querySelector(`[data-count="${key}"]`);
+                if (badge) badge.textContent = count;
+            }
+            statusTabs.querySelector('[data-count=""]').textContent = total;
+
+            const html = page.proposals.map(renderProposal).join("");
+            if (append) {
+                container.insertAdjacentHTML("beforeend", html);
+            } else if (page.proposals.length === 0) {
+                container.innerHTML = total === 0
+                    ? '<div class="empty-state"><p>No proposals have been submitted for review.</p></div>'
+                    : '<div class="empty-state"><p>No proposals match these filters.</p></div>';
+            } else {
+                container.innerHTML = html;
+            }
+
+            nextCursor = page.next_cursor;
+            moreBtn.classList.toggle("hidden", !nextCursor);
+        } catch (error) {
+            container.innerHTML =
+                '<div class="error-message">Failed to load proposals. Please refresh the page.</div>';
+            moreBtn.classList.add("hidden");
+        }
+    }
+
     delegate(container, "[data-delete-id]", "click", async (e, target) => {
         e.preventDefault();
         const proposalId = target.getAttribute("data-delete-id");
@@ -140,5 +203,26 @@ document.addEventListener("DOMContentLoaded", () => {
         }
     });
 
-    loadProposals();
+    if (!isReviewer) {
+        loadProposals();
+        return;
+    }
+
+    delegate(statusTabs, "[data-status]", "click", (e, target) => {
+        status = target.getAttribute("data-status");
+        statusTabs.querySelectorAll("[data-status]").forEach((tab) => {
+            tab.classList.toggle("active", tab === target);
+        });
+        loadQueue(false);
+    });
+    authorInput.addEventListener("input", debounce(() => loadQueue(false), 300));
+    sortSelect.addEventListener("change", () => loadQueue(false));
+    waitingInput.addEventListener("change", () => loadQueue(false));
+    $("#proposal-filters").addEventListener("submit", (e) => {
+        e.preventDefault();
+        loadQueue(false);
+    });
+    moreBtn.addEventListener("click", () => loadQueue(true));
+
+    loadQueue(false);
 });
@@ -0,0 +1,68 @@
+import api from "../core/api.js";
+import { $ } from "../core/dom.js";
+import { showErrorToast, showSuccessToast } from "../components/Toast.js";
+
+function collectAnswers(form) {
+    return Array.from(form.querySelectorAll(".quiz-question")).map((fieldset) => {
+        const index = fieldset.dataset.questionIndex;
+        if (fieldset.dataset.questionType === "short_answer") {
+            const input = fieldset.querySelector(`[name="question-${index}"]`);
+            return { text: input ? input.value.trim() : "" };
+        }
+        const selected = Array.from(
+            fieldset.querySelectorAll(`[name="question-${index}"]:checked`),
+        ).map((input) => Number(input.value));
+        return { selected_options: selected };
+    });
+}
+
+document.addEventListener("DOMContentLoaded", () => {
+    const { courseId, moduleId, quizId } = window.QUIZ_VIEW_DATA || {};
+
+    if (!courseId || !moduleId || !quizId) return;
+
+    const apiUrl = `/api/courses/${courseId}/modules/${moduleId}/content/${quizId}`;
+
+    const form = $("#quiz-form");
+    if (form) {
+        form.addEventListener("submit", async (e) => {
+            e.preventDefault();
+            try {
+                const response = await api.post(`${apiUrl}/attempts`, {
+                    answers: collectAnswers(form),
+                });
+                if (!response) return;
+                const attempt = await response.json();
+                const result = attempt.passed ? "Passed" : "Not passed";
+                showSuccessToast(`${result}: ${attempt.score} / ${attempt.max_score}`);
+                setTimeout(() => window.location.reload(), 1500);
+            } catch (err) {
+                showErrorToast(err.message || "Failed to submit quiz");
+            }
+        });
+    }
+
+    const publishBtn = $("#publish-btn");
+    if (publishBtn) {
+        publishBtn.addEventListener("click", async () => {
+            try {
+                await api.post(`${apiUrl}/actions/publish?type=quiz`);
+                window.location.reload();
+            } catch (err) {
+                showErrorToast(err.message || "Failed to publish");
+            }
+        });
+    }
+
+    const unpublishBtn = $("#unpublish-btn");
+    if (unpublishBtn) {
+        unpublishBtn.addEventListener("click", async () => {
+            try {
+                await api.post(`${apiUrl}/actions/unpublish?type=quiz`);
+                window.location.reload();
+            } catch (err) {
+                showErrorToast(err.message || "Failed to unpublish");
+            }
+        });
+    }
+});
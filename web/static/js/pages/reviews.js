@@ -0,0 +1,57 @@
+import api from "../core/api.js";
+import { $ } from "../core/dom.js";
+import { escapeHtml, formatDate } from "../core/utils.js";
+
+const VOTE_LABELS = {
+    approve: "Voted to approve",
+    reject: "Voted to reject",
+    request_changes: "Voted for changes",
+};
+
+document.addEventListener("DOMContentLoaded", () => {
+    const container = $("#review-queue");
+    if (!container) return;
+
+    function renderItem(item) {
+        const p = item.proposal;
+        const vote = item.vote || "pending";
+        const label = item.vote
+            ? VOTE_LABELS[item.vote] || item.vote
+            : "Awaiting your vote";
+
+        return `
+            <div class="proposal-card review-queue-item" data-proposal-id="${p.id}">
+                <div class="proposal-header">
+                    <h3><a href="/proposals/${p.id}">${escapeHtml(p.title || "Untitled Proposal")}</a></h3>
+                    <span class="status-badge status-vote-${vote}">${label}</span>
+                </div>
+                <p class="proposal-summary">${escapeHtml(p.summary)}</p>
+                <div class="proposal-meta">
+                    <span>Waiting since: ${formatDate(item.pending_since)}</span>
+                    <span>Updated: ${formatDate(p.updated_at)}</span>
+                </div>
+            </div>
+        `;
+    }
+
+    async function loadQueue() {
+        try {
+            const response = await api.get("/api/me/reviews");
+            if (!response) return;
+
+            const queue = await response.json();
+            if (queue.length === 0) {
+                container.innerHTML =
+                    '<div class="empty-state"><p>No proposals are waiting for your review.</p></div>';
+                return;
+            }
+
+            container.innerHTML = queue.map(renderItem).join("");
+        } catch (error) {
+            container.innerHTML =
+                '<div class="error-message">Failed to load your reviews. Please refresh the page.</div>';
+        }
+    }
+
+    loadQueue();
+});